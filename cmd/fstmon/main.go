@@ -66,6 +66,18 @@ var (
 			Thermal:   20,
 			NetworkIO: 10,
 			DiskIO:    10,
+
+			BackoffCap: 300,
+			LazyIdle:   600,
+
+			StaleFactor:  3,
+			ExpireFactor: 10,
+
+			HistorySize: 360,
+
+			SnapshotInterval: 60,
+
+			TSDBRetention: 30,
 		},
 	}
 )
@@ -4,16 +4,22 @@
 package fstmon
 
 import (
-	"context"
 	"io"
+	"maps"
+	"os"
+	"slices"
 	"time"
 
 	"github.com/eterline/fstmon/internal/config"
 	"github.com/eterline/fstmon/internal/infra/log"
 	metricstore "github.com/eterline/fstmon/internal/infra/metrics/metric_store"
 	"github.com/eterline/fstmon/internal/infra/metrics/system"
+	"github.com/eterline/fstmon/internal/infra/metrics/tsdb"
 	"github.com/eterline/fstmon/internal/infra/security"
+	httpadmin "github.com/eterline/fstmon/internal/interface/http/admin"
 	"github.com/eterline/fstmon/internal/interface/http/api"
+	httpcollectors "github.com/eterline/fstmon/internal/interface/http/collectors"
+	httphistory "github.com/eterline/fstmon/internal/interface/http/history"
 	httphomepage "github.com/eterline/fstmon/internal/interface/http/homepage"
 	middleware "github.com/eterline/fstmon/internal/interface/http/middlewares"
 	"github.com/eterline/fstmon/internal/interface/http/server"
@@ -45,7 +51,7 @@ func Execute(root *toolkit.AppStarter, flags InitFlags, cfg config.Configuration
 
 	// ========================================================
 
-	proc, err := procfs.NewDefaultFS()
+	proc, err := procfs.NewFS(procfs.DefaultMountPoint)
 	if err != nil {
 		log.Error("procfs initialization error", "error", err)
 		root.MustStopApp(1)
@@ -68,59 +74,193 @@ func Execute(root *toolkit.AppStarter, flags InitFlags, cfg config.Configuration
 		log.Info("in-memory pooler store closed")
 	}()
 
-	metricPooling := monitor.NewServicePooler(mStore) // Metric pooling service
+	ttls := make(map[string]monitor.TTL)
+	for key, d := range cfg.StaleAfterDurations() {
+		ttls[key] = monitor.TTL{StaleAfter: d}
+	}
+	for key, d := range cfg.ExpireAfterDurations() {
+		ttl := ttls[key]
+		ttl.ExpireAfter = d
+		ttls[key] = ttl
+	}
+
+	metricPooling := monitor.NewServicePooler( // Metric pooling service
+		mStore,
+		monitor.WithBackoffCap(cfg.BackoffCapDuration()),
+		monitor.WithScrapeTimeouts(cfg.ScrapeTimeoutDurations()),
+		monitor.WithLazyWorkers(
+			monitor.LazyConfig{
+				MaxAge:      cfg.LazyMaxAgeDuration(),
+				IdleTimeout: cfg.LazyIdleDuration(),
+			},
+			cfg.LazyWorkers...,
+		),
+		monitor.WithStaleness(
+			monitor.StalenessConfig{
+				StaleFactor:  cfg.StaleFactor,
+				ExpireFactor: cfg.ExpireFactor,
+				TTLs:         ttls,
+				ServeExpired: cfg.ServeExpired,
+			},
+		),
+	)
 
 	// ========================================================
 
-	// CPU usage
-	hMtCpu := system.NewHardwareMetricCPU(time.Second)
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtCpu.ScrapeCpuMetrics), "cpu_usage", cfg.CpuDuration(),
-	)
+	rules := make([]system.FilterRule, 0, len(cfg.FilterInclude)+len(cfg.FilterExclude))
+	for _, rule := range cfg.FilterInclude {
+		fr, err := system.ParseFilterRule(rule, true)
+		if err != nil {
+			log.Error("filter rules error", "error", err)
+			root.MustStopApp(1)
+		}
+		rules = append(rules, fr)
+	}
+	for _, rule := range cfg.FilterExclude {
+		fr, err := system.ParseFilterRule(rule, false)
+		if err != nil {
+			log.Error("filter rules error", "error", err)
+			root.MustStopApp(1)
+		}
+		rules = append(rules, fr)
+	}
+	filters := system.NewFilters(!cfg.NoDefaultFilters, rules...)
 
-	// CPU summary info
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtCpu.ScrapeCpuPackage), "cpu", time.Minute,
-	)
+	registry := monitor.NewRegistry()
+	if err := system.RegisterCollectors(registry, proc, procfs.DefaultMountPoint, filters); err != nil {
+		log.Error("collectors registration error", "error", err)
+		root.MustStopApp(1)
+	}
 
-	// Network interfaces I/O
-	hMtNet := system.NewHardwareMetricNetwork(proc)
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtNet.ScrapeInterfacesIO), "net_io", cfg.NetworkIoDuration(),
-	)
+	if err := system.RegisterSelfCollector(registry, proc, procfs.DefaultMountPoint, metricPooling); err != nil {
+		log.Error("self collector registration error", "error", err)
+		root.MustStopApp(1)
+	}
 
-	// Memory stats
-	hMtMem := system.NewHardwareMetricMemory(proc)
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtMem.ScrapeMemoryMetrics), "memory", cfg.MemorykDuration(),
-	)
+	if len(cfg.Watch) > 0 {
+		entries := make([]system.WatchEntry, 0, len(cfg.Watch))
+		for _, name := range slices.Sorted(maps.Keys(cfg.Watch)) {
+			we, err := system.ParseWatchEntry(name, cfg.Watch[name])
+			if err != nil {
+				log.Error("watch list error", "error", err)
+				root.MustStopApp(1)
+			}
+			entries = append(entries, we)
+		}
 
-	hMtParts := system.NewHardwareMetricPartitions(proc)
+		if err := system.RegisterWatchCollector(registry, proc, entries); err != nil {
+			log.Error("watch collector registration error", "error", err)
+			root.MustStopApp(1)
+		}
+	}
 
-	// Disk I/O metrics
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtParts.ScrapeDiskIO), "disk_io", cfg.DiskIODuration(),
-	)
+	if cfg.Systemd || len(cfg.SystemdUnits) > 0 {
+		if err := system.RegisterSystemdCollector(registry, cfg.SystemdBus, cfg.SystemdUnits); err != nil {
+			log.Error("systemd collector registration error", "error", err)
+			root.MustStopApp(1)
+		}
+	}
 
-	// Partitions static info
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtParts.ScrapePartitions), "partitions", time.Minute,
-	)
+	if err := system.RegisterCgroupCollector(registry, ""); err != nil {
+		log.Error("cgroup collector registration error", "error", err)
+		root.MustStopApp(1)
+	}
 
-	// System info (loadavg, uptime, procs, etc.)
-	hMtSystem := system.NewHardwareMetricSystem(proc)
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtSystem.ScrapeSystemInfo), "system", cfg.SystemDuration(),
-	)
+	if unknown := registry.Unknown(append(cfg.Collectors, cfg.DisabledCollectors...)...); len(unknown) > 0 {
+		log.Warn("unknown collectors in configuration", "names", unknown)
+	}
+
+	collectors := registry.Select(cfg.Collectors, cfg.DisabledCollectors)
+	intervals := cfg.CollectorIntervals()
 
-	// Thermal sensors
-	hMtThermal := system.NewHardwareThermalMetrics()
-	metricPooling.AddMetricPooling(
-		wrapJob(hMtThermal.ScrapeThermalMetrics), "thermal", cfg.ThermalDuration(),
+	history := metricstore.NewHistoryStore(
+		metricstore.WithHistorySize(cfg.HistoryLength()),
+		metricstore.WithHistoryDuration(cfg.HistoryDurationValue()),
 	)
 
+	// series endpoints are served by the on-disk storage when it is enabled
+	var series httphistory.SeriesReader
+	recorders := []metricstore.Recorder{history}
+
+	if cfg.TSDBDir != "" {
+		db, err := tsdb.Open(
+			cfg.TSDBDir,
+			tsdb.WithRetention(cfg.TSDBRetentionDuration()),
+			tsdb.WithMaxSize(cfg.TSDBMaxSizeBytes()),
+		)
+		if err != nil {
+			log.Error("tsdb initialization error", "error", err, "dir", cfg.TSDBDir)
+			root.MustStopApp(1)
+		}
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Error("tsdb close error", "error", err)
+			}
+			log.Info("tsdb closed")
+		}()
+
+		rec := db.Recovery()
+		log.Info(
+			"tsdb opened",
+			"dir", cfg.TSDBDir,
+			"segments", rec.Segments,
+			"wal_records", rec.ReplayedRecords,
+			"size", db.Size(),
+		)
+		if rec.TruncatedBytes > 0 || len(rec.Corrupted) > 0 || len(rec.Superseded) > 0 {
+			log.Warn(
+				"tsdb recovered after crash",
+				"wal_truncated_bytes", rec.TruncatedBytes,
+				"corrupted", rec.Corrupted,
+				"superseded", rec.Superseded,
+			)
+		}
+
+		root.WrapWorker(func() {
+			db.Run(ctx, time.Minute)
+			log.Info("tsdb maintenance stopped")
+		})
+
+		series = db
+		recorders = append(recorders, db)
+	} else {
+		rollups := metricstore.NewRollupStore(history)
+		series = rollups
+		recorders = append(recorders, rollups)
+	}
+
+	for _, c := range collectors.Collectors() {
+		interval, ok := intervals[c.Name()]
+		if !ok {
+			interval = c.DefaultInterval()
+		}
+
+		metricPooling.AddCollector(c, interval)
+		history.Track(c.Name(), interval)
+	}
+
+	if cfg.SnapshotFile != "" {
+		restored, err := mStore.LoadSnapshot(cfg.SnapshotFile, collectors)
+		if err != nil {
+			// undecodable entries are skipped, the others are still restored
+			log.Warn("metric snapshot restore error", "error", err, "entries", restored, "file", cfg.SnapshotFile)
+		} else {
+			log.Info("metric snapshot restored", "entries", restored, "file", cfg.SnapshotFile)
+		}
+
+		root.WrapWorker(func() {
+			mStore.RunSnapshots(ctx, cfg.SnapshotFile, cfg.SnapshotIntervalDuration())
+			log.Info("metric snapshots stopped")
+		})
+	}
+
 	// ========
 
+	root.WrapWorker(func() {
+		metricstore.Consume(ctx, metricPooling.Subscribe(monitor.WithQueueSize(256)), recorders...)
+		log.Info("metric history stopped")
+	})
+
 	root.WrapWorker(func() {
 		metricPooling.RunPooling(ctx)
 		metricPooling.Wait()
@@ -185,11 +325,39 @@ func Execute(root *toolkit.AppStarter, flags InitFlags, cfg config.Configuration
 				r.Get("/network", h.HandleNetwork)
 				r.Get("/partitions", h.HandlePartitions)
 				r.Get("/diskio", h.HandleDiskIO)
+				r.Get("/processes", h.HandleProcesses)
+				r.Get("/services", h.HandleServices)
+				r.Get("/systemd", h.HandleSystemd)
+				r.Get("/cgroups", h.HandleCgroups)
+				r.Get("/pressure", h.HandlePressure)
+				r.Get("/protocols", h.HandleNetProtocols)
+				r.Get("/sockets", h.HandleSockets)
+				r.Get("/listening", h.HandleListening)
 			},
 		)
 
+		metricRouter.Get("/self", h.HandleSelf)
+
+		httpcollectors.New(collectors, metricPooling).Routes(metricRouter)
+		httphistory.New(history, series).Routes(metricRouter)
+
 		rootMux.Mount("/metric", metricRouter)
 	}
+	// ========
+	if cfg.AdminAPI {
+		adminAuth, err := security.NewTokenAuthProvide(security.PolicyStart, os.Getenv("FSTMON_ADMIN_TOKEN"))
+		if err != nil { // empty FSTMON_ADMIN_TOKEN fails the length policy too
+			log.Error("admin API initialization error", "error", err)
+		} else {
+			adminRouter := chi.NewRouter()
+			adminRouter.Use(middleware.BearerAuth(adminAuth, "X-Admin-Token"))
+
+			httpadmin.New(metricPooling).Routes(adminRouter)
+
+			rootMux.Mount("/admin", adminRouter)
+			log.Warn("admin API enabled")
+		}
+	}
 	// ============================
 	{
 		srv := server.NewServer(
@@ -209,9 +377,3 @@ func Execute(root *toolkit.AppStarter, flags InitFlags, cfg config.Configuration
 	// ============================
 	root.WaitWorkers(15 * time.Second)
 }
-
-func wrapJob[T any](f func(context.Context) (T, error)) monitor.UpdateWorker {
-	return func(ctx context.Context) (any, error) {
-		return f(ctx)
-	}
-}
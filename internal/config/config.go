@@ -32,6 +32,43 @@ type Monitor struct {
 	Thermal   int `arg:"--thermal-loop" help:"Thermal update loop seconds"`
 	NetworkIO int `arg:"--network-loop" help:"Network I/O update loop seconds"`
 	DiskIO    int `arg:"--partitions-loop" help:"Disk I/O update loop seconds"`
+
+	BackoffCap     int            `arg:"--backoff-cap" help:"Max retry delay seconds for failing metric workers"`
+	ScrapeTimeouts map[string]int `arg:"--scrape-timeout" help:"Scrape deadline seconds overrides by metric key, e.g. thermal=10"`
+
+	LazyWorkers []string `arg:"--lazy" help:"Metric keys scraped on demand only, e.g. --lazy thermal partitions"`
+	LazyMaxAge  int      `arg:"--lazy-max-age" help:"Max age seconds of a served lazy metric, 0 – worker interval"`
+	LazyIdle    int      `arg:"--lazy-idle" help:"Seconds without requests before a lazy worker sleeps"`
+
+	StaleFactor  float64        `arg:"--stale-factor" help:"Value older than this many worker intervals is stale"`
+	ExpireFactor float64        `arg:"--expire-factor" help:"Value older than this many worker intervals is expired"`
+	StaleAfter   map[string]int `arg:"--stale-after" help:"Stale threshold seconds overrides by metric key, e.g. partitions=600"`
+	ExpireAfter  map[string]int `arg:"--expire-after" help:"Expire threshold seconds overrides by metric key"`
+	ServeExpired bool           `arg:"--serve-expired" help:"Serve expired values as stale instead of 503"`
+
+	Intervals          map[string]int `arg:"--interval" help:"Update loop seconds overrides by collector name, e.g. partitions=300"`
+	Collectors         []string       `arg:"--collectors" help:"Enabled collector names, empty – all"`
+	DisabledCollectors []string       `arg:"--disable" help:"Disabled collector names, e.g. --disable thermal"`
+
+	HistorySize     int `arg:"--history-size" help:"Max history samples kept per metric key"`
+	HistoryDuration int `arg:"--history-duration" help:"Seconds of metric history kept, 0 – limited by size only"`
+
+	SnapshotFile     string `arg:"--snapshot" help:"Metric store snapshot file restored at startup, empty – disabled"`
+	SnapshotInterval int    `arg:"--snapshot-interval" help:"Metric store snapshot write loop seconds"`
+
+	TSDBDir       string `arg:"--tsdb" help:"On-disk series storage directory, empty – in-memory rollups"`
+	TSDBRetention int    `arg:"--tsdb-retention" help:"Days of on-disk series kept"`
+	TSDBMaxSize   int    `arg:"--tsdb-max-size" help:"Max on-disk series size in MB, 0 – unlimited"`
+
+	Systemd      bool     `arg:"--systemd" help:"Enable the systemd units collector"`
+	SystemdUnits []string `arg:"--systemd-units" help:"Watched systemd units, e.g. --systemd-units nginx.service backup.timer"`
+	SystemdBus   string   `arg:"--systemd-bus" help:"D-Bus address of systemd, empty – system bus"`
+
+	Watch map[string]string `arg:"--watch" help:"Watched services by name, matcher is name:REGEX, exe:PATH, cmdline:REGEX or pidfile:PATH, e.g. nginx=exe:/usr/sbin/nginx"`
+
+	FilterInclude    []string `arg:"--filter-include" help:"Kept items as TARGET=PATTERN, pattern is a glob or re:REGEX, include rules keep only the matching items of the target, e.g. net_io.interface=eth*"`
+	FilterExclude    []string `arg:"--filter-exclude" help:"Dropped items as TARGET=PATTERN, e.g. partitions.mount=/snap/*"`
+	NoDefaultFilters bool     `arg:"--no-default-filters" help:"Disable built-in excludes of virtual interfaces, loop devices and pseudo filesystems"`
 }
 
 func (m Monitor) CpuDuration() time.Duration {
@@ -58,6 +95,92 @@ func (m Monitor) DiskIODuration() time.Duration {
 	return clampSeconds(m.DiskIO, 10, 300)
 }
 
+func (m Monitor) BackoffCapDuration() time.Duration {
+	return clampSeconds(m.BackoffCap, 30, 3600)
+}
+
+func (m Monitor) LazyMaxAgeDuration() time.Duration {
+	if m.LazyMaxAge <= 0 {
+		return 0
+	}
+	return clampSeconds(m.LazyMaxAge, 1, 3600)
+}
+
+func (m Monitor) LazyIdleDuration() time.Duration {
+	return clampSeconds(m.LazyIdle, 30, 86400)
+}
+
+func (m Monitor) ScrapeTimeoutDurations() map[string]time.Duration {
+	timeouts := make(map[string]time.Duration, len(m.ScrapeTimeouts))
+	for key, sec := range m.ScrapeTimeouts {
+		timeouts[key] = clampSeconds(sec, 1, 300)
+	}
+	return timeouts
+}
+
+func (m Monitor) StaleAfterDurations() map[string]time.Duration {
+	stale := make(map[string]time.Duration, len(m.StaleAfter))
+	for key, sec := range m.StaleAfter {
+		stale[key] = clampSeconds(sec, 1, 7*86400)
+	}
+	return stale
+}
+
+func (m Monitor) ExpireAfterDurations() map[string]time.Duration {
+	expire := make(map[string]time.Duration, len(m.ExpireAfter))
+	for key, sec := range m.ExpireAfter {
+		expire[key] = clampSeconds(sec, 1, 30*86400)
+	}
+	return expire
+}
+
+func (m Monitor) HistoryLength() int {
+	return min(max(m.HistorySize, 10), 86400)
+}
+
+func (m Monitor) HistoryDurationValue() time.Duration {
+	if m.HistoryDuration <= 0 {
+		return 0
+	}
+	return clampSeconds(m.HistoryDuration, 60, 7*86400)
+}
+
+func (m Monitor) SnapshotIntervalDuration() time.Duration {
+	return clampSeconds(m.SnapshotInterval, 10, 3600)
+}
+
+func (m Monitor) TSDBRetentionDuration() time.Duration {
+	return time.Duration(min(max(m.TSDBRetention, 1), 3650)) * 24 * time.Hour
+}
+
+func (m Monitor) TSDBMaxSizeBytes() int64 {
+	return int64(max(m.TSDBMaxSize, 0)) << 20
+}
+
+/*
+CollectorIntervals – returns update intervals by collector name.
+
+	Legacy per-metric loop flags are mapped to their collectors,
+	--interval overrides win. Collectors without an entry use
+	their default interval.
+*/
+func (m Monitor) CollectorIntervals() map[string]time.Duration {
+	intervals := map[string]time.Duration{
+		"cpu_usage": m.CpuDuration(),
+		"net_io":    m.NetworkIoDuration(),
+		"memory":    m.MemorykDuration(),
+		"disk_io":   m.DiskIODuration(),
+		"system":    m.SystemDuration(),
+		"thermal":   m.ThermalDuration(),
+	}
+
+	for name, sec := range m.Intervals {
+		intervals[name] = clampSeconds(sec, 1, 3600)
+	}
+
+	return intervals
+}
+
 type (
 	Log struct {
 		LogLevel      string `arg:"--log-level" help:"Logging level: debug|info|warn|error"`
@@ -77,6 +200,7 @@ type (
 		//		AuthToken      []string `arg:"--token,-t,env:TOKEN" help:"Server auth token string"`
 		AuthToken     bool `arg:"--token,-t" help:"Server auth token is enabled"`
 		ParseIpHeader bool `arg:"--ip-header" help:"Enable parsing reverse proxy headers"`
+		AdminAPI      bool `arg:"--admin" help:"Enable admin API, token is read from FSTMON_ADMIN_TOKEN env"`
 	}
 
 	Configuration struct {
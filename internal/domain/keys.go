@@ -0,0 +1,49 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package domain
+
+/*
+Key – metric key bound to the type of its values.
+
+	Collectors are registered and metrics are read by the same Key
+	value, so a key used with another value type fails to compile
+	instead of failing with a type mismatch at runtime.
+*/
+type Key[T any] struct {
+	name string
+}
+
+// NewKey – creates the key of T values under the name.
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name: name}
+}
+
+// Name – returns the key name used by the store, HTTP, gRPC and configuration.
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+func (k Key[T]) String() string {
+	return k.name
+}
+
+// Metric keys of the host collectors.
+var (
+	KeyCpuUsage   = NewKey[CpuMetrics]("cpu_usage")
+	KeyCpu        = NewKey[CpuPackage]("cpu")
+	KeyNetIO      = NewKey[InterfacesIOMap]("net_io")
+	KeyMemory     = NewKey[MemoryMetrics]("memory")
+	KeyDiskIO     = NewKey[DiskIOMap]("disk_io")
+	KeyPartitions = NewKey[Partitions]("partitions")
+	KeySystem     = NewKey[SystemInfo]("system")
+	KeyThermal    = NewKey[ThermalMetricsMap]("thermal")
+	KeySelf       = NewKey[SelfMetrics]("self")
+	KeyProcesses  = NewKey[ProcessList]("processes")
+	KeyServices   = NewKey[ServiceList]("services")
+	KeySystemd    = NewKey[SystemdMetrics]("systemd")
+	KeyCgroups    = NewKey[CgroupList]("cgroups")
+	KeyPressure   = NewKey[PressureMetrics]("pressure")
+	KeySockets    = NewKey[SocketMetrics]("sockets")
+	KeyProtocols  = NewKey[NetProtocolMetrics]("net_protocols")
+)
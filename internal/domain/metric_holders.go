@@ -3,12 +3,139 @@
 // Licensed under the MIT License. See the LICENSE file for details.
 package domain
 
-import "time"
+import (
+	"cmp"
+	"slices"
+	"time"
+)
 
 type MetricState struct {
 	Value      any
 	Available  bool
 	LastUpdate time.Time
+	Restored   bool // loaded from a snapshot, not scraped since the start
+}
+
+/*
+ScrapeStatus – scrape health of a single metric worker.
+
+	LastSuccess and LastError* describe the latest outcomes,
+	ConsecutiveFailures is reset to zero by any successful scrape,
+	counters and durations below NextScrape are totals since the
+	worker start.
+*/
+type ScrapeStatus struct {
+	LastRun             time.Time     `json:"last_run"`             // Start time of the last scrape
+	LastDuration        time.Duration `json:"last_duration"`        // Duration of the last scrape
+	LastSuccess         time.Time     `json:"last_success"`         // Time of the last successful scrape
+	LastError           string        `json:"last_error"`           // Text of the last scrape error
+	LastErrorTime       time.Time     `json:"last_error_time"`      // Time of the last scrape error
+	ConsecutiveFailures int           `json:"consecutive_failures"` // Failed scrapes since the last success
+	Backoff             time.Duration `json:"backoff"`              // Current delay before the next scrape
+	NextScrape          time.Time     `json:"next_scrape"`          // Planned time of the next scrape
+	Timeouts            int           `json:"timeouts"`             // Scrapes aborted by the deadline
+	Panics              int           `json:"panics"`               // Worker panics recovered
+	Successes           int           `json:"successes"`            // Successful scrapes
+	Errors              int           `json:"errors"`               // Failed scrapes
+	Skipped             int           `json:"skipped"`              // Planned ticks skipped by a paused or idle worker
+	Overlaps            int           `json:"overlaps"`             // Ticks rejected while the previous call still ran
+	TotalDuration       time.Duration `json:"total_duration"`       // Sum of all scrape durations
+	MaxDuration         time.Duration `json:"max_duration"`         // Longest scrape
+}
+
+// Failing – reports whether the latest scrape attempt failed.
+func (s ScrapeStatus) Failing() bool {
+	return s.ConsecutiveFailures > 0
+}
+
+// AvgDuration – returns the average scrape duration.
+func (s ScrapeStatus) AvgDuration() time.Duration {
+	n := s.Successes + s.Errors
+	if n == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(n)
+}
+
+// Freshness – trust level of a metric value by its age.
+type Freshness int
+
+const (
+	FreshnessFresh   Freshness = iota // updated within the stale threshold
+	FreshnessStale                    // older than the stale threshold
+	FreshnessExpired                  // older than the expire threshold, not served by default
+)
+
+func (f Freshness) String() string {
+	switch f {
+	case FreshnessFresh:
+		return "fresh"
+	case FreshnessStale:
+		return "stale"
+	case FreshnessExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+ActualMetric – last known metric value together with its scrape health.
+
+	Freshness is decided by Age against the thresholds of the key.
+	Stale is set when the value is not fresh, when it was kept from an
+	earlier scrape because the latest attempts failed, or when it was
+	restored from a snapshot and not refreshed yet.
+*/
+type ActualMetric struct {
+	Value      any
+	LastUpdate time.Time
+	Age        time.Duration
+	Freshness  Freshness
+	RetryIn    time.Duration
+	Stale      bool
+	Restored   bool
+	Status     ScrapeStatus
+}
+
+/*
+WorkerInfo – runtime state of a metric worker.
+
+	Running reports an in-flight worker call, Paused a worker
+	whose planned scrapes are suspended.
+*/
+type WorkerInfo struct {
+	Key      string        `json:"key"`
+	Mode     string        `json:"mode"`
+	Interval time.Duration `json:"interval"`
+	Timeout  time.Duration `json:"timeout"`
+	Paused   bool          `json:"paused"`
+	Running  bool          `json:"running"`
+	Status   ScrapeStatus  `json:"status"`
+}
+
+// Sample – metric value saved at the timestamp.
+type Sample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     any       `json:"value"`
+}
+
+// SeriesPoint – aggregate of a numeric series over the bucket starting at Timestamp.
+type SeriesPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Min       float64   `json:"min"`
+	Max       float64   `json:"max"`
+	Avg       float64   `json:"avg"`
+	Last      float64   `json:"last"`
+	Count     int       `json:"count"` // Number of aggregated samples
+}
+
+// CollectorInfo – metadata of a registered metric collector.
+type CollectorInfo struct {
+	Name            string        `json:"name"`
+	Description     string        `json:"description,omitempty"`
+	Units           string        `json:"units,omitempty"`
+	DefaultInterval time.Duration `json:"default_interval"`
 }
 
 // ============================ CPU domain structures ============================
@@ -40,22 +167,58 @@ type CpuPackage struct {
 
 // =======
 
+/*
+CpuTimes – shares of CPU time spent in each state, in percent.
+
+	User and Nice exclude the guest time, so all shares sum up to 100.
+*/
+type CpuTimes struct {
+	User      float64 `json:"user"`       // Normal processes in user mode
+	Nice      float64 `json:"nice"`       // Niced processes in user mode
+	System    float64 `json:"system"`     // Kernel mode
+	Idle      float64 `json:"idle"`       // Idle
+	Iowait    float64 `json:"iowait"`     // Idle while waiting for I/O to complete
+	IRQ       float64 `json:"irq"`        // Servicing interrupts
+	SoftIRQ   float64 `json:"softirq"`    // Servicing softirqs
+	Steal     float64 `json:"steal"`      // Taken by the hypervisor for other guests
+	Guest     float64 `json:"guest"`      // Running a virtual CPU of a guest
+	GuestNice float64 `json:"guest_nice"` // Running a virtual CPU of a niced guest
+}
+
 /*
 CpuCoreMetrics – instantaneous dynamic metrics of a single CPU core,
 
-	including load percentage and current frequency in MHz.
+	including load percentage, time breakdown and current frequency in MHz.
 */
 type CpuCoreMetrics struct {
-	Load      float64 `json:"load"`      // Current core load in percent
-	Frequency float64 `json:"frequnecy"` // Current core frequency in MHz
+	Load      float64  `json:"load"`      // Current core load in percent
+	Frequency float64  `json:"frequnecy"` // Current core frequency in MHz
+	Times     CpuTimes `json:"times"`     // Time breakdown by state
+}
+
+/*
+CpuActivity – system-wide kernel activity from /proc/stat.
+
+	Rates are per second since the previous scrape, zero on the first one.
+*/
+type CpuActivity struct {
+	ContextSwitches       uint64 `json:"context_switches"`         // Context switches since boot
+	ContextSwitchesPerSec uint64 `json:"context_switches_per_sec"` // Context switches per second
+	Interrupts            uint64 `json:"interrupts"`               // Serviced interrupts since boot
+	InterruptsPerSec      uint64 `json:"interrupts_per_sec"`       // Interrupts per second
+	Forks                 uint64 `json:"forks"`                    // Created processes and threads since boot
+	ForksPerSec           uint64 `json:"forks_per_sec"`            // Forks per second
+	ProcsRunning          uint64 `json:"procs_running"`            // Tasks in runnable state
+	ProcsBlocked          uint64 `json:"procs_blocked"`            // Tasks blocked waiting for I/O
 }
 
 /*
 CpuMetrics – current dynamic metrics for all CPU cores, including the average CPU load across all cores.
 */
 type CpuMetrics struct {
-	Average CpuCoreMetrics   `json:"average"` // Average metrics across all cores
-	Cores   []CpuCoreMetrics `json:"cores"`   // Metrics per individual core
+	Average  CpuCoreMetrics   `json:"average"`  // Average metrics across all cores
+	Cores    []CpuCoreMetrics `json:"cores"`    // Metrics per individual core
+	Activity CpuActivity      `json:"activity"` // Kernel activity counters
 }
 
 // ============================ Networking domain structures ============================
@@ -73,6 +236,43 @@ type InterfaceIO struct {
 
 	BytesPerSec   IO[uint64] `json:"bytes_per_sec"`   // Per second bytes
 	PacketsPerSec IO[uint64] `json:"packets_per_sec"` // Per second packets
+
+	Link               InterfaceLink `json:"link"`                // Link state and addresses
+	UtilizationPercent float64       `json:"utilization_percent"` // Busier direction share of the link speed, zero when the speed is unknown
+}
+
+// InterfaceKind – kind of a network interface.
+type InterfaceKind string
+
+const (
+	InterfacePhysical  InterfaceKind = "physical"
+	InterfaceLoopback  InterfaceKind = "loopback"
+	InterfaceBridge    InterfaceKind = "bridge"
+	InterfaceVeth      InterfaceKind = "veth"
+	InterfaceVlan      InterfaceKind = "vlan"
+	InterfaceBond      InterfaceKind = "bond"
+	InterfaceWireGuard InterfaceKind = "wireguard"
+	InterfaceTun       InterfaceKind = "tun"     // tun and tap devices
+	InterfaceVirtual   InterfaceKind = "virtual" // any other virtual device, e.g. dummy or ifb
+)
+
+/*
+InterfaceLink – link metadata of a network interface from /sys/class/net.
+
+	Speed and duplex are reported by drivers of physical links only.
+	Addresses are in CIDR notation.
+*/
+type InterfaceLink struct {
+	Kind      InterfaceKind `json:"kind"`
+	OperState string        `json:"oper_state"` // "up", "down", "unknown", "dormant", "lowerlayerdown"
+	Carrier   bool          `json:"carrier"`
+	SpeedMbps uint64        `json:"speed_mbps"` // zero when unknown
+	Duplex    string        `json:"duplex"`     // "full", "half" or "unknown"
+	MTU       uint64        `json:"mtu"`
+	MAC       string        `json:"mac"`
+	Master    string        `json:"master"` // bridge or bond the interface belongs to
+	IPv4      []string      `json:"ipv4"`
+	IPv6      []string      `json:"ipv6"`
 }
 
 /*
@@ -110,6 +310,57 @@ type MemoryMetrics struct {
 
 	UsedPercent     float64 `json:"used_percent"`      // percentage of used memory
 	SwapUsedPercent float64 `json:"swap_used_percent"` // percentage of used swap
+
+	Buffers      uint64 `json:"buffers"`      // block device buffers (bytes)
+	Cached       uint64 `json:"cached"`       // page cache without swap cache (bytes)
+	SwapCached   uint64 `json:"swap_cached"`  // swapped out memory kept in RAM as well (bytes)
+	Shmem        uint64 `json:"shmem"`        // shared memory and tmpfs (bytes)
+	Slab         uint64 `json:"slab"`         // kernel slab allocations (bytes)
+	SReclaimable uint64 `json:"sreclaimable"` // reclaimable part of slab (bytes)
+	SUnreclaim   uint64 `json:"sunreclaim"`   // unreclaimable part of slab (bytes)
+	Dirty        uint64 `json:"dirty"`        // waiting to be written back to disk (bytes)
+	Writeback    uint64 `json:"writeback"`    // being written back to disk (bytes)
+	CommittedAS  uint64 `json:"committed_as"` // allocated memory, even if not used yet (bytes)
+	CommitLimit  uint64 `json:"commit_limit"` // allocation limit under strict overcommit (bytes)
+
+	HugePages HugePages `json:"huge_pages"` // preallocated huge pages pool
+	Zswap     uint64    `json:"zswap"`      // memory used by the zswap pool (bytes)
+	Zswapped  uint64    `json:"zswapped"`   // swapped out memory held by zswap (bytes)
+	Zram      Zram      `json:"zram"`       // all zram devices together
+
+	Activity MemoryActivity `json:"activity"` // paging activity from /proc/vmstat
+}
+
+// HugePages – preallocated huge pages pool.
+type HugePages struct {
+	Total    uint64 `json:"total"`     // pages in the pool
+	Free     uint64 `json:"free"`      // pages not allocated yet
+	Reserved uint64 `json:"reserved"`  // pages promised but not faulted in yet
+	Surplus  uint64 `json:"surplus"`   // pages above the pool size
+	PageSize uint64 `json:"page_size"` // default huge page size (bytes)
+}
+
+// Zram – compressed RAM block devices.
+type Zram struct {
+	Devices    int    `json:"devices"`
+	OrigData   uint64 `json:"orig_data"`  // uncompressed data stored (bytes)
+	Compressed uint64 `json:"compressed"` // compressed data stored (bytes)
+	MemUsed    uint64 `json:"mem_used"`   // memory used including metadata (bytes)
+}
+
+/*
+MemoryActivity – paging activity from /proc/vmstat.
+
+	Rates are per second since the previous scrape, zero on the first one.
+*/
+type MemoryActivity struct {
+	PageFaultsPerSec  uint64 `json:"page_faults_per_sec"`  // minor and major page faults
+	MajorFaultsPerSec uint64 `json:"major_faults_per_sec"` // page faults that needed I/O
+	SwapInPerSec      uint64 `json:"swap_in_per_sec"`      // pages read from swap
+	SwapOutPerSec     uint64 `json:"swap_out_per_sec"`     // pages written to swap
+	PageInPerSec      uint64 `json:"page_in_per_sec"`      // KiB read from block devices
+	PageOutPerSec     uint64 `json:"page_out_per_sec"`     // KiB written to block devices
+	OOMKills          uint64 `json:"oom_kills"`            // processes killed by the OOM killer since boot
 }
 
 // ============================ Thermal domain structures ============================
@@ -204,3 +455,471 @@ func (p *DiskIO) SetTotalTime(ms uint64) {
 func (p *DiskIO) SetWeightedIOTime(ms uint64) {
 	p.WeightedIO = time.Millisecond * time.Duration(ms)
 }
+
+// ============================ Self monitoring domain structures ============================
+
+// WorkerSelfStats – scrape cost and outcomes of a single metric worker.
+type WorkerSelfStats struct {
+	Key          string        `json:"key"`
+	LastDuration time.Duration `json:"last_duration"` // Duration of the last scrape
+	AvgDuration  time.Duration `json:"avg_duration"`  // Average scrape duration
+	MaxDuration  time.Duration `json:"max_duration"`  // Longest scrape
+	Successes    int           `json:"successes"`     // Successful scrapes
+	Errors       int           `json:"errors"`        // Failed scrapes
+	Timeouts     int           `json:"timeouts"`      // Scrapes aborted by the deadline
+	Panics       int           `json:"panics"`        // Worker panics recovered
+	Skipped      int           `json:"skipped"`       // Planned ticks skipped by a paused or idle worker
+	Overlaps     int           `json:"overlaps"`      // Ticks rejected while the previous call still ran
+}
+
+// RuntimeStats – Go runtime state of the agent.
+type RuntimeStats struct {
+	Goroutines   int           `json:"goroutines"`     // Number of goroutines
+	HeapAlloc    uint64        `json:"heap_alloc"`     // Bytes of allocated heap objects
+	HeapInuse    uint64        `json:"heap_inuse"`     // Bytes in in-use heap spans
+	HeapSys      uint64        `json:"heap_sys"`       // Bytes of heap memory obtained from the OS
+	HeapObjects  uint64        `json:"heap_objects"`   // Number of allocated heap objects
+	NextGC       uint64        `json:"next_gc"`        // Target heap size of the next GC cycle
+	NumGC        uint32        `json:"num_gc"`         // Completed GC cycles
+	GCPauseTotal time.Duration `json:"gc_pause_total"` // Cumulative GC pause time
+	GCPauseLast  time.Duration `json:"gc_pause_last"`  // Pause of the latest GC cycle
+}
+
+// ProcessStats – OS level state of the agent process.
+type ProcessStats struct {
+	Pid             int32         `json:"pid"`
+	Threads         uint64        `json:"threads"`          // Number of threads
+	RSS             uint64        `json:"rss"`              // Resident set size (bytes)
+	VirtualMemory   uint64        `json:"virtual_memory"`   // Virtual memory size (bytes)
+	OpenFDs         int           `json:"open_fds"`         // Currently open file descriptors
+	FDSize          uint32        `json:"fd_size"`          // Allocated file descriptor slots
+	CPUUser         time.Duration `json:"cpu_user"`         // CPU time in user mode
+	CPUSystem       time.Duration `json:"cpu_system"`       // CPU time in kernel mode
+	VoluntaryCtxt   uint64        `json:"voluntary_ctxt"`   // Voluntary context switches
+	InvoluntaryCtxt uint64        `json:"involuntary_ctxt"` // Forced context switches
+}
+
+/*
+SelfMetrics – self monitoring snapshot of the agent.
+
+	Shows how expensive the agent itself is: per-worker scrape
+	cost, Go runtime and process resource usage.
+*/
+type SelfMetrics struct {
+	Workers []WorkerSelfStats `json:"workers"`
+	Runtime RuntimeStats      `json:"runtime"`
+	Process ProcessStats      `json:"process"`
+}
+
+// ============================ Top consumers ============================
+
+const (
+	TopDefaultLimit = 5   // top consumers served when no limit is requested
+	TopMaxLimit     = 100 // largest top consumers limit accepted by the API
+)
+
+// TopSort – order of the top resource consumers.
+type TopSort string
+
+const (
+	TopSortCPU TopSort = "cpu" // by CPU usage
+	TopSortMem TopSort = "mem" // by memory usage
+	TopSortIO  TopSort = "io"  // by storage read and write rate
+)
+
+// ParseTopSort – parses the sort order, an empty string is the CPU order.
+func ParseTopSort(s string) (TopSort, bool) {
+	switch TopSort(s) {
+	case "", TopSortCPU:
+		return TopSortCPU, true
+	case TopSortMem, TopSortIO:
+		return TopSort(s), true
+	default:
+		return "", false
+	}
+}
+
+// topUsage – resource usage a top list is ordered by.
+type topUsage struct {
+	cpu float64
+	mem float64
+	io  float64
+}
+
+// compare – orders a before b when it uses more of the sorted resource.
+func (s TopSort) compare(a, b topUsage) int {
+	switch s {
+	case TopSortMem:
+		return cmp.Compare(b.mem, a.mem)
+	case TopSortIO:
+		return cmp.Compare(b.io, a.io)
+	default:
+		return cmp.Compare(b.cpu, a.cpu)
+	}
+}
+
+// topBy – returns a sorted copy of up to limit items, equal usage is ordered by tie.
+func topBy[T any](items []T, by TopSort, limit int, usage func(T) topUsage, tie func(a, b T) int) []T {
+	top := slices.Clone(items)
+	slices.SortFunc(top, func(a, b T) int {
+		return cmp.Or(by.compare(usage(a), usage(b)), tie(a, b))
+	})
+
+	if limit > 0 && len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
+
+// ============================ Process domain structures ============================
+
+// ProcessInfo – resource usage of a single host process.
+type ProcessInfo struct {
+	PID        int     `json:"pid"`
+	PPID       int     `json:"ppid"`
+	Name       string  `json:"name"`          // Command name (comm)
+	Command    string  `json:"command"`       // Full command line
+	State      string  `json:"state"`         // Process state (R, S, D, Z, ...)
+	User       string  `json:"user"`          // Real user name, uid when unresolved
+	CPUPercent float64 `json:"cpu_percent"`   // CPU usage since the previous scrape, 100 is one core
+	RSS        uint64  `json:"rss"`           // Resident set size (bytes)
+	PSS        *uint64 `json:"pss,omitempty"` // Proportional set size (bytes), read for the largest processes by RSS only, nil when not read
+	Threads    int     `json:"threads"`       // Number of threads
+	FDs        int     `json:"fds"`           // Open file descriptors, zero when unreadable
+	ReadBytes  uint64  `json:"read_bytes"`    // Bytes read from storage
+	WriteBytes uint64  `json:"write_bytes"`   // Bytes written to storage
+	ReadRate   uint64  `json:"read_rate"`     // Storage read bytes per second
+	WriteRate  uint64  `json:"write_rate"`    // Storage write bytes per second
+}
+
+/*
+ProcessList – processes of the host.
+*/
+type ProcessList []ProcessInfo
+
+/*
+Top – returns up to limit processes in the sort order, ties go by PID.
+
+	The list itself is not modified. A limit below one returns
+	all processes.
+*/
+func (pl ProcessList) Top(by TopSort, limit int) ProcessList {
+	return topBy(pl, by, limit,
+		func(p ProcessInfo) topUsage {
+			return topUsage{cpu: p.CPUPercent, mem: float64(p.RSS), io: float64(p.ReadRate + p.WriteRate)}
+		},
+		func(a, b ProcessInfo) int { return cmp.Compare(a.PID, b.PID) },
+	)
+}
+
+// ============================ Watched services domain structures ============================
+
+/*
+ServiceStatus – state of a watched service.
+
+	A service is running while at least one process matches its
+	matcher. Restarts counts how many times the oldest instance was
+	replaced since the agent start, either after the service was down
+	or when its main process changed.
+*/
+type ServiceStatus struct {
+	Name       string    `json:"name"`
+	Match      string    `json:"match"`       // Matcher of the service, e.g. "name:^nginx$"
+	Running    bool      `json:"running"`     // At least one instance is running
+	Instances  int       `json:"instances"`   // Number of matching processes
+	PIDs       []int     `json:"pids"`        // PIDs of the instances, oldest first
+	StartedAt  time.Time `json:"started_at"`  // Start time of the oldest instance
+	Restarts   int       `json:"restarts"`    // Restarts since the agent start
+	CPUPercent float64   `json:"cpu_percent"` // CPU usage of all instances, 100 is one core
+	RSS        uint64    `json:"rss"`         // Resident set size of all instances (bytes)
+}
+
+/*
+ServiceList – watched services in the configured order.
+*/
+type ServiceList []ServiceStatus
+
+// ============================ Systemd domain structures ============================
+
+/*
+SystemdUnit – state of a watched systemd unit.
+
+	Accounting fields are zero when the unit has no accounting
+	enabled or is not a service. Timer fields are set for .timer
+	units only.
+*/
+type SystemdUnit struct {
+	Name        string    `json:"name"`         // "nginx.service"
+	Description string    `json:"description"`  //
+	LoadState   string    `json:"load_state"`   // "loaded", "not-found", "masked", ...
+	ActiveState string    `json:"active_state"` // "active", "inactive", "failed", ...
+	SubState    string    `json:"sub_state"`    // "running", "exited", "dead", ...
+	Result      string    `json:"result"`       // Result of the last run, "success" or the failure reason
+	ActiveSince time.Time `json:"active_since"` // Last transition into the active state
+
+	MainPID    uint32        `json:"main_pid"`    // Main process of a service
+	Restarts   uint32        `json:"restarts"`    // Automatic restarts of a service (NRestarts)
+	Memory     uint64        `json:"memory"`      // Current memory usage (bytes)
+	CPUUsage   time.Duration `json:"cpu_usage"`   // Consumed CPU time
+	CPUPercent float64       `json:"cpu_percent"` // CPU usage since the previous scrape, 100 is one core
+
+	LastTrigger time.Time `json:"last_trigger"` // Last run of a timer
+	NextElapse  time.Time `json:"next_elapse"`  // Next run of a timer
+}
+
+/*
+SystemdMetrics – unit counts of the service manager and the watched units.
+
+	Changing counts units in activating, deactivating, reloading or
+	other transitional states.
+*/
+type SystemdMetrics struct {
+	Total       int           `json:"total"`
+	Active      int           `json:"active"`
+	Inactive    int           `json:"inactive"`
+	Failed      int           `json:"failed"`
+	Changing    int           `json:"changing"`
+	FailedUnits []string      `json:"failed_units"`
+	Units       []SystemdUnit `json:"units"` // Watched units in the configured order
+}
+
+// ============================ Socket domain structures ============================
+
+// ListeningSocket – TCP socket in the LISTEN state or bound UDP socket.
+type ListeningSocket struct {
+	Protocol string `json:"protocol"` // "tcp", "tcp6", "udp" or "udp6"
+	Address  string `json:"address"`  // local address, "0.0.0.0" or "::" for all
+	Port     uint64 `json:"port"`
+	UID      uint64 `json:"uid"`
+	Inode    uint64 `json:"inode"`
+	PID      int    `json:"pid"`     // owning process, zero when unknown
+	Process  string `json:"process"` // command name of the owning process
+}
+
+// RemoteConnections – TCP connections with a single remote address.
+type RemoteConnections struct {
+	Address     string `json:"address"`
+	Connections int    `json:"connections"`
+}
+
+/*
+SocketMetrics – sockets of the host from /proc/net.
+
+	TCP states and remotes cover both IPv4 and IPv6. Listening sockets
+	are ordered by port and protocol, remotes by the number of
+	connections, most first.
+*/
+type SocketMetrics struct {
+	TCP        int                 `json:"tcp"`         // TCP sockets, IPv4 and IPv6
+	UDP        int                 `json:"udp"`         // UDP sockets, IPv4 and IPv6
+	UNIX       int                 `json:"unix"`        // Unix domain sockets
+	UNIXListen int                 `json:"unix_listen"` // Unix domain sockets accepting connections
+	TCPStates  map[string]int      `json:"tcp_states"`  // e.g. "ESTABLISHED", "TIME_WAIT", "LISTEN"
+	Listening  []ListeningSocket   `json:"listening"`
+	Remotes    []RemoteConnections `json:"remotes"` // connections per remote address, listening sockets excluded
+}
+
+// ============================ Network protocol domain structures ============================
+
+// ProtocolCounter – cumulative kernel protocol counter with its rate since the previous scrape.
+type ProtocolCounter struct {
+	Total  uint64  `json:"total"`
+	PerSec float64 `json:"per_sec"`
+}
+
+// TCPProtocol – TCP counters of /proc/net/snmp and TcpExt of /proc/net/netstat.
+type TCPProtocol struct {
+	CurrEstab         uint64          `json:"curr_estab"` // currently established connections
+	ActiveOpens       ProtocolCounter `json:"active_opens"`
+	PassiveOpens      ProtocolCounter `json:"passive_opens"`
+	AttemptFails      ProtocolCounter `json:"attempt_fails"`
+	EstabResets       ProtocolCounter `json:"estab_resets"`
+	OutResets         ProtocolCounter `json:"out_resets"`
+	InSegs            ProtocolCounter `json:"in_segs"`
+	OutSegs           ProtocolCounter `json:"out_segs"`
+	Retransmits       ProtocolCounter `json:"retransmits"`
+	RetransmitPercent float64         `json:"retransmit_percent"` // retransmitted share of sent segments since the previous scrape
+	InErrors          ProtocolCounter `json:"in_errors"`
+	InCsumErrors      ProtocolCounter `json:"in_csum_errors"`
+
+	ListenOverflows ProtocolCounter `json:"listen_overflows"` // accept queue was full
+	ListenDrops     ProtocolCounter `json:"listen_drops"`     // SYNs dropped on listening sockets
+	Timeouts        ProtocolCounter `json:"timeouts"`         // retransmission timer expirations
+	SynRetrans      ProtocolCounter `json:"syn_retrans"`      // retransmitted SYN and SYN-ACK segments
+	FastRetrans     ProtocolCounter `json:"fast_retrans"`
+	LostRetransmit  ProtocolCounter `json:"lost_retransmit"` // retransmissions that were lost again
+	AbortOnTimeout  ProtocolCounter `json:"abort_on_timeout"`
+	BacklogDrop     ProtocolCounter `json:"backlog_drop"` // segments dropped on a full socket backlog
+}
+
+// UDPProtocol – UDP counters of /proc/net/snmp.
+type UDPProtocol struct {
+	InDatagrams  ProtocolCounter `json:"in_datagrams"`
+	OutDatagrams ProtocolCounter `json:"out_datagrams"`
+	NoPorts      ProtocolCounter `json:"no_ports"` // datagrams to ports without a socket
+	InErrors     ProtocolCounter `json:"in_errors"`
+	RcvbufErrors ProtocolCounter `json:"rcvbuf_errors"` // datagrams dropped on a full receive buffer
+	SndbufErrors ProtocolCounter `json:"sndbuf_errors"`
+}
+
+// ICMPProtocol – ICMP counters of /proc/net/snmp.
+type ICMPProtocol struct {
+	InMsgs         ProtocolCounter `json:"in_msgs"`
+	OutMsgs        ProtocolCounter `json:"out_msgs"`
+	InErrors       ProtocolCounter `json:"in_errors"`
+	OutErrors      ProtocolCounter `json:"out_errors"`
+	InDestUnreachs ProtocolCounter `json:"in_dest_unreachs"`
+}
+
+/*
+NetProtocolMetrics – kernel network protocol counters.
+
+	Rates are zero on the first scrape. TcpExt counters a kernel
+	does not export stay zero.
+*/
+type NetProtocolMetrics struct {
+	TCP  TCPProtocol  `json:"tcp"`
+	UDP  UDPProtocol  `json:"udp"`
+	ICMP ICMPProtocol `json:"icmp"`
+}
+
+// ============================ Pressure domain structures ============================
+
+// PressureLine – stall statistics of a single PSI line.
+type PressureLine struct {
+	Avg10  float64 `json:"avg10"`  // Share of time stalled over the last 10s (percent)
+	Avg60  float64 `json:"avg60"`  // Share of time stalled over the last 60s (percent)
+	Avg300 float64 `json:"avg300"` // Share of time stalled over the last 300s (percent)
+
+	Total        time.Duration `json:"total"`         // Cumulative stall time
+	Stall        time.Duration `json:"stall"`         // Stall time since the previous scrape
+	StallPercent float64       `json:"stall_percent"` // Stall time since the previous scrape (percent of the interval)
+}
+
+/*
+PressureStats – PSI of a single resource.
+
+	Some is the time at least one task stalled on the resource, Full
+	is the time all non-idle tasks stalled at once. Full of the host
+	CPU is zero on kernels before 5.13.
+*/
+type PressureStats struct {
+	Some PressureLine `json:"some"`
+	Full PressureLine `json:"full"`
+}
+
+// Pressure – PSI of the CPU, memory and I/O resources.
+type Pressure struct {
+	CPU    PressureStats `json:"cpu"`
+	Memory PressureStats `json:"memory"`
+	IO     PressureStats `json:"io"`
+}
+
+// CgroupPressure – PSI of a cgroup v2 group.
+type CgroupPressure struct {
+	Path     string     `json:"path"` // path below the cgroup root
+	Kind     CgroupKind `json:"kind"`
+	Name     string     `json:"name"` // unit name, or the short container ID
+	Pressure Pressure   `json:"pressure"`
+}
+
+/*
+PressureMetrics – Pressure Stall Information of the host and its cgroups.
+
+	Available is false on kernels built without PSI or booted with
+	psi=0, all values are zero then. Cgroups are empty without the
+	cgroup v2 hierarchy.
+*/
+type PressureMetrics struct {
+	Available bool             `json:"available"`
+	Host      Pressure         `json:"host"`
+	Cgroups   []CgroupPressure `json:"cgroups"`
+}
+
+/*
+TopCgroups – returns up to limit cgroups under the most pressure, ties go by path.
+
+	The order is the some avg10 of the CPU, memory or I/O resource.
+	A limit below one returns all cgroups.
+*/
+func (pm PressureMetrics) TopCgroups(by TopSort, limit int) []CgroupPressure {
+	return topBy(pm.Cgroups, by, limit,
+		func(c CgroupPressure) topUsage {
+			p := c.Pressure
+			return topUsage{cpu: p.CPU.Some.Avg10, mem: p.Memory.Some.Avg10, io: p.IO.Some.Avg10}
+		},
+		func(a, b CgroupPressure) int { return cmp.Compare(a.Path, b.Path) },
+	)
+}
+
+// ============================ Cgroup domain structures ============================
+
+// CgroupKind – what a cgroup belongs to, recognized by its path.
+type CgroupKind string
+
+const (
+	CgroupSlice   CgroupKind = "slice"   // systemd slice, e.g. system.slice
+	CgroupService CgroupKind = "service" // systemd service
+	CgroupScope   CgroupKind = "scope"   // systemd scope, e.g. a user session
+	CgroupDocker  CgroupKind = "docker"  // Docker container
+	CgroupPodman  CgroupKind = "podman"  // podman container
+	CgroupOther   CgroupKind = "other"   // any other cgroup
+)
+
+// CgroupIO – storage I/O of a cgroup on a single device.
+type CgroupIO struct {
+	Device     string `json:"device"` // block device name, "MAJ:MIN" when unresolved
+	ReadBytes  uint64 `json:"read_bytes"`
+	WriteBytes uint64 `json:"write_bytes"`
+	ReadOps    uint64 `json:"read_ops"`
+	WriteOps   uint64 `json:"write_ops"`
+}
+
+/*
+CgroupMetrics – resource usage of a cgroup v2 group.
+
+	Usage of a cgroup includes all of its descendants, so a slice
+	covers every service and container below it.
+*/
+type CgroupMetrics struct {
+	Path  string     `json:"path"` // path below the cgroup root, e.g. "/system.slice/nginx.service"
+	Kind  CgroupKind `json:"kind"`
+	Name  string     `json:"name"`  // unit name, or the short container ID
+	Slice string     `json:"slice"` // nearest systemd slice, empty outside slices
+
+	CPUUsage   time.Duration `json:"cpu_usage"`   // Consumed CPU time
+	CPUPercent float64       `json:"cpu_percent"` // CPU usage since the previous scrape, 100 is one core
+
+	MemoryCurrent uint64 `json:"memory_current"` // Current memory usage (bytes)
+	MemoryMax     uint64 `json:"memory_max"`     // Memory limit (bytes), zero is unlimited
+	MemoryPeak    uint64 `json:"memory_peak"`    // Peak memory usage (bytes), zero on kernels without memory.peak
+	OOM           uint64 `json:"oom"`            // Times the limit was hit and reclaim failed
+	OOMKill       uint64 `json:"oom_kill"`       // Processes killed by the OOM killer
+
+	IO        []CgroupIO `json:"io"`
+	ReadRate  uint64     `json:"read_rate"`  // Storage read bytes per second of all devices
+	WriteRate uint64     `json:"write_rate"` // Storage write bytes per second of all devices
+
+	Pids    uint64 `json:"pids"`     // Current number of tasks
+	PidsMax uint64 `json:"pids_max"` // Task limit, zero is unlimited
+}
+
+/*
+CgroupList – cgroups of the host, the root cgroup excluded.
+*/
+type CgroupList []CgroupMetrics
+
+/*
+Top – returns up to limit cgroups in the sort order, ties go by path.
+
+	Memory order uses the current memory usage. The list itself
+	is not modified. A limit below one returns all cgroups.
+*/
+func (cl CgroupList) Top(by TopSort, limit int) CgroupList {
+	return topBy(cl, by, limit,
+		func(c CgroupMetrics) topUsage {
+			return topUsage{cpu: c.CPUPercent, mem: float64(c.MemoryCurrent), io: float64(c.ReadRate + c.WriteRate)}
+		},
+		func(a, b CgroupMetrics) int { return cmp.Compare(a.Path, b.Path) },
+	)
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+)
+
+// DefaultHistorySize – default number of samples kept per key.
+const DefaultHistorySize = 360
+
+/*
+ringBuffer – fixed capacity buffer of samples ordered by time.
+
+	The storage is allocated once, the oldest sample is overwritten
+	when the buffer is full.
+*/
+type ringBuffer struct {
+	mu      sync.RWMutex
+	samples []domain.Sample
+	head    int // index of the next write
+	size    int
+}
+
+func newRingBuffer(capacity int) *ringBuffer {
+	return &ringBuffer{
+		samples: make([]domain.Sample, capacity),
+	}
+}
+
+func (rb *ringBuffer) push(s domain.Sample) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	rb.samples[rb.head] = s
+	rb.head = (rb.head + 1) % len(rb.samples)
+	if rb.size < len(rb.samples) {
+		rb.size++
+	}
+}
+
+/*
+rangeOf – returns the samples within [since, until] from the oldest one.
+
+	With a positive step only the latest sample of every step-long
+	bucket is kept, buckets are aligned to the Unix epoch.
+*/
+func (rb *ringBuffer) rangeOf(since, until time.Time, step time.Duration) []domain.Sample {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	out := make([]domain.Sample, 0, rb.size)
+	start := rb.head - rb.size
+	bucket := int64(-1)
+
+	for i := 0; i < rb.size; i++ {
+		s := rb.samples[(start+i+len(rb.samples))%len(rb.samples)]
+		if s.Timestamp.Before(since) || s.Timestamp.After(until) {
+			continue
+		}
+
+		if step > 0 {
+			b := s.Timestamp.UnixNano() / int64(step)
+			if b == bucket {
+				out[len(out)-1] = s
+				continue
+			}
+			bucket = b
+		}
+
+		out = append(out, s)
+	}
+
+	return out
+}
+
+// HistoryOption – functional option for NewHistoryStore.
+type HistoryOption func(*HistoryStore)
+
+// WithHistorySize – sets the max number of samples kept per key.
+func WithHistorySize(n int) HistoryOption {
+	return func(hs *HistoryStore) {
+		if n > 0 {
+			hs.size = n
+		}
+	}
+}
+
+/*
+WithHistoryDuration – limits the history by time.
+
+	Keys tracked with their interval get a buffer just long enough
+	to cover the duration, samples older than it are never returned.
+*/
+func WithHistoryDuration(d time.Duration) HistoryOption {
+	return func(hs *HistoryStore) {
+		if d > 0 {
+			hs.duration = d
+		}
+	}
+}
+
+/*
+HistoryStore – bounded time series of metric samples per key.
+
+	Kept apart from MetricInMemoryStore, so the latest value path
+	never waits for history writes or queries. Memory cost is fixed:
+	every key owns one preallocated ring buffer.
+*/
+type HistoryStore struct {
+	size     int
+	duration time.Duration
+
+	mu   sync.RWMutex
+	keys map[string]*ringBuffer
+}
+
+// NewHistoryStore – creates an empty history store.
+func NewHistoryStore(opts ...HistoryOption) *HistoryStore {
+	hs := &HistoryStore{
+		size: DefaultHistorySize,
+		keys: make(map[string]*ringBuffer),
+	}
+
+	for _, opt := range opts {
+		opt(hs)
+	}
+
+	return hs
+}
+
+// capacity – returns the buffer length for samples arriving every interval.
+func (hs *HistoryStore) capacity(interval time.Duration) int {
+	if hs.duration <= 0 || interval <= 0 {
+		return hs.size
+	}
+
+	n := int(hs.duration/interval) + 1
+	return min(n, hs.size)
+}
+
+// Track – preallocates the key buffer for samples arriving every interval.
+func (hs *HistoryStore) Track(key string, interval time.Duration) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	if _, ok := hs.keys[key]; !ok {
+		hs.keys[key] = newRingBuffer(hs.capacity(interval))
+	}
+}
+
+// Record – appends the sample to the key history.
+func (hs *HistoryStore) Record(key string, value any, ts time.Time) {
+	hs.mu.RLock()
+	rb, ok := hs.keys[key]
+	hs.mu.RUnlock()
+
+	if !ok {
+		hs.mu.Lock()
+		rb, ok = hs.keys[key]
+		if !ok {
+			rb = newRingBuffer(hs.capacity(0))
+			hs.keys[key] = rb
+		}
+		hs.mu.Unlock()
+	}
+
+	rb.push(domain.Sample{Timestamp: ts, Value: value})
+}
+
+/*
+Range – returns the key samples within [since, until].
+
+	Zero until means now. A positive step downsamples the series to
+	the latest sample per step. ok is false for an unknown key.
+*/
+func (hs *HistoryStore) Range(key string, since, until time.Time, step time.Duration) (samples []domain.Sample, ok bool) {
+	hs.mu.RLock()
+	rb, ok := hs.keys[key]
+	hs.mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	now := time.Now()
+	if until.IsZero() {
+		until = now
+	}
+	if hs.duration > 0 {
+		if oldest := now.Add(-hs.duration); since.Before(oldest) {
+			since = oldest
+		}
+	}
+
+	return rb.rangeOf(since, until, step), true
+}
+
+/*
+covers – reports whether the raw history of the key holds everything since the time.
+
+	True while the buffer has not wrapped yet or its oldest sample
+	is not newer than since.
+*/
+func (hs *HistoryStore) covers(key string, since time.Time) bool {
+	hs.mu.RLock()
+	rb, ok := hs.keys[key]
+	hs.mu.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	if hs.duration > 0 && since.Before(time.Now().Add(-hs.duration)) {
+		return false
+	}
+
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	if rb.size < len(rb.samples) {
+		return true
+	}
+	return !rb.samples[rb.head].Timestamp.After(since)
+}
+
+// Recorder – consumer of saved metric values.
+type Recorder interface {
+	Record(key string, value any, ts time.Time)
+}
+
+/*
+Consume – passes the events of the subscription to the recorders until ctx is done.
+
+	The subscription is closed on return.
+*/
+func Consume(ctx context.Context, sub *monitor.Subscription, recs ...Recorder) {
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			for _, rec := range recs {
+				rec.Record(ev.Key, ev.Value, ev.Timestamp)
+			}
+		}
+	}
+}
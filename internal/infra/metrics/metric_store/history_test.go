@@ -0,0 +1,70 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func sampleValues(hs *HistoryStore, key string, since, until time.Time, step time.Duration) []any {
+	samples, _ := hs.Range(key, since, until, step)
+	values := []any{}
+	for _, s := range samples {
+		values = append(values, s.Value)
+	}
+	return values
+}
+
+func Test_HistoryStore_Range(t *testing.T) {
+	base := time.Now().Add(-time.Minute).Truncate(10 * time.Second)
+
+	hs := NewHistoryStore(WithHistorySize(4))
+	for i := range 6 {
+		hs.Record("cpu", i, base.Add(time.Duration(i)*time.Second))
+	}
+
+	tests := []struct {
+		name     string
+		since    time.Time
+		until    time.Time
+		step     time.Duration
+		expected []any
+	}{
+		{"oldest overwritten", time.Time{}, time.Time{}, 0, []any{2, 3, 4, 5}},
+		{"since", base.Add(4 * time.Second), time.Time{}, 0, []any{4, 5}},
+		{"until", time.Time{}, base.Add(3 * time.Second), 0, []any{2, 3}},
+		{"step keeps latest per bucket", time.Time{}, time.Time{}, 2 * time.Second, []any{3, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sampleValues(hs, "cpu", tt.since, tt.until, tt.step); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := hs.Range("memory", time.Time{}, time.Time{}, 0); ok {
+		t.Error("unknown key must not exist")
+	}
+}
+
+func Test_HistoryStore_Duration(t *testing.T) {
+	hs := NewHistoryStore(WithHistorySize(100), WithHistoryDuration(time.Minute))
+	hs.Track("cpu", 10*time.Second)
+
+	if got := len(hs.keys["cpu"].samples); got != 7 {
+		t.Errorf("capacity %d, want 7", got)
+	}
+
+	now := time.Now()
+	hs.Record("cpu", "old", now.Add(-2*time.Minute))
+	hs.Record("cpu", "new", now.Add(-time.Second))
+
+	if got := sampleValues(hs, "cpu", time.Time{}, time.Time{}, 0); !reflect.DeepEqual(got, []any{"new"}) {
+		t.Errorf("got %v, want only samples within the duration", got)
+	}
+}
@@ -4,7 +4,6 @@
 package metricstore
 
 import (
-	"reflect"
 	"sync"
 	"time"
 
@@ -14,33 +13,63 @@ import (
 /*
 MetricInMemoryStore – in-memory storage, implements MetricRepository.
 
-	Stores a per-key preallocated pointer to a concrete value and reuses it on updates
-	to avoid repeated allocations.
+	Every key owns an entry holding the interface value it was
+	saved with, so neither SaveValue nor GetState copies the value
+	through reflection. Compile-time typing of the keys is done by
+	the readers with domain.Key.
 */
 type MetricInMemoryStore struct {
 	mu sync.RWMutex
 	db map[string]*metricEntry
 }
 
+// metricEntry – value of a single key with its update metadata.
 type metricEntry struct {
 	// per-entry mutex protects fields below
 	mu sync.RWMutex
 
-	// valuePtr stores a pointer to the concrete value (always a pointer type).
-	// We keep a pointer so we can update its Elem() without reallocating pointer/interface.
-	// Example: for a value of type T, valuePtr has type *T (stored as interface{}).
-	valuePtr any
-
+	value      any
 	lastUpdate int64 // milliseconds since epoch
 	available  bool
+	restored   bool // value is loaded from a snapshot and not refreshed yet
+}
+
+func (e *metricEntry) set(value any, ts time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.value = value
+	e.lastUpdate = ts.UnixMilli()
+	e.available = value != nil
+	e.restored = false
+}
+
+func (e *metricEntry) state() domain.MetricState {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return domain.MetricState{
+		Value:      e.value,
+		Available:  e.available,
+		LastUpdate: time.UnixMilli(e.lastUpdate),
+		Restored:   e.restored,
+	}
 }
 
-func (me *metricEntry) lastUpdateTime() time.Time {
-	return time.UnixMilli(me.lastUpdate)
+func (e *metricEntry) markRestored() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.restored = true
 }
 
-func (me *metricEntry) setLastUpdateTime(t time.Time) {
-	me.lastUpdate = t.UnixMilli()
+func (e *metricEntry) clear() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.value = nil
+	e.available = false
+	e.restored = false
+	e.lastUpdate = 0
 }
 
 // NewMetricInMemoryStore – create new in-memory storage.
@@ -50,6 +79,14 @@ func NewMetricInMemoryStore() *MetricInMemoryStore {
 	}
 }
 
+func (r *MetricInMemoryStore) entry(key string) (*metricEntry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.db[key]
+	return e, ok
+}
+
 // =========================
 // MetricRepository methods
 // =========================
@@ -57,160 +94,52 @@ func NewMetricInMemoryStore() *MetricInMemoryStore {
 /*
 SaveValue – saves value for the given key and timestamp.
 
-	Behavior:
-	- If this is the first time a value for this key is seen, allocate a pointer of the concrete type
-		and store the incoming value into it.
-	- On subsequent saves, if incoming value's concrete type matches the stored pointer's element type,
-		copy the new value into the existing storage (no new allocation for the pointer/interface).
-	- If the type differs, replace storage with a newly allocated pointer for the new type.
-
-	Thread-safety:
-	- Map-level locking for creating/accessing entries.
-	- Per-entry locking for updating value/metadata.
+	The entry of a key is created by its first save and reused by
+	the following ones. A nil value marks the key unavailable.
 */
 func (r *MetricInMemoryStore) SaveValue(key string, value any, ts time.Time) {
-
-	// ensure entry exists
-	r.mu.RLock()
-	entry, ok := r.db[key]
-	r.mu.RUnlock()
-
+	e, ok := r.entry(key)
 	if !ok {
-		// create new entry under write lock
 		r.mu.Lock()
-		entry, ok = r.db[key]
-		if !ok {
-			entry = &metricEntry{}
-			r.db[key] = entry
+		if e, ok = r.db[key]; !ok {
+			e = &metricEntry{}
+			r.db[key] = e
 		}
 		r.mu.Unlock()
 	}
 
-	// lock entry for write
-	entry.mu.Lock()
-	defer entry.mu.Unlock()
-
-	nv := reflect.ValueOf(value)
-	if !nv.IsValid() {
-		// unset value
-		entry.available = false
-		entry.setLastUpdateTime(ts)
-		return
-	}
-
-	// If we have no storage pointer yet -> allocate pointer to concrete type and set
-	if entry.valuePtr == nil {
-		var storage reflect.Value
-		if nv.Kind() == reflect.Ptr {
-			storage = reflect.New(nv.Elem().Type()) // *T
-			storage.Elem().Set(nv.Elem())
-		} else {
-			storage = reflect.New(nv.Type())
-			storage.Elem().Set(nv)
-		}
-		entry.valuePtr = storage.Interface()
-		entry.available = true
-		entry.setLastUpdateTime(ts)
-		return
-	}
-
-	// we have existing storage pointer; try to reuse it
-	ev := reflect.ValueOf(entry.valuePtr) // should be Ptr
-	if ev.Kind() == reflect.Ptr && ev.Elem().CanSet() {
-
-		target := ev.Elem()
-
-		if nv.Kind() == reflect.Ptr {
-
-			if nv.Elem().Type() == target.Type() {
-				target.Set(nv.Elem())
-				entry.available = true
-				entry.setLastUpdateTime(ts)
-				return
-			}
-		} else {
-			if nv.Type() == target.Type() {
-				target.Set(nv)
-				entry.available = true
-				entry.setLastUpdateTime(ts)
-				return
-			}
-		}
-	}
-
-	// fallback: types differ or cannot set -> allocate new storage for new type
-	var newStorage reflect.Value
-	if nv.Kind() == reflect.Ptr {
-		newStorage = reflect.New(nv.Elem().Type())
-		newStorage.Elem().Set(nv.Elem())
-	} else {
-		newStorage = reflect.New(nv.Type())
-		newStorage.Elem().Set(nv)
-	}
-	entry.valuePtr = newStorage.Interface()
-	entry.available = true
-	entry.setLastUpdateTime(ts)
+	e.set(value, ts)
 }
 
 /*
 GetState – returns domain.MetricState and whether key exists.
 
-	We return a copy of the stored value (dereferenced) so caller receives normal
-	value (not pointer to internal buffer).
+	The stored value is shared with every caller: maps and slices
+	of values such as InterfacesIOMap or ProcessList are the store's
+	own, so callers must treat them as read-only and clone before
+	modifying.
 */
 func (r *MetricInMemoryStore) GetState(key string) (domain.MetricState, bool) {
-	r.mu.RLock()
-	entry, ok := r.db[key]
-	r.mu.RUnlock()
-
+	e, ok := r.entry(key)
 	if !ok {
 		return domain.MetricState{}, false
 	}
 
-	entry.mu.RLock()
-	defer entry.mu.RUnlock()
-
-	var v any
-	if entry.available && entry.valuePtr != nil {
-		ev := reflect.ValueOf(entry.valuePtr)
-		if ev.Kind() == reflect.Ptr {
-			v = ev.Elem().Interface()
-		} else {
-			v = entry.valuePtr
-		}
-	}
-
-	return domain.MetricState{
-		Value:      v,
-		Available:  entry.available,
-		LastUpdate: entry.lastUpdateTime(),
-	}, true
+	return e.state(), true
 }
 
 /*
 Close – clears all entries and releases internal storage.
 
 	Behavior:
-	- For each entry we zero the underlying storage (if pointer), mark unavailable and delete map entries.
+	- For each entry we zero the stored value, mark it unavailable and delete map entries.
 */
 func (r *MetricInMemoryStore) Close() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	for k, entry := range r.db {
-		entry.mu.Lock()
-		if entry.valuePtr != nil {
-			ev := reflect.ValueOf(entry.valuePtr)
-			if ev.Kind() == reflect.Ptr && ev.Elem().CanSet() {
-				ev.Elem().Set(reflect.Zero(ev.Elem().Type()))
-			}
-			entry.valuePtr = nil
-		}
-
-		entry.available = false
-		entry.lastUpdate = 0
-		entry.mu.Unlock()
-
+	for k, e := range r.db {
+		e.clear()
 		delete(r.db, k)
 	}
 }
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+func Test_MetricInMemoryStore_SaveValue(t *testing.T) {
+	r := NewMetricInMemoryStore()
+	ts := time.Now().Truncate(time.Millisecond)
+	key := domain.KeyMemory.Name()
+
+	r.SaveValue(key, domain.MemoryMetrics{Used: 1}, ts)
+	state, ok := r.GetState(key)
+	if v, _ := state.Value.(domain.MemoryMetrics); !ok || !state.Available || v.Used != 1 || !state.LastUpdate.Equal(ts) {
+		t.Errorf("GetState after SaveValue = %+v", state)
+	}
+
+	// the entry is reused by a value of another type
+	r.SaveValue(key, 42, ts.Add(time.Second))
+	if state, _ := r.GetState(key); state.Value != 42 || !state.LastUpdate.Equal(ts.Add(time.Second)) {
+		t.Errorf("GetState after a value of another type = %+v", state)
+	}
+
+	r.SaveValue(key, nil, ts)
+	if state, ok := r.GetState(key); !ok || state.Available || state.Value != nil {
+		t.Errorf("GetState after nil save = %+v", state)
+	}
+
+	if _, ok := r.GetState(domain.KeySelf.Name()); ok {
+		t.Error("GetState of an unknown key must fail")
+	}
+}
+
+/*
+reflectionStore – baseline of the store replaced by interface entries.
+
+	Values were copied through reflection into a preallocated
+	pointer on save and copied out again on every read.
+*/
+type reflectionStore struct {
+	mu sync.RWMutex
+	db map[string]any // key -> *T
+}
+
+func (rs *reflectionStore) SaveValue(key string, value any) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	nv := reflect.ValueOf(value)
+	if ptr, ok := rs.db[key]; ok {
+		if target := reflect.ValueOf(ptr).Elem(); target.Type() == nv.Type() {
+			target.Set(nv)
+			return
+		}
+	}
+
+	storage := reflect.New(nv.Type())
+	storage.Elem().Set(nv)
+	rs.db[key] = storage.Interface()
+}
+
+func (rs *reflectionStore) GetState(key string) any {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return reflect.ValueOf(rs.db[key]).Elem().Interface()
+}
+
+// Benchmarks of the SaveValue and GetState paths taken by the pooler and the handlers.
+
+func BenchmarkSaveValue(b *testing.B) {
+	var v any = domain.MemoryMetrics{Used: 1} // boxed by the scrape
+	ts := time.Now()
+
+	b.Run("interface entries", func(b *testing.B) {
+		r := NewMetricInMemoryStore()
+
+		b.ReportAllocs()
+		for b.Loop() {
+			r.SaveValue("memory", v, ts)
+		}
+	})
+
+	b.Run("reflection baseline", func(b *testing.B) {
+		rs := &reflectionStore{db: make(map[string]any)}
+
+		b.ReportAllocs()
+		for b.Loop() {
+			rs.SaveValue("memory", v)
+		}
+	})
+}
+
+func BenchmarkGetState(b *testing.B) {
+	var v any = domain.MemoryMetrics{Used: 1}
+
+	b.Run("interface entries", func(b *testing.B) {
+		r := NewMetricInMemoryStore()
+		r.SaveValue("memory", v, time.Now())
+
+		b.ReportAllocs()
+		for b.Loop() {
+			state, _ := r.GetState("memory")
+			_ = state.Value.(domain.MemoryMetrics)
+		}
+	})
+
+	b.Run("reflection baseline", func(b *testing.B) {
+		rs := &reflectionStore{db: make(map[string]any)}
+		rs.SaveValue("memory", v)
+
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = rs.GetState("memory").(domain.MemoryMetrics)
+		}
+	})
+}
@@ -0,0 +1,399 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+// RollupTier – bucket resolution and how long its buckets are kept.
+type RollupTier struct {
+	Resolution time.Duration
+	Retention  time.Duration
+}
+
+func (t RollupTier) capacity() int {
+	return int(t.Retention / t.Resolution)
+}
+
+/*
+DefaultRollupTiers – 1m buckets for a day, 5m for a week and 1h for a month.
+
+	Every series costs about 4200 buckets of 48 bytes (~200KB) in total,
+	allocated on its first sample.
+*/
+var DefaultRollupTiers = []RollupTier{
+	{Resolution: time.Minute, Retention: 24 * time.Hour},
+	{Resolution: 5 * time.Minute, Retention: 7 * 24 * time.Hour},
+	{Resolution: time.Hour, Retention: 30 * 24 * time.Hour},
+}
+
+// bucket – min/max/sum/last aggregate of the samples within a resolution step.
+type bucket struct {
+	start int64 // unix nanoseconds
+	min   float64
+	max   float64
+	sum   float64
+	last  float64
+	count int64
+}
+
+func (b *bucket) add(v float64) {
+	b.min = min(b.min, v)
+	b.max = max(b.max, v)
+	b.sum += v
+	b.last = v
+	b.count++
+}
+
+func (b bucket) point() domain.SeriesPoint {
+	return domain.SeriesPoint{
+		Timestamp: time.Unix(0, b.start),
+		Min:       b.min,
+		Max:       b.max,
+		Avg:       b.sum / float64(b.count),
+		Last:      b.last,
+		Count:     int(b.count),
+	}
+}
+
+// bucketRing – fixed capacity ring of consecutive buckets of one tier.
+type bucketRing struct {
+	resolution time.Duration
+	buckets    []bucket
+	head       int // index of the next write
+	size       int
+}
+
+func newBucketRing(t RollupTier) *bucketRing {
+	return &bucketRing{
+		resolution: t.Resolution,
+		buckets:    make([]bucket, t.capacity()),
+	}
+}
+
+// add – aggregates the sample into its bucket. Samples older than the latest bucket are dropped.
+func (br *bucketRing) add(ts time.Time, v float64) {
+	start := ts.Truncate(br.resolution).UnixNano()
+
+	if br.size > 0 {
+		latest := &br.buckets[(br.head-1+len(br.buckets))%len(br.buckets)]
+		if latest.start == start {
+			latest.add(v)
+			return
+		}
+		if latest.start > start {
+			return
+		}
+	}
+
+	br.buckets[br.head] = bucket{start: start, min: v, max: v, sum: v, last: v, count: 1}
+	br.head = (br.head + 1) % len(br.buckets)
+	if br.size < len(br.buckets) {
+		br.size++
+	}
+}
+
+// covers – reports whether the ring holds every bucket since the time.
+func (br *bucketRing) covers(since time.Time) bool {
+	if br.size < len(br.buckets) {
+		return true
+	}
+	return br.buckets[br.head].start <= since.UnixNano()
+}
+
+func (br *bucketRing) rangeOf(since, until time.Time) []domain.SeriesPoint {
+	out := make([]domain.SeriesPoint, 0)
+	start := br.head - br.size
+	from := since.Truncate(br.resolution).UnixNano()
+
+	for i := 0; i < br.size; i++ {
+		b := br.buckets[(start+i+len(br.buckets))%len(br.buckets)]
+		if b.start < from || time.Unix(0, b.start).After(until) {
+			continue
+		}
+		out = append(out, b.point())
+	}
+
+	return out
+}
+
+// seriesRollup – all tiers of a single named series.
+type seriesRollup struct {
+	mu    sync.RWMutex
+	tiers []*bucketRing
+	last  int64 // unix nanoseconds of the newest sample
+}
+
+func (sr *seriesRollup) add(ts time.Time, v float64) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	for _, t := range sr.tiers {
+		t.add(ts, v)
+	}
+	sr.last = max(sr.last, ts.UnixNano())
+}
+
+func (sr *seriesRollup) lastSample() int64 {
+	sr.mu.RLock()
+	defer sr.mu.RUnlock()
+
+	return sr.last
+}
+
+/*
+mergePoints – downsamples points to the step.
+
+	Points of the same step-long bucket aligned to the Unix epoch
+	are merged into one, the average is weighted by sample count.
+*/
+func mergePoints(points []domain.SeriesPoint, step time.Duration) []domain.SeriesPoint {
+	if step <= 0 || len(points) == 0 {
+		return points
+	}
+
+	out := make([]domain.SeriesPoint, 0, len(points))
+	for _, p := range points {
+		start := p.Timestamp.Truncate(step)
+
+		if n := len(out); n > 0 && out[n-1].Timestamp.Equal(start) {
+			m := &out[n-1]
+			total := m.Count + p.Count
+			m.Avg = (m.Avg*float64(m.Count) + p.Avg*float64(p.Count)) / float64(total)
+			m.Min = min(m.Min, p.Min)
+			m.Max = max(m.Max, p.Max)
+			m.Last = p.Last
+			m.Count = total
+			continue
+		}
+
+		p.Timestamp = start
+		out = append(out, p)
+	}
+
+	return out
+}
+
+// RollupOption – functional option for NewRollupStore.
+type RollupOption func(*RollupStore)
+
+// WithRollupTiers – replaces the default rollup tiers. Tiers must go from the finest resolution.
+func WithRollupTiers(tiers ...RollupTier) RollupOption {
+	return func(rs *RollupStore) {
+		if len(tiers) > 0 {
+			rs.tiers = tiers
+		}
+	}
+}
+
+/*
+RollupStore – tiered aggregates of the numeric series of every metric key.
+
+	Values are flattened by FlattenSeries and aggregated into every
+	tier at once. Queries pick the resolution from the requested range:
+	the raw history while it still covers the range and the step is
+	finer than the first tier, otherwise the coarsest tier not coarser
+	than the step among the ones reaching back to since.
+	A series without samples for the longest tier retention is dropped.
+*/
+type RollupStore struct {
+	raw   *HistoryStore
+	tiers []RollupTier
+
+	mu     sync.RWMutex
+	series map[string]map[string]*seriesRollup // key -> series name -> rollup
+	swept  map[string]time.Time                // key -> time of the last stale series sweep
+}
+
+// NewRollupStore – creates a rollup store over the raw history.
+func NewRollupStore(raw *HistoryStore, opts ...RollupOption) *RollupStore {
+	rs := &RollupStore{
+		raw:    raw,
+		tiers:  DefaultRollupTiers,
+		series: make(map[string]map[string]*seriesRollup),
+		swept:  make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(rs)
+	}
+
+	return rs
+}
+
+func (rs *RollupStore) newSeriesRollup() *seriesRollup {
+	sr := &seriesRollup{tiers: make([]*bucketRing, len(rs.tiers))}
+	for i, t := range rs.tiers {
+		sr.tiers[i] = newBucketRing(t)
+	}
+	return sr
+}
+
+// Record – aggregates the numeric series of the value.
+func (rs *RollupStore) Record(key string, value any, ts time.Time) {
+	flat := FlattenSeries(value)
+	if len(flat) == 0 {
+		return
+	}
+
+	for name, v := range flat {
+		rs.seriesRollup(key, name).add(ts, v)
+	}
+
+	rs.evictStale(key, ts)
+}
+
+// retention – the longest retention among the tiers.
+func (rs *RollupStore) retention() time.Duration {
+	var longest time.Duration
+	for _, t := range rs.tiers {
+		longest = max(longest, t.Retention)
+	}
+	return longest
+}
+
+/*
+evictStale – drops the series of the key without samples for the longest retention.
+
+	Interfaces, disks and sensors come and go (veths, hot-plugged
+	disks), their series would be kept forever otherwise. The sweep
+	runs at most once per finest tier resolution of a key.
+*/
+func (rs *RollupStore) evictStale(key string, ts time.Time) {
+	rs.mu.RLock()
+	due := ts.Sub(rs.swept[key]) >= rs.tiers[0].Resolution
+	rs.mu.RUnlock()
+
+	if !due {
+		return
+	}
+
+	cutoff := ts.Add(-rs.retention()).UnixNano()
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.swept[key] = ts
+	for name, sr := range rs.series[key] {
+		if sr.lastSample() < cutoff {
+			delete(rs.series[key], name)
+		}
+	}
+}
+
+func (rs *RollupStore) seriesRollup(key, name string) *seriesRollup {
+	rs.mu.RLock()
+	sr, ok := rs.series[key][name]
+	rs.mu.RUnlock()
+
+	if ok {
+		return sr
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	names, ok := rs.series[key]
+	if !ok {
+		names = make(map[string]*seriesRollup)
+		rs.series[key] = names
+	}
+
+	if sr, ok = names[name]; !ok {
+		sr = rs.newSeriesRollup()
+		names[name] = sr
+	}
+
+	return sr
+}
+
+// Series – returns the sorted series names of the key.
+func (rs *RollupStore) Series(key string) ([]string, bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	names, ok := rs.series[key]
+	if !ok {
+		return nil, false
+	}
+
+	out := make([]string, 0, len(names))
+	for name := range names {
+		out = append(out, name)
+	}
+	slices.Sort(out)
+
+	return out, true
+}
+
+/*
+Query – returns the series points within [since, until].
+
+	Zero until means now, zero since means one hour ago.
+	resolution is zero for raw samples. ok is false for an unknown series.
+*/
+func (rs *RollupStore) Query(key, name string, since, until time.Time, step time.Duration) (points []domain.SeriesPoint, resolution time.Duration, ok bool) {
+	rs.mu.RLock()
+	sr, ok := rs.series[key][name]
+	rs.mu.RUnlock()
+
+	if !ok {
+		return nil, 0, false
+	}
+
+	now := time.Now()
+	if until.IsZero() {
+		until = now
+	}
+	if since.IsZero() {
+		since = now.Add(-time.Hour)
+	}
+
+	if step < rs.tiers[0].Resolution && rs.raw != nil && rs.raw.covers(key, since) {
+		return mergePoints(rs.rawPoints(key, name, since, until), step), 0, true
+	}
+
+	sr.mu.RLock()
+	defer sr.mu.RUnlock()
+
+	// coarsest tier not coarser than the step among the ones covering since
+	var tier *bucketRing
+	for _, t := range sr.tiers {
+		if !t.covers(since) {
+			continue
+		}
+		if tier == nil || t.resolution <= step {
+			tier = t
+		}
+	}
+
+	if tier == nil {
+		tier = sr.tiers[len(sr.tiers)-1]
+	}
+
+	return mergePoints(tier.rangeOf(since, until), step), tier.resolution, true
+}
+
+// rawPoints – flattens the raw samples of the key into points of the series.
+func (rs *RollupStore) rawPoints(key, name string, since, until time.Time) []domain.SeriesPoint {
+	samples, _ := rs.raw.Range(key, since, until, 0)
+
+	points := make([]domain.SeriesPoint, 0, len(samples))
+	for _, s := range samples {
+		v, ok := FlattenSeries(s.Value)[name]
+		if !ok {
+			continue
+		}
+		points = append(points, domain.SeriesPoint{
+			Timestamp: s.Timestamp, Min: v, Max: v, Avg: v, Last: v, Count: 1,
+		})
+	}
+
+	return points
+}
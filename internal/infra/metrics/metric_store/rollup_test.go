@@ -0,0 +1,126 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+func Test_FlattenSeries(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected map[string]float64
+	}{
+		{
+			"cpu",
+			domain.CpuMetrics{
+				Average: domain.CpuCoreMetrics{Load: 12.5, Frequency: 3200},
+				Cores:   []domain.CpuCoreMetrics{{Load: 10}, {Load: 15}},
+			},
+			map[string]float64{"load": 12.5, "frequency": 3200, "cores.0.load": 10, "cores.1.load": 15},
+		},
+		{
+			"thermal",
+			domain.ThermalMetricsMap{"cpu_pkg": {Current: 54, Max: 90}},
+			map[string]float64{"cpu_pkg.current": 54},
+		},
+		{"not numeric", domain.Partitions{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlattenSeries(tt.value); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_RollupStore_Query(t *testing.T) {
+	tiers := []RollupTier{
+		{Resolution: time.Minute, Retention: 3 * time.Minute},
+		{Resolution: 5 * time.Minute, Retention: time.Hour},
+	}
+	rs := NewRollupStore(nil, WithRollupTiers(tiers...))
+
+	base := time.Now().Add(-50 * time.Minute).Truncate(5 * time.Minute)
+	for i := range 10 { // one sample per minute, loads 0..9
+		rs.Record("cpu_usage", domain.CpuMetrics{Average: domain.CpuCoreMetrics{Load: float64(i)}}, base.Add(time.Duration(i)*time.Minute))
+	}
+
+	// 1m tier wrapped and keeps the last 3 minutes only
+	points, res, ok := rs.Query("cpu_usage", "load", base.Add(7*time.Minute), time.Time{}, 0)
+	if !ok || res != time.Minute || len(points) != 3 || points[0].Last != 7 {
+		t.Errorf("recent range: res=%v points=%+v", res, points)
+	}
+
+	// older range falls back to the 5m tier
+	points, res, _ = rs.Query("cpu_usage", "load", base, time.Time{}, 0)
+	if res != 5*time.Minute || len(points) != 2 {
+		t.Fatalf("old range: res=%v points=%+v", res, points)
+	}
+
+	expected := domain.SeriesPoint{Timestamp: base, Min: 0, Max: 4, Avg: 2, Last: 4, Count: 5}
+	if points[0] != expected {
+		t.Errorf("got %+v, want %+v", points[0], expected)
+	}
+
+	// step merges the buckets
+	points, _, _ = rs.Query("cpu_usage", "load", base, time.Time{}, 10*time.Minute)
+	if len(points) > 2 || points[len(points)-1].Max != 9 {
+		t.Errorf("merged points %+v", points)
+	}
+
+	if _, _, ok := rs.Query("cpu_usage", "unknown", base, time.Time{}, 0); ok {
+		t.Error("unknown series must not exist")
+	}
+}
+
+func Test_RollupStore_evictStale(t *testing.T) {
+	tiers := []RollupTier{
+		{Resolution: time.Second, Retention: 10 * time.Second},
+		{Resolution: 10 * time.Second, Retention: time.Minute},
+	}
+	rs := NewRollupStore(nil, WithRollupTiers(tiers...))
+
+	base := time.Now().Add(-time.Hour)
+	rs.Record("net_io", domain.InterfacesIOMap{"eth0": {}, "veth1a2b": {}}, base)
+
+	names := func() (eth, veth int) {
+		series, _ := rs.Series("net_io")
+		for _, name := range series {
+			switch {
+			case strings.HasPrefix(name, "eth0."):
+				eth++
+			case strings.HasPrefix(name, "veth1a2b."):
+				veth++
+			}
+		}
+		return eth, veth
+	}
+
+	if eth, veth := names(); eth == 0 || veth == 0 {
+		t.Fatalf("series of eth0 = %d, veth1a2b = %d, want both", eth, veth)
+	}
+
+	// container stopped, the veth is kept for the longest retention
+	rs.Record("net_io", domain.InterfacesIOMap{"eth0": {}}, base.Add(30*time.Second))
+	if _, veth := names(); veth == 0 {
+		t.Error("veth1a2b series evicted before the retention")
+	}
+
+	rs.Record("net_io", domain.InterfacesIOMap{"eth0": {}}, base.Add(2*time.Minute))
+	if eth, veth := names(); eth == 0 || veth != 0 {
+		t.Errorf("series of eth0 = %d, veth1a2b = %d, want veth1a2b evicted", eth, veth)
+	}
+	if _, _, ok := rs.Query("net_io", "veth1a2b.bytes_per_sec.rx", base, time.Time{}, 0); ok {
+		t.Error("evicted series must not exist")
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"strconv"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+/*
+FlattenSeries – extracts the numeric fields of a domain value into named series.
+
+	Names are relative to the metric key and joined by dots,
+	e.g. "cores.0.load" for cpu_usage or "eth0.bytes_per_sec.rx" for net_io.
+	Returns nil for values without numeric series.
+*/
+func FlattenSeries(value any) map[string]float64 {
+	switch v := value.(type) {
+	case domain.CpuMetrics:
+		s := make(map[string]float64, len(v.Cores)+2)
+		s["load"] = v.Average.Load
+		s["frequency"] = v.Average.Frequency
+		for i, c := range v.Cores {
+			s["cores."+strconv.Itoa(i)+".load"] = c.Load
+		}
+		return s
+
+	case domain.MemoryMetrics:
+		return map[string]float64{
+			"used":              float64(v.Used),
+			"available":         float64(v.Available),
+			"used_percent":      v.UsedPercent,
+			"swap_used":         float64(v.SwapUsed),
+			"swap_used_percent": v.SwapUsedPercent,
+			"cached":            float64(v.Cached),
+			"dirty":             float64(v.Dirty),
+
+			"activity.page_faults_per_sec":  float64(v.Activity.PageFaultsPerSec),
+			"activity.major_faults_per_sec": float64(v.Activity.MajorFaultsPerSec),
+			"activity.swap_in_per_sec":      float64(v.Activity.SwapInPerSec),
+			"activity.swap_out_per_sec":     float64(v.Activity.SwapOutPerSec),
+		}
+
+	case domain.InterfacesIOMap:
+		s := make(map[string]float64, len(v)*5)
+		for name, io := range v {
+			s[name+".utilization_percent"] = io.UtilizationPercent
+			s[name+".bytes_per_sec.rx"] = float64(io.BytesPerSec.RX)
+			s[name+".bytes_per_sec.tx"] = float64(io.BytesPerSec.TX)
+			s[name+".packets_per_sec.rx"] = float64(io.PacketsPerSec.RX)
+			s[name+".packets_per_sec.tx"] = float64(io.PacketsPerSec.TX)
+		}
+		return s
+
+	case domain.DiskIOMap:
+		s := make(map[string]float64, len(v)*4)
+		for dev, io := range v {
+			s[dev+".ops_per_sec.rx"] = float64(io.OpsPerSec.RX)
+			s[dev+".ops_per_sec.tx"] = float64(io.OpsPerSec.TX)
+			s[dev+".bytes_per_sec.rx"] = float64(io.BytesPerSec.RX)
+			s[dev+".bytes_per_sec.tx"] = float64(io.BytesPerSec.TX)
+		}
+		return s
+
+	case domain.ThermalMetricsMap:
+		s := make(map[string]float64, len(v))
+		for sensor, t := range v {
+			s[sensor+".current"] = t.Current
+		}
+		return s
+
+	case domain.NetProtocolMetrics:
+		return map[string]float64{
+			"tcp.retransmits_per_sec":   v.TCP.Retransmits.PerSec,
+			"tcp.retransmit_percent":    v.TCP.RetransmitPercent,
+			"tcp.estab_resets_per_sec":  v.TCP.EstabResets.PerSec,
+			"tcp.listen_drops_per_sec":  v.TCP.ListenDrops.PerSec,
+			"tcp.curr_estab":            float64(v.TCP.CurrEstab),
+			"udp.rcvbuf_errors_per_sec": v.UDP.RcvbufErrors.PerSec,
+		}
+
+	case domain.SystemInfo:
+		return map[string]float64{
+			"load1":         v.Load1,
+			"load5":         v.Load5,
+			"load15":        v.Load15,
+			"running_procs": float64(v.RunningProcs),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/eterline/fstmon/internal/infra/log"
+)
+
+// SnapshotVersion – version of the snapshot encoding written by this build.
+const SnapshotVersion = 1
+
+var ErrSnapshotVersion = errors.New("unsupported snapshot version")
+
+/*
+ValueDecoder – decodes a saved value of the metric key.
+
+	valueType is the Go type name of the value when it was saved,
+	decoders must reject values of another type.
+*/
+type ValueDecoder interface {
+	DecodeValue(key, valueType string, data []byte) (any, error)
+}
+
+/*
+snapshotFile – versioned on-disk encoding of the store.
+
+	Values are kept as JSON, so added or removed fields of domain
+	structs don't break loading of an older snapshot.
+*/
+type snapshotFile struct {
+	Version int             `json:"version"`
+	SavedAt time.Time       `json:"saved_at"`
+	Entries []snapshotEntry `json:"entries"`
+}
+
+type snapshotEntry struct {
+	Key        string          `json:"key"`
+	Type       string          `json:"type"`
+	LastUpdate time.Time       `json:"last_update"`
+	Value      json.RawMessage `json:"value"`
+}
+
+// snapshot – encodes all available entries.
+func (r *MetricInMemoryStore) snapshot() (snapshotFile, error) {
+	r.mu.RLock()
+	keys := make([]string, 0, len(r.db))
+	for key := range r.db {
+		keys = append(keys, key)
+	}
+	r.mu.RUnlock()
+
+	file := snapshotFile{
+		Version: SnapshotVersion,
+		SavedAt: time.Now(),
+		Entries: make([]snapshotEntry, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		state, ok := r.GetState(key)
+		if !ok || !state.Available || state.Value == nil {
+			continue
+		}
+
+		data, err := json.Marshal(state.Value)
+		if err != nil {
+			return snapshotFile{}, fmt.Errorf("failed encode '%s': %w", key, err)
+		}
+
+		file.Entries = append(file.Entries, snapshotEntry{
+			Key:        key,
+			Type:       fmt.Sprintf("%T", state.Value),
+			LastUpdate: state.LastUpdate,
+			Value:      data,
+		})
+	}
+
+	return file, nil
+}
+
+/*
+WriteSnapshot – saves the store into the file.
+
+	The snapshot is written into a temporary file of the same directory
+	and renamed over the target, so a crash never leaves a partial file.
+*/
+func (r *MetricInMemoryStore) WriteSnapshot(path string) error {
+	file, err := r.snapshot()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after rename
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	// persist the rename itself
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+
+	return nil
+}
+
+/*
+LoadSnapshot – restores the store from the file.
+
+	Restored values are marked until the next save of their key, so
+	they are served as stale. A missing file restores nothing without
+	error. Entries that fail to decode are skipped and reported in the
+	joined error, the others are still restored.
+*/
+func (r *MetricInMemoryStore) LoadSnapshot(path string, dec ValueDecoder) (restored int, err error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var file snapshotFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return 0, fmt.Errorf("failed decode snapshot: %w", err)
+	}
+
+	if file.Version < 1 || file.Version > SnapshotVersion {
+		return 0, fmt.Errorf("%w: %d", ErrSnapshotVersion, file.Version)
+	}
+
+	var errs []error
+	for _, e := range file.Entries {
+		value, err := dec.DecodeValue(e.Key, e.Type, e.Value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("skip '%s': %w", e.Key, err))
+			continue
+		}
+
+		r.restore(e.Key, value, e.LastUpdate)
+		restored++
+	}
+
+	return restored, errors.Join(errs...)
+}
+
+// restore – saves the value and marks it as restored.
+func (r *MetricInMemoryStore) restore(key string, value any, ts time.Time) {
+	r.SaveValue(key, value, ts)
+
+	if e, ok := r.entry(key); ok {
+		e.markRestored()
+	}
+}
+
+/*
+RunSnapshots – writes the snapshot every interval until ctx is done.
+
+	The final snapshot is written on return, so a graceful shutdown
+	keeps the latest values.
+*/
+func (r *MetricInMemoryStore) RunSnapshots(ctx context.Context, path string, interval time.Duration) {
+	logger := log.MustLoggerFromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	write := func() {
+		if err := r.WriteSnapshot(path); err != nil {
+			logger.Error("metric snapshot write error", "error", err, "file", path)
+			return
+		}
+		logger.Debug("metric snapshot written", "file", path)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			write()
+			return
+		case <-ticker.C:
+			write()
+		}
+	}
+}
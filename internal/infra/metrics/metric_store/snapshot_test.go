@@ -0,0 +1,64 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package metricstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	metricstore "github.com/eterline/fstmon/internal/infra/metrics/metric_store"
+	"github.com/eterline/fstmon/internal/services/monitor"
+)
+
+func Test_Snapshot_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	ts := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	src := metricstore.NewMetricInMemoryStore()
+	src.SaveValue("memory", domain.MemoryMetrics{Used: 42}, ts)
+	src.SaveValue("renamed", domain.MemoryMetrics{Used: 1}, ts)
+
+	if err := src.WriteSnapshot(path); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	reg := monitor.NewRegistry()
+	reg.MustRegister(
+		monitor.NewCollector(domain.KeyMemory, time.Second, func(ctx context.Context) (domain.MemoryMetrics, error) {
+			return domain.MemoryMetrics{}, nil
+		}),
+	)
+
+	dst := metricstore.NewMetricInMemoryStore()
+	restored, err := dst.LoadSnapshot(path, reg)
+	if restored != 1 || err == nil { // unknown collector is skipped
+		t.Fatalf("restored %d, err %v", restored, err)
+	}
+
+	state, ok := dst.GetState("memory")
+	if !ok || !state.Restored || !state.LastUpdate.Equal(ts) {
+		t.Fatalf("state %+v", state)
+	}
+	if v, _ := state.Value.(domain.MemoryMetrics); v.Used != 42 {
+		t.Errorf("value %+v", state.Value)
+	}
+
+	dst.SaveValue("memory", domain.MemoryMetrics{Used: 43}, time.Now())
+	if state, _ := dst.GetState("memory"); state.Restored {
+		t.Error("refreshed value must not be restored")
+	}
+
+	if restored, err := dst.LoadSnapshot(filepath.Join(t.TempDir(), "none"), reg); restored != 0 || err != nil {
+		t.Errorf("missing file: %d, %v", restored, err)
+	}
+
+	os.WriteFile(path, []byte(`{"version":99}`), 0o600)
+	if _, err := dst.LoadSnapshot(path, reg); err == nil {
+		t.Error("unsupported version must fail")
+	}
+}
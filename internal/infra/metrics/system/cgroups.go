@@ -0,0 +1,328 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+)
+
+const (
+	cgroupRoot   = "/sys/fs/cgroup"
+	sysDevBlock  = "/sys/dev/block"
+	cgroupV2Mark = "cgroup.controllers" // exists in every cgroup v2 directory
+)
+
+var (
+	dockerScopeRe = regexp.MustCompile(`^docker-([0-9a-f]{64})\.scope$`)    // systemd cgroup driver
+	containerIDRe = regexp.MustCompile(`^[0-9a-f]{64}$`)                    // cgroupfs driver, below "docker"
+	podmanScopeRe = regexp.MustCompile(`^libpod-([0-9a-f]{64})(\.scope)?$`) // both drivers
+)
+
+// cgroupV2Mounted – reports whether the unified cgroup v2 hierarchy is mounted at root.
+func cgroupV2Mounted(root string) bool {
+	_, err := os.Stat(filepath.Join(root, cgroupV2Mark))
+	return err == nil
+}
+
+/*
+walkCgroups – calls fn for every cgroup below the root, the root itself excluded.
+
+	rel is the cgroup path below the root, e.g. "/system.slice".
+	Cgroups removed while walking are skipped.
+*/
+func walkCgroups(ctx context.Context, root string, fn func(dir, rel string)) error {
+	return filepath.WalkDir(root, func(dir string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if dir == root {
+				return err
+			}
+			return nil
+		}
+		if !d.IsDir() || dir == root {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return err
+		}
+
+		fn(dir, "/"+filepath.ToSlash(rel))
+		return nil
+	})
+}
+
+/*
+classifyCgroup – recognizes what the cgroup belongs to by its path.
+
+	Container IDs are shortened to 12 characters as shown by the
+	docker and podman CLI. slice is the nearest systemd slice above
+	or at the cgroup.
+*/
+func classifyCgroup(rel string) (kind domain.CgroupKind, name, slice string) {
+	segments := strings.Split(strings.Trim(rel, "/"), "/")
+	for _, s := range segments {
+		if strings.HasSuffix(s, ".slice") {
+			slice = s
+		}
+	}
+
+	last := segments[len(segments)-1]
+	parent := ""
+	if len(segments) > 1 {
+		parent = segments[len(segments)-2]
+	}
+
+	switch {
+	case dockerScopeRe.MatchString(last):
+		return domain.CgroupDocker, dockerScopeRe.FindStringSubmatch(last)[1][:12], slice
+	case parent == "docker" && containerIDRe.MatchString(last):
+		return domain.CgroupDocker, last[:12], slice
+	case podmanScopeRe.MatchString(last):
+		return domain.CgroupPodman, podmanScopeRe.FindStringSubmatch(last)[1][:12], slice
+	case strings.HasSuffix(last, ".slice"):
+		return domain.CgroupSlice, last, slice
+	case strings.HasSuffix(last, ".service"):
+		return domain.CgroupService, last, slice
+	case strings.HasSuffix(last, ".scope"):
+		return domain.CgroupScope, last, slice
+	default:
+		return domain.CgroupOther, last, slice
+	}
+}
+
+// cgroupSample – counters of a cgroup kept between scrapes.
+type cgroupSample struct {
+	cpuUsec    uint64
+	readBytes  uint64
+	writeBytes uint64
+}
+
+/*
+hardwareMetricCgroups – provides resource usage of cgroup v2 groups.
+
+	Every directory of the unified hierarchy except the root is a
+	cgroup. Files of controllers not enabled for a cgroup are missing
+	and leave their fields zero. CPU usage and I/O rates are computed
+	from the counter deltas between two consecutive scrapes.
+*/
+type hardwareMetricCgroups struct {
+	root   string
+	devDir string
+	delta  deltaTracker[cgroupSample]
+
+	devMu   sync.Mutex
+	devices map[string]string // MAJ:MIN to block device name
+}
+
+/*
+NewHardwareMetricCgroups – creates a new hardwareMetricCgroups instance reading the hierarchy at root.
+*/
+func NewHardwareMetricCgroups(root string) *hardwareMetricCgroups {
+	return &hardwareMetricCgroups{
+		root:    root,
+		devDir:  sysDevBlock,
+		devices: make(map[string]string),
+	}
+}
+
+/*
+RegisterCgroupCollector – registers the cgroups collector.
+
+	Kept apart from RegisterCollectors, because it is registered only
+	when the unified cgroup v2 hierarchy is mounted at root, cgroup v1
+	hosts have no collector instead of a failing one. An empty root
+	is /sys/fs/cgroup.
+*/
+func RegisterCgroupCollector(reg *monitor.Registry, root string) error {
+	if root == "" {
+		root = cgroupRoot
+	}
+	if !cgroupV2Mounted(root) {
+		return nil
+	}
+
+	return reg.Register(
+		monitor.NewCollector(domain.KeyCgroups, 15*time.Second, NewHardwareMetricCgroups(root).ScrapeCgroups,
+			monitor.WithDescription("Per-cgroup CPU, memory, OOM events, I/O and pids of slices, services and containers"),
+		),
+	)
+}
+
+// ScrapeCgroups – collects the usage of every cgroup below the root.
+func (hmc *hardwareMetricCgroups) ScrapeCgroups(ctx context.Context) (domain.CgroupList, error) {
+	var (
+		list    domain.CgroupList
+		samples = make(map[string]cgroupSample)
+	)
+
+	err := walkCgroups(ctx, hmc.root, func(dir, rel string) {
+		cg, sample := hmc.readCgroup(dir, rel)
+		list = append(list, cg)
+		samples[cg.Path] = sample
+	})
+	if err != nil {
+		return domain.CgroupList{}, ErrScrapeCgroups.Wrap(err)
+	}
+
+	prev, elapsed, _ := hmc.delta.advance(samples, time.Now())
+
+	for i := range list {
+		p, ok := prev[list[i].Path]
+		if !ok {
+			continue
+		}
+		cur := samples[list[i].Path]
+
+		if elapsed > 0 && cur.cpuUsec >= p.cpuUsec {
+			busy := time.Duration(cur.cpuUsec-p.cpuUsec) * time.Microsecond
+			list[i].CPUPercent = float64(busy) / float64(elapsed) * 100
+		}
+		list[i].ReadRate = counterRate(p.readBytes, cur.readBytes, elapsed)
+		list[i].WriteRate = counterRate(p.writeBytes, cur.writeBytes, elapsed)
+	}
+
+	return list, nil
+}
+
+// readCgroup – reads the controller files of a single cgroup.
+func (hmc *hardwareMetricCgroups) readCgroup(dir, rel string) (domain.CgroupMetrics, cgroupSample) {
+	cg := domain.CgroupMetrics{Path: rel}
+	cg.Kind, cg.Name, cg.Slice = classifyCgroup(rel)
+
+	var sample cgroupSample
+
+	cpu := readKeyedFile(filepath.Join(dir, "cpu.stat"))
+	sample.cpuUsec = cpu["usage_usec"]
+	cg.CPUUsage = time.Duration(sample.cpuUsec) * time.Microsecond
+
+	cg.MemoryCurrent = readUintFile(filepath.Join(dir, "memory.current"))
+	cg.MemoryMax = readUintFile(filepath.Join(dir, "memory.max"))
+	cg.MemoryPeak = readUintFile(filepath.Join(dir, "memory.peak"))
+
+	events := readKeyedFile(filepath.Join(dir, "memory.events"))
+	cg.OOM, cg.OOMKill = events["oom"], events["oom_kill"]
+
+	cg.Pids = readUintFile(filepath.Join(dir, "pids.current"))
+	cg.PidsMax = readUintFile(filepath.Join(dir, "pids.max"))
+
+	cg.IO = hmc.readIOStat(filepath.Join(dir, "io.stat"))
+	for _, io := range cg.IO {
+		sample.readBytes += io.ReadBytes
+		sample.writeBytes += io.WriteBytes
+	}
+
+	return cg, sample
+}
+
+/*
+readIOStat – parses io.stat lines of the form
+
+	MAJ:MIN rbytes=N wbytes=N rios=N wios=N dbytes=N dios=N
+*/
+func (hmc *hardwareMetricCgroups) readIOStat(file string) []domain.CgroupIO {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+
+	var stats []domain.CgroupIO
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		io := domain.CgroupIO{Device: hmc.deviceName(fields[0])}
+		for _, f := range fields[1:] {
+			key, value, _ := strings.Cut(f, "=")
+			v, _ := strconv.ParseUint(value, 10, 64)
+
+			switch key {
+			case "rbytes":
+				io.ReadBytes = v
+			case "wbytes":
+				io.WriteBytes = v
+			case "rios":
+				io.ReadOps = v
+			case "wios":
+				io.WriteOps = v
+			}
+		}
+
+		stats = append(stats, io)
+	}
+
+	return stats
+}
+
+// deviceName – resolves MAJ:MIN to the block device name through /sys/dev/block.
+func (hmc *hardwareMetricCgroups) deviceName(majMin string) string {
+	hmc.devMu.Lock()
+	defer hmc.devMu.Unlock()
+
+	if name, ok := hmc.devices[majMin]; ok {
+		return name
+	}
+
+	name := majMin
+	if target, err := os.Readlink(filepath.Join(hmc.devDir, majMin)); err == nil {
+		name = path.Base(target)
+	}
+
+	hmc.devices[majMin] = name
+	return name
+}
+
+// readUintFile – reads a single value file, "max" and missing files are zero.
+func readUintFile(file string) uint64 {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return 0
+	}
+
+	v, _ := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return v
+}
+
+// readKeyedFile – reads a flat keyed file of "key value" lines, a missing file is empty.
+func readKeyedFile(file string) map[string]uint64 {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+
+	values := make(map[string]uint64)
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		key, value, ok := strings.Cut(sc.Text(), " ")
+		if !ok {
+			continue
+		}
+		v, _ := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		values[key] = v
+	}
+
+	return values
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+const (
+	testDockerID = "4f1c0d9ab3e2c7f1d2a6b5e4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4"
+	testPodmanID = "9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b"
+)
+
+func Test_classifyCgroup(t *testing.T) {
+	tests := []struct {
+		path  string
+		kind  domain.CgroupKind
+		name  string
+		slice string
+	}{
+		{"/system.slice", domain.CgroupSlice, "system.slice", "system.slice"},
+		{"/system.slice/nginx.service", domain.CgroupService, "nginx.service", "system.slice"},
+		{"/system.slice/docker-" + testDockerID + ".scope", domain.CgroupDocker, testDockerID[:12], "system.slice"},
+		{"/docker/" + testDockerID, domain.CgroupDocker, testDockerID[:12], ""},
+		{"/machine.slice/libpod-" + testPodmanID + ".scope", domain.CgroupPodman, testPodmanID[:12], "machine.slice"},
+		{"/user.slice/user-1000.slice/session-3.scope", domain.CgroupScope, "session-3.scope", "user-1000.slice"},
+		{"/init.scope", domain.CgroupScope, "init.scope", ""},
+		{"/lxc.payload.ct1", domain.CgroupOther, "lxc.payload.ct1", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			kind, name, slice := classifyCgroup(tt.path)
+			if kind != tt.kind || name != tt.name || slice != tt.slice {
+				t.Errorf("classifyCgroup() = %q, %q, %q, want %q, %q, %q", kind, name, slice, tt.kind, tt.name, tt.slice)
+			}
+		})
+	}
+}
+
+// writeFakeCgroup – writes controller files of a cgroup into the fake hierarchy.
+func writeFakeCgroup(t *testing.T, root, rel string, files map[string]string) {
+	t.Helper()
+
+	dir := filepath.Join(root, rel)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_hardwareMetricCgroups(t *testing.T) {
+	root := t.TempDir()
+	writeFakeCgroup(t, root, "", map[string]string{cgroupV2Mark: "cpu io memory pids\n"})
+
+	writeFakeCgroup(t, root, "system.slice", map[string]string{
+		"cpu.stat":       "usage_usec 9000000\nuser_usec 6000000\nsystem_usec 3000000\n",
+		"memory.current": "805306368\n",
+		"memory.max":     "max\n",
+	})
+	writeFakeCgroup(t, root, "system.slice/nginx.service", map[string]string{
+		"cpu.stat":       "usage_usec 2000000\n",
+		"memory.current": "67108864\n",
+		"memory.max":     "134217728\n",
+		"memory.peak":    "100663296\n",
+		"memory.events":  "low 0\nhigh 0\nmax 12\noom 2\noom_kill 1\n",
+		"io.stat":        "8:0 rbytes=1048576 wbytes=2097152 rios=10 wios=20 dbytes=0 dios=0\n",
+		"pids.current":   "5\n",
+		"pids.max":       "max\n",
+	})
+	writeFakeCgroup(t, root, "system.slice/docker-"+testDockerID+".scope", map[string]string{
+		"cpu.stat":       "usage_usec 7000000\n",
+		"memory.current": "536870912\n",
+		"pids.current":   "42\n",
+		"pids.max":       "1000\n",
+	})
+
+	hmc := NewHardwareMetricCgroups(root)
+	hmc.devDir = t.TempDir()
+	if err := os.Symlink("../../devices/pci0000:00/0000:00:17.0/ata1/host0/target0:0:0/0:0:0:0/block/sda", filepath.Join(hmc.devDir, "8:0")); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := hmc.ScrapeCgroups(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("got %d cgroups, want 3", len(list))
+	}
+
+	byPath := make(map[string]domain.CgroupMetrics, len(list))
+	for _, c := range list {
+		byPath[c.Path] = c
+	}
+
+	nginx := byPath["/system.slice/nginx.service"]
+	if nginx.MemoryCurrent != 64<<20 || nginx.MemoryMax != 128<<20 || nginx.MemoryPeak != 96<<20 ||
+		nginx.OOM != 2 || nginx.OOMKill != 1 || nginx.Pids != 5 || nginx.PidsMax != 0 {
+		t.Errorf("nginx = %+v", nginx)
+	}
+	if len(nginx.IO) != 1 || nginx.IO[0] != (domain.CgroupIO{Device: "sda", ReadBytes: 1 << 20, WriteBytes: 2 << 20, ReadOps: 10, WriteOps: 20}) {
+		t.Errorf("nginx io = %+v", nginx.IO)
+	}
+
+	if slice := byPath["/system.slice"]; slice.MemoryMax != 0 || slice.Kind != domain.CgroupSlice || slice.IO != nil {
+		t.Errorf("system.slice = %+v", slice)
+	}
+
+	docker := byPath["/system.slice/docker-"+testDockerID+".scope"]
+	if docker.Kind != domain.CgroupDocker || docker.Name != testDockerID[:12] || docker.PidsMax != 1000 {
+		t.Errorf("docker = %+v", docker)
+	}
+
+	// usage grows by 1s of CPU time on nginx and 4MB written
+	writeFakeCgroup(t, root, "system.slice/nginx.service", map[string]string{
+		"cpu.stat": "usage_usec 3000000\n",
+		"io.stat":  "8:0 rbytes=1048576 wbytes=6291456 rios=10 wios=84 dbytes=0 dios=0\n",
+	})
+
+	list, err = hmc.ScrapeCgroups(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	top := list.Top(domain.TopSortCPU, 1)
+	if len(top) != 1 || !strings.HasSuffix(top[0].Path, "nginx.service") || top[0].CPUPercent <= 0 || top[0].WriteRate == 0 {
+		t.Errorf("top by cpu = %+v", top)
+	}
+
+	if top := list.Top(domain.TopSortMem, 2); top[0].Path != "/system.slice" || top[1].Name != testDockerID[:12] {
+		t.Errorf("top by memory = %+v", top)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/prometheus/procfs"
+)
+
+/*
+RegisterCollectors – registers all host collectors of the package.
+
+	Collector names are the metric keys served by HTTP and gRPC.
+	Intervals are defaults, the app overrides them from configuration.
+	Filters drop interfaces, disks, partitions and sensors at scrape
+	time, so every consumer of the store sees the same items. procRoot
+	is the mount point fs is opened at, read by the collectors of the
+	files procfs doesn't parse.
+*/
+func RegisterCollectors(reg *monitor.Registry, fs procfs.FS, procRoot string, filters *Filters) error {
+	cpu := NewHardwareMetricCPU(fs)
+	parts := NewHardwareMetricPartitions(fs, filters)
+
+	cgroups := ""
+	if cgroupV2Mounted(cgroupRoot) {
+		cgroups = cgroupRoot
+	}
+
+	return reg.Register(
+		monitor.NewCollector(domain.KeyCpuUsage, 10*time.Second, cpu.ScrapeCpuMetrics,
+			monitor.WithDescription("Total and per-core CPU load with frequencies"),
+			monitor.WithUnits("percent"),
+		),
+		monitor.NewCollector(domain.KeyCpu, time.Minute, cpu.ScrapeCpuPackage,
+			monitor.WithDescription("CPU package: vendor, model, cores and threads"),
+		),
+		monitor.NewCollector(domain.KeyNetIO, 10*time.Second, NewHardwareMetricNetwork(fs, filters).ScrapeInterfacesIO,
+			monitor.WithDescription("Network interfaces I/O counters and rates"),
+			monitor.WithUnits("bytes"),
+		),
+		monitor.NewCollector(domain.KeyMemory, 10*time.Second, NewHardwareMetricMemory(fs).ScrapeMemoryMetrics,
+			monitor.WithDescription("RAM and swap usage"),
+			monitor.WithUnits("bytes"),
+		),
+		monitor.NewCollector(domain.KeyDiskIO, 10*time.Second, parts.ScrapeDiskIO,
+			monitor.WithDescription("Block devices I/O counters and rates"),
+			monitor.WithUnits("bytes"),
+		),
+		monitor.NewCollector(domain.KeyPartitions, time.Minute, parts.ScrapePartitions,
+			monitor.WithDescription("Mounted partitions and their usage"),
+			monitor.WithUnits("bytes"),
+		),
+		monitor.NewCollector(domain.KeySystem, 20*time.Second, NewHardwareMetricSystem(fs).ScrapeSystemInfo,
+			monitor.WithDescription("Host info, uptime, load average and processes"),
+		),
+		monitor.NewCollector(domain.KeyThermal, 20*time.Second, NewHardwareThermalMetrics(filters).ScrapeThermalMetrics,
+			monitor.WithDescription("Hardware temperature sensors"),
+			monitor.WithUnits("celsius"),
+		),
+		monitor.NewCollector(domain.KeyProcesses, 15*time.Second, NewHardwareMetricProcesses(fs).ScrapeProcesses,
+			monitor.WithDescription("Per-process CPU, memory, file descriptors and storage I/O"),
+		),
+		monitor.NewCollector(domain.KeyPressure, 10*time.Second, NewHardwareMetricPressure(fs, cgroups).ScrapePressure,
+			monitor.WithDescription("Pressure stall information of CPU, memory and I/O for the host and cgroups"),
+			monitor.WithUnits("percent"),
+		),
+		monitor.NewCollector(domain.KeyProtocols, 10*time.Second, NewHardwareMetricProtocols(procRoot).ScrapeProtocols,
+			monitor.WithDescription("TCP, UDP and ICMP protocol counters with retransmit, reset and error rates"),
+		),
+		monitor.NewCollector(domain.KeySockets, 30*time.Second, NewHardwareMetricSockets(fs).ScrapeSockets,
+			monitor.WithDescription("TCP, UDP and Unix sockets, listening ports with their processes and remote peers"),
+		),
+	)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+/*
+counterDelta – returns the increase of a monotonic counter between two samples.
+
+	A decreasing counter is either wrapped or reset. Counters that
+	fit 32 bits are treated as 32-bit ones, because many kernel
+	counters are unsigned long on 32-bit hosts. A counter that was
+	in the upper half of its range is assumed wrapped, otherwise
+	it was reset (device re-created) and counts from zero.
+*/
+func counterDelta(prev, cur uint64) uint64 {
+	if cur >= prev {
+		return cur - prev
+	}
+
+	if prev <= math.MaxUint32 {
+		if prev > math.MaxUint32/2 {
+			return cur + (math.MaxUint32 - prev) + 1
+		}
+		return cur
+	}
+
+	if prev > math.MaxUint64/2 {
+		return cur + (math.MaxUint64 - prev) + 1
+	}
+	return cur
+}
+
+// counterRate – returns the per-second rate of a counter over the elapsed time.
+func counterRate(prev, cur uint64, elapsed time.Duration) uint64 {
+	return uint64(counterRateFloat(prev, cur, elapsed))
+}
+
+// counterRateFloat – counterRate without truncation, for counters that grow slowly.
+func counterRateFloat(prev, cur uint64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(counterDelta(prev, cur)) / elapsed.Seconds()
+}
+
+/*
+deltaTracker – keeps the previous counter snapshot between scrapes.
+
+	Rates are computed over the real time between two scrapes, so a
+	scrape never has to sleep. Snapshot entries are keyed by device,
+	devices that appear have no previous sample and devices that
+	disappear are forgotten with the next snapshot.
+*/
+type deltaTracker[S any] struct {
+	mu   sync.Mutex
+	prev map[string]S
+	at   time.Time
+}
+
+/*
+advance – stores the current snapshot and returns the previous one.
+
+	ok is false for the first sample, when there is nothing to compare with.
+*/
+func (dt *deltaTracker[S]) advance(cur map[string]S, now time.Time) (prev map[string]S, elapsed time.Duration, ok bool) {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+
+	prev, at := dt.prev, dt.at
+	dt.prev, dt.at = cur, now
+
+	if prev == nil || !now.After(at) {
+		return nil, 0, false
+	}
+
+	return prev, now.Sub(at), true
+}
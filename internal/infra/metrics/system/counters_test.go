@@ -0,0 +1,151 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+func Test_counterDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     uint64
+		cur      uint64
+		expected uint64
+	}{
+		{"increase", 100, 150, 50},
+		{"no change", 100, 100, 0},
+		{"32-bit wrap", math.MaxUint32 - 9, 5, 15},
+		{"64-bit wrap", math.MaxUint64 - 9, 5, 15},
+		{"32-bit reset", 1000, 10, 10},
+		{"64-bit reset", math.MaxUint32 + 1000, 10, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counterDelta(tt.prev, tt.cur); got != tt.expected {
+				t.Errorf("counterDelta(%d, %d) = %d, want %d", tt.prev, tt.cur, got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_counterRate(t *testing.T) {
+	if got := counterRate(1000, 5000, 4*time.Second); got != 1000 {
+		t.Errorf("rate = %d, want 1000", got)
+	}
+	if got := counterRate(1000, 5000, 0); got != 0 {
+		t.Errorf("rate over zero time = %d, want 0", got)
+	}
+}
+
+func Test_deltaTracker(t *testing.T) {
+	var dt deltaTracker[uint64]
+	t0 := time.Now()
+
+	if _, _, ok := dt.advance(map[string]uint64{"eth0": 10}, t0); ok {
+		t.Fatal("first sample must have no previous snapshot")
+	}
+
+	prev, elapsed, ok := dt.advance(map[string]uint64{"eth0": 20, "eth1": 5}, t0.Add(10*time.Second))
+	if !ok || elapsed != 10*time.Second || prev["eth0"] != 10 {
+		t.Fatalf("got prev=%v elapsed=%v ok=%v", prev, elapsed, ok)
+	}
+	if _, exists := prev["eth1"]; exists {
+		t.Error("appeared device must have no previous sample")
+	}
+
+	prev, _, _ = dt.advance(map[string]uint64{"eth1": 7}, t0.Add(20*time.Second))
+	if prev["eth1"] != 5 {
+		t.Errorf("got prev eth1 %d, want 5", prev["eth1"])
+	}
+
+	prev, _, _ = dt.advance(map[string]uint64{}, t0.Add(30*time.Second))
+	if _, exists := prev["eth0"]; exists {
+		t.Error("disappeared device must be forgotten")
+	}
+}
+
+func Test_cpuLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     procfs.CPUStat
+		cur      procfs.CPUStat
+		expected float64
+	}{
+		{"half busy", procfs.CPUStat{User: 10, Idle: 10}, procfs.CPUStat{User: 15, Idle: 15}, 50},
+		{"iowait is idle", procfs.CPUStat{}, procfs.CPUStat{System: 1, Idle: 2, Iowait: 1}, 25},
+		{"guest not doubled", procfs.CPUStat{}, procfs.CPUStat{User: 3, Guest: 3, Idle: 1}, 75},
+		{"no progress", procfs.CPUStat{User: 5}, procfs.CPUStat{User: 5}, 0},
+		{"counters reset", procfs.CPUStat{User: 50}, procfs.CPUStat{User: 5}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cpuLoad(tt.prev, tt.cur); got != tt.expected {
+				t.Errorf("cpuLoad() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_processCPUPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     uint64
+		cur      uint64
+		elapsed  time.Duration
+		expected float64
+	}{
+		{"one core busy", 100, 1100, 10 * time.Second, 100},
+		{"two cores busy", 0, 400, 2 * time.Second, 200},
+		{"idle", 500, 500, 10 * time.Second, 0},
+		{"counters decreased", 500, 100, 10 * time.Second, 0},
+		{"no elapsed time", 0, 100, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := processCPUPercent(tt.prev, tt.cur, tt.elapsed); got != tt.expected {
+				t.Errorf("processCPUPercent() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_cpuTimes(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     procfs.CPUStat
+		cur      procfs.CPUStat
+		expected domain.CpuTimes
+	}{
+		{
+			"breakdown",
+			procfs.CPUStat{User: 10, Idle: 10},
+			procfs.CPUStat{User: 13, System: 2, Idle: 12, Iowait: 1, Steal: 2},
+			domain.CpuTimes{User: 30, System: 20, Idle: 20, Iowait: 10, Steal: 20},
+		},
+		{
+			"guest out of user",
+			procfs.CPUStat{},
+			procfs.CPUStat{User: 3, Nice: 1, Guest: 2, GuestNice: 1},
+			domain.CpuTimes{User: 25, Guest: 50, GuestNice: 25},
+		},
+		{"no progress", procfs.CPUStat{User: 5}, procfs.CPUStat{User: 5}, domain.CpuTimes{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cpuTimes(tt.prev, tt.cur); got != tt.expected {
+				t.Errorf("cpuTimes() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
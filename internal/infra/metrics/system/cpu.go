@@ -6,11 +6,15 @@ package system
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/eterline/fstmon/internal/domain"
 	"github.com/eterline/fstmon/internal/utils/usecase"
 	"github.com/eterline/fstmon/pkg/procf"
+	"github.com/prometheus/procfs"
 	pscpu "github.com/shirou/gopsutil/v4/cpu"
 )
 
@@ -18,20 +22,21 @@ import (
 hardwareMetricCPU – provides CPU hardware metrics, both static and dynamic.
 
 	It can fetch information about CPU package and per-core metrics.
-	Interval defines the averaging duration for dynamic metrics.
+	Load and time breakdown are computed from /proc/stat jiffy deltas
+	between scrapes, kernel activity rates from its counter deltas.
 */
 type hardwareMetricCPU struct {
-	mi time.Duration
+	fs       procfs.FS
+	delta    deltaTracker[procfs.CPUStat]
+	activity deltaTracker[uint64]
 }
 
 /*
 NewHardwareMetricCPU – creates a new hardwareMetricCPU instance.
-
-	Interval specifies the time duration for CPU load averaging in CpuMetrics.
 */
-func NewHardwareMetricCPU(measureInterwal time.Duration) *hardwareMetricCPU {
+func NewHardwareMetricCPU(fs procfs.FS) *hardwareMetricCPU {
 	return &hardwareMetricCPU{
-		mi: measureInterwal,
+		fs: fs,
 	}
 }
 
@@ -95,41 +100,147 @@ CpuMetrics – returns dynamic CPU metrics, including:
 
 	- per-core frequency
 
-	- average load/frequency across all cores
+	- per-core time breakdown by state
+
+	- average load/frequency/time breakdown across all cores
+
+	- context switches, interrupts and forks rates, running and blocked tasks
 
-	  Uses the configured interval for calculating CPU load percentages.
-	  Returns an error if fetching CPU info or CPU load fails,
-	  or if the number of cores and load entries do not match.
+	  Load is the share of non-idle jiffies over the time since the previous
+	  scrape. The first scrape and hot-plugged cores report the load averaged
+	  since boot. Returns an error if /proc/stat or CPU info can't be read.
 */
 func (hmc *hardwareMetricCPU) ScrapeCpuMetrics(ctx context.Context) (domain.CpuMetrics, error) {
-	cpuInfo, err := pscpu.InfoWithContext(ctx)
+	stat, err := hmc.fs.Stat()
 	if err != nil {
-		return domain.CpuMetrics{}, err
+		return domain.CpuMetrics{}, ErrScrapeCpuMetrics.Wrap(err)
 	}
 
-	loads, err := pscpu.PercentWithContext(ctx, hmc.mi, true)
+	cpuInfo, err := pscpu.InfoWithContext(ctx)
 	if err != nil {
-		return domain.CpuMetrics{}, err
+		return domain.CpuMetrics{}, ErrScrapeCpuMetrics.Wrap(err)
 	}
 
-	if len(cpuInfo) != len(loads) {
-		return domain.CpuMetrics{}, errors.New("unequal load stats count and core information count")
+	ids := slices.Sorted(maps.Keys(stat.CPU))
+
+	cur := make(map[string]procfs.CPUStat, len(ids)+1)
+	cur[cpuTotalKey] = stat.CPUTotal
+	for _, id := range ids {
+		cur[strconv.FormatInt(id, 10)] = stat.CPU[id]
 	}
 
+	prev, _, _ := hmc.delta.advance(cur, time.Now())
+
 	metrics := domain.CpuMetrics{
-		Cores: make([]domain.CpuCoreMetrics, len(cpuInfo)),
+		Cores: make([]domain.CpuCoreMetrics, len(ids)),
 	}
 
-	{
-		f := func(idx int) float64 { return cpuInfo[idx].Mhz }
-		metrics.Average.Load = usecase.AvgVector(loads)
-		metrics.Average.Frequency = usecase.AvgVectorFunc(cpuInfo, f)
-	}
+	metrics.Average.Load = cpuLoad(prev[cpuTotalKey], stat.CPUTotal)
+	metrics.Average.Times = cpuTimes(prev[cpuTotalKey], stat.CPUTotal)
+	metrics.Average.Frequency = usecase.AvgVectorFunc(cpuInfo, func(idx int) float64 { return cpuInfo[idx].Mhz })
 
-	for i := range metrics.Cores {
-		metrics.Cores[i].Load = loads[i]
-		metrics.Cores[i].Frequency = cpuInfo[i].Mhz
+	for i, id := range ids {
+		key := strconv.FormatInt(id, 10)
+		metrics.Cores[i].Load = cpuLoad(prev[key], stat.CPU[id])
+		metrics.Cores[i].Times = cpuTimes(prev[key], stat.CPU[id])
+		if i < len(cpuInfo) {
+			metrics.Cores[i].Frequency = cpuInfo[i].Mhz
+		}
 	}
 
+	metrics.Activity = hmc.cpuActivity(stat)
+
 	return metrics, nil
 }
+
+// cpuActivity – returns the kernel activity counters with their rates since the previous scrape.
+func (hmc *hardwareMetricCPU) cpuActivity(stat procfs.Stat) domain.CpuActivity {
+	act := domain.CpuActivity{
+		ContextSwitches: stat.ContextSwitches,
+		Interrupts:      stat.IRQTotal,
+		Forks:           stat.ProcessCreated,
+		ProcsRunning:    stat.ProcessesRunning,
+		ProcsBlocked:    stat.ProcessesBlocked,
+	}
+
+	cur := map[string]uint64{
+		"ctxt":      act.ContextSwitches,
+		"intr":      act.Interrupts,
+		"processes": act.Forks,
+	}
+
+	prev, elapsed, ok := hmc.activity.advance(cur, time.Now())
+	if ok {
+		act.ContextSwitchesPerSec = counterRate(prev["ctxt"], cur["ctxt"], elapsed)
+		act.InterruptsPerSec = counterRate(prev["intr"], cur["intr"], elapsed)
+		act.ForksPerSec = counterRate(prev["processes"], cur["processes"], elapsed)
+	}
+
+	return act
+}
+
+const cpuTotalKey = "total"
+
+/*
+cpuLoad – returns the CPU load percent between two /proc/stat samples.
+
+	Guest time is already accounted in user time, so it is not summed.
+	Iowait counts as idle time.
+*/
+func cpuLoad(prev, cur procfs.CPUStat) float64 {
+	total := func(s procfs.CPUStat) float64 {
+		return s.User + s.Nice + s.System + s.Idle + s.Iowait + s.IRQ + s.SoftIRQ + s.Steal
+	}
+
+	dTotal := total(cur) - total(prev)
+	dIdle := (cur.Idle + cur.Iowait) - (prev.Idle + prev.Iowait)
+
+	if dTotal <= 0 {
+		return 0
+	}
+
+	return min(max((dTotal-dIdle)/dTotal*100, 0), 100)
+}
+
+/*
+cpuTimes – returns the shares of CPU time by state between two /proc/stat samples.
+
+	Guest time is accounted in user time by the kernel, it is
+	subtracted from User and Nice and reported on its own.
+*/
+func cpuTimes(prev, cur procfs.CPUStat) domain.CpuTimes {
+	d := procfs.CPUStat{
+		User:      cur.User - prev.User,
+		Nice:      cur.Nice - prev.Nice,
+		System:    cur.System - prev.System,
+		Idle:      cur.Idle - prev.Idle,
+		Iowait:    cur.Iowait - prev.Iowait,
+		IRQ:       cur.IRQ - prev.IRQ,
+		SoftIRQ:   cur.SoftIRQ - prev.SoftIRQ,
+		Steal:     cur.Steal - prev.Steal,
+		Guest:     cur.Guest - prev.Guest,
+		GuestNice: cur.GuestNice - prev.GuestNice,
+	}
+
+	total := d.User + d.Nice + d.System + d.Idle + d.Iowait + d.IRQ + d.SoftIRQ + d.Steal
+	if total <= 0 {
+		return domain.CpuTimes{}
+	}
+
+	share := func(v float64) float64 {
+		return min(max(v/total*100, 0), 100)
+	}
+
+	return domain.CpuTimes{
+		User:      share(d.User - d.Guest),
+		Nice:      share(d.Nice - d.GuestNice),
+		System:    share(d.System),
+		Idle:      share(d.Idle),
+		Iowait:    share(d.Iowait),
+		IRQ:       share(d.IRQ),
+		SoftIRQ:   share(d.SoftIRQ),
+		Steal:     share(d.Steal),
+		Guest:     share(d.Guest),
+		GuestNice: share(d.GuestNice),
+	}
+}
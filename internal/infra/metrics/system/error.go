@@ -41,4 +41,12 @@ var (
 	ErrScrapeThermalMetrics = newSystemError("failed scrape thermal metrics")
 	ErrScrapePartitions     = newSystemError("failed scrape partitions")
 	ErrScrapeDiskIO         = newSystemError("failed scrape disk I/O")
+	ErrScrapeSelfMetrics    = newSystemError("failed scrape self metrics")
+	ErrScrapeProcesses      = newSystemError("failed scrape processes")
+	ErrScrapeServices       = newSystemError("failed scrape watched services")
+	ErrScrapeSystemd        = newSystemError("failed scrape systemd units")
+	ErrScrapeCgroups        = newSystemError("failed scrape cgroups")
+	ErrScrapePressure       = newSystemError("failed scrape pressure stall information")
+	ErrScrapeSockets        = newSystemError("failed scrape sockets")
+	ErrScrapeProtocols      = newSystemError("failed scrape network protocol counters")
 )
@@ -0,0 +1,256 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// FilterTarget – collector attribute a filter rule is matched against.
+type FilterTarget string
+
+const (
+	FilterInterface     FilterTarget = "net_io.interface"  // network interface name, e.g. eth0
+	FilterDiskDevice    FilterTarget = "disk_io.device"    // block device name, e.g. sda
+	FilterPartDevice    FilterTarget = "partitions.device" // partition device path, e.g. /dev/sda1
+	FilterPartMount     FilterTarget = "partitions.mount"  // mount point, e.g. /var/lib/docker
+	FilterPartFSType    FilterTarget = "partitions.fstype" // filesystem type, e.g. tmpfs
+	FilterThermalSensor FilterTarget = "thermal.sensor"    // sensor key, e.g. coretemp_core_0
+)
+
+var filterTargets = []FilterTarget{
+	FilterInterface,
+	FilterDiskDevice,
+	FilterPartDevice,
+	FilterPartMount,
+	FilterPartFSType,
+	FilterThermalSensor,
+}
+
+/*
+pseudoFilesystems – filesystem types without backing storage.
+
+	Kernel interfaces, in-memory and container layer filesystems
+	have no capacity worth monitoring and flood the partition list.
+*/
+var pseudoFilesystems = []string{
+	"autofs", "binfmt_misc", "bpf", "cgroup", "cgroup2", "configfs",
+	"debugfs", "devpts", "devtmpfs", "efivarfs", "fusectl", "fuse.gvfsd-fuse",
+	"fuse.lxcfs", "fuse.portal", "hugetlbfs", "mqueue", "nfsd", "nsfs",
+	"overlay", "proc", "pstore", "ramfs", "rpc_pipefs", "securityfs",
+	"selinuxfs", "squashfs", "sysfs", "tmpfs", "tracefs",
+}
+
+/*
+defaultFilterExcludes – built-in exclude globs by target.
+
+	Virtual interfaces of containers and bridges, memory and loop
+	block devices, pseudo filesystems and mounts of the container
+	runtime and of /run are dropped unless included explicitly.
+*/
+var defaultFilterExcludes = map[FilterTarget][]string{
+	FilterInterface:  {"veth*", "docker*", "br-*", "cali*", "cni*", "flannel*", "lxc*"},
+	FilterDiskDevice: {"loop*", "ram*", "zram*"},
+	FilterPartDevice: {"/dev/loop*"},
+	FilterPartMount: {
+		"/var/lib/docker*", "/var/run/docker*", "/run", "/run/*",
+		"/proc", "/proc/*", "/sys", "/sys/*", "/dev", "/dev/*",
+	},
+	FilterPartFSType: pseudoFilesystems,
+}
+
+/*
+FilterRule – include or exclude rule of a collector attribute.
+
+	Rules are written as TARGET=PATTERN, the pattern is a glob
+	by default or a regular expression with the re: prefix:
+	  net_io.interface=eth*          – glob, * also matches /
+	  partitions.mount=re:^/srv/.+$  – regular expression
+	Globs match the whole value, regular expressions any part of it.
+*/
+type FilterRule struct {
+	Target  FilterTarget
+	Include bool
+
+	pattern string
+	re      *regexp.Regexp
+}
+
+// ParseFilterRule – parses the TARGET=PATTERN rule.
+func ParseFilterRule(rule string, include bool) (FilterRule, error) {
+	target, pattern, ok := strings.Cut(rule, "=")
+	if !ok || pattern == "" {
+		return FilterRule{}, fmt.Errorf("filter %q: rule is not TARGET=PATTERN", rule)
+	}
+
+	fr, err := newFilterRule(FilterTarget(target), pattern, include)
+	if err != nil {
+		return FilterRule{}, fmt.Errorf("filter %q: %w", rule, err)
+	}
+	return fr, nil
+}
+
+func newFilterRule(target FilterTarget, pattern string, include bool) (FilterRule, error) {
+	if !slices.Contains(filterTargets, target) {
+		return FilterRule{}, fmt.Errorf("unknown target %q, expected one of %v", target, filterTargets)
+	}
+
+	expr, ok := strings.CutPrefix(pattern, "re:")
+	if !ok {
+		expr = globExpr(pattern)
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return FilterRule{}, err
+	}
+
+	return FilterRule{
+		Target:  target,
+		Include: include,
+		pattern: pattern,
+		re:      re,
+	}, nil
+}
+
+// globExpr – translates a glob with *, ? and [...] classes into an anchored expression.
+func globExpr(glob string) string {
+	var b strings.Builder
+	b.WriteByte('^')
+
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteByte('.')
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if rest, ok := strings.CutPrefix(class, "!"); ok {
+				class = "^" + rest
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteByte('$')
+	return b.String()
+}
+
+// Pattern – returns the pattern as written in the configuration.
+func (fr FilterRule) Pattern() string {
+	return fr.pattern
+}
+
+func (fr FilterRule) matches(v string) bool {
+	return fr.re.MatchString(v)
+}
+
+// filterSet – rules of a single target.
+type filterSet struct {
+	include  []FilterRule
+	exclude  []FilterRule
+	defaults []FilterRule
+}
+
+func matchesAny(rules []FilterRule, v string) bool {
+	for _, r := range rules {
+		if r.matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Filters – include and exclude rules of the collected items.
+
+	A value is kept by the rules of its target in this order:
+	  1. an exclude rule matches – dropped
+	  2. an include rule matches – kept
+	  3. include rules exist, none matches – dropped
+	  4. a built-in default matches – dropped
+	So an explicit include overrides the built-in defaults.
+	The nil Filters keeps everything.
+*/
+type Filters struct {
+	sets map[FilterTarget]*filterSet
+}
+
+/*
+NewFilters – creates filters of the rules.
+
+	With defaults the built-in excludes are added under the rules.
+*/
+func NewFilters(defaults bool, rules ...FilterRule) *Filters {
+	f := &Filters{
+		sets: make(map[FilterTarget]*filterSet, len(filterTargets)),
+	}
+
+	set := func(t FilterTarget) *filterSet {
+		s, ok := f.sets[t]
+		if !ok {
+			s = &filterSet{}
+			f.sets[t] = s
+		}
+		return s
+	}
+
+	for _, r := range rules {
+		s := set(r.Target)
+		if r.Include {
+			s.include = append(s.include, r)
+		} else {
+			s.exclude = append(s.exclude, r)
+		}
+	}
+
+	if defaults {
+		for target, globs := range defaultFilterExcludes {
+			s := set(target)
+			for _, g := range globs {
+				r, err := newFilterRule(target, g, false)
+				if err != nil {
+					panic(err) // built-in globs are constant
+				}
+				s.defaults = append(s.defaults, r)
+			}
+		}
+	}
+
+	return f
+}
+
+// Allow – reports whether the value of the target is kept.
+func (f *Filters) Allow(target FilterTarget, v string) bool {
+	if f == nil {
+		return true
+	}
+
+	s, ok := f.sets[target]
+	if !ok {
+		return true
+	}
+
+	switch {
+	case matchesAny(s.exclude, v):
+		return false
+	case matchesAny(s.include, v):
+		return true
+	case len(s.include) > 0:
+		return false
+	}
+	return !matchesAny(s.defaults, v)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import "testing"
+
+func Test_ParseFilterRule(t *testing.T) {
+	tests := []struct {
+		rule string
+		ok   bool
+	}{
+		{"net_io.interface=eth*", true},
+		{"partitions.mount=re:^/srv/.+$", true},
+		{"thermal.sensor=[!a]*", true},
+		{"net_io.interface", false},
+		{"net_io.interface=", false},
+		{"memory.bank=dimm0", false},
+		{"disk_io.device=re:(", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			_, err := ParseFilterRule(tt.rule, true)
+			if (err == nil) != tt.ok {
+				t.Errorf("ParseFilterRule(%q) error = %v, want ok %v", tt.rule, err, tt.ok)
+			}
+		})
+	}
+}
+
+func Test_Filters(t *testing.T) {
+	var rules []FilterRule
+	for _, r := range []struct {
+		rule    string
+		include bool
+	}{
+		{"net_io.interface=wg*", false},
+		{"partitions.mount=/run/media/*", true},
+		{"partitions.mount=/mnt/*", true},
+		{"partitions.mount=/mnt/scratch*", false},
+		{"thermal.sensor=re:^coretemp_", true},
+		{"thermal.sensor=coretemp_package*", false},
+	} {
+		fr, err := ParseFilterRule(r.rule, r.include)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rules = append(rules, fr)
+	}
+
+	tests := []struct {
+		name     string
+		defaults bool
+		target   FilterTarget
+		value    string
+		want     bool
+	}{
+		{"physical interface", true, FilterInterface, "eth0", true},
+		{"veth by default", true, FilterInterface, "veth1a2b3c", false},
+		{"docker bridge by default", true, FilterInterface, "br-4f2a9c", false},
+		{"excluded interface", true, FilterInterface, "wg0", false},
+		{"no defaults", false, FilterInterface, "veth1a2b3c", true},
+		{"loop device", true, FilterDiskDevice, "loop3", false},
+		{"nvme device", true, FilterDiskDevice, "nvme0n1", true},
+		{"pseudo fs", true, FilterPartFSType, "tmpfs", false},
+		{"fuse lxcfs", true, FilterPartFSType, "fuse.lxcfs", false},
+		{"ext4", true, FilterPartFSType, "ext4", true},
+		{"include overrides default", true, FilterPartMount, "/run/media/usb", true},
+		{"included mount", true, FilterPartMount, "/mnt/backup", true},
+		{"exclude glob crosses slash", true, FilterPartMount, "/mnt/scratch/tmp", false},
+		{"not included mount", false, FilterPartMount, "/home", false},
+		{"included sensor", true, FilterThermalSensor, "coretemp_core_0", true},
+		{"exclude wins over include", true, FilterThermalSensor, "coretemp_package_id_0", false},
+		{"not included sensor", true, FilterThermalSensor, "acpitz", false},
+	}
+
+	filters := map[bool]*Filters{
+		true:  NewFilters(true, rules...),
+		false: NewFilters(false, rules...),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filters[tt.defaults].Allow(tt.target, tt.value); got != tt.want {
+				t.Errorf("Allow(%s, %q) = %v, want %v", tt.target, tt.value, got, tt.want)
+			}
+		})
+	}
+
+	var none *Filters
+	if !none.Allow(FilterInterface, "veth0") {
+		t.Error("nil filters must keep everything")
+	}
+}
@@ -5,18 +5,38 @@ package system
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/pkg/procf"
 	"github.com/prometheus/procfs"
 )
 
+const sysBlock = "/sys/block"
+
+/*
+hardwareMetricMemory – provides RAM, swap and paging metrics.
+
+	Sizes come from /proc/meminfo, paging rates from the /proc/vmstat
+	counter deltas between two consecutive scrapes and zram figures
+	from mm_stat of every zram block device.
+*/
 type hardwareMetricMemory struct {
-	fs procfs.FS
+	fs     procfs.FS
+	vmstat func() (procf.ProcVmStat, error)
+	sysDir string
+	paging deltaTracker[uint64]
 }
 
 func NewHardwareMetricMemory(fs procfs.FS) *hardwareMetricMemory {
 	return &hardwareMetricMemory{
-		fs: fs,
+		fs:     fs,
+		vmstat: procf.ReadProcVmstat,
+		sysDir: sysBlock,
 	}
 }
 
@@ -27,13 +47,19 @@ func (hmm *hardwareMetricMemory) ScrapeMemoryMetrics(ctx context.Context) (domai
 			ErrScrapeMemoryMetrics.Wrap(err)
 	}
 
+	vm, err := hmm.vmstat()
+	if err != nil {
+		return domain.MemoryMetrics{},
+			ErrScrapeMemoryMetrics.Wrap(err)
+	}
+
 	if err := ctx.Err(); err != nil {
 		return domain.MemoryMetrics{},
 			ErrScrapeMemoryMetrics.Wrap(ctx.Err())
 	}
 
-	total := uwPtr(mem.MemTotal)
-	avail := uwPtr(mem.MemAvailable)
+	total := uwPtr(mem.MemTotalBytes)
+	avail := uwPtr(mem.MemAvailableBytes)
 	used := total - avail
 
 	stotal := uwPtr(mem.SwapTotalBytes)
@@ -44,14 +70,110 @@ func (hmm *hardwareMetricMemory) ScrapeMemoryMetrics(ctx context.Context) (domai
 		Total:       total,
 		Available:   avail,
 		Used:        used,
-		Free:        uwPtr(mem.MemFree),
-		UsedPercent: usedPercent[uint64, float64](avail, total),
+		Free:        uwPtr(mem.MemFreeBytes),
+		UsedPercent: usedPercent[uint64, float64](used, total),
 
-		SwapTotal:       stotal,
+		SwapTotal: stotal,
+		// swap cache pages are dropped from swap once they are needed
+		SwapAvailable:   sfree + uwPtr(mem.SwapCachedBytes),
 		SwapFree:        sfree,
 		SwapUsed:        sused,
 		SwapUsedPercent: usedPercent[uint64, float64](sused, stotal),
+
+		Buffers:      uwPtr(mem.BuffersBytes),
+		Cached:       uwPtr(mem.CachedBytes),
+		SwapCached:   uwPtr(mem.SwapCachedBytes),
+		Shmem:        uwPtr(mem.ShmemBytes),
+		Slab:         uwPtr(mem.SlabBytes),
+		SReclaimable: uwPtr(mem.SReclaimableBytes),
+		SUnreclaim:   uwPtr(mem.SUnreclaimBytes),
+		Dirty:        uwPtr(mem.DirtyBytes),
+		Writeback:    uwPtr(mem.WritebackBytes),
+		CommittedAS:  uwPtr(mem.CommittedASBytes),
+		CommitLimit:  uwPtr(mem.CommitLimitBytes),
+
+		HugePages: domain.HugePages{
+			Total:    uwPtr(mem.HugePagesTotal),
+			Free:     uwPtr(mem.HugePagesFree),
+			Reserved: uwPtr(mem.HugePagesRsvd),
+			Surplus:  uwPtr(mem.HugePagesSurp),
+			PageSize: uwPtr(mem.HugepagesizeBytes),
+		},
+
+		Zswap:    uwPtr(mem.ZswapBytes),
+		Zswapped: uwPtr(mem.ZswappedBytes),
+		Zram:     hmm.scrapeZram(),
 	}
 
+	data.Activity = hmm.pagingActivity(vm)
+
 	return data, nil
 }
+
+// vmstatRates – /proc/vmstat counters reported as per second rates.
+var vmstatRates = [...]string{"pgfault", "pgmajfault", "pswpin", "pswpout", "pgpgin", "pgpgout"}
+
+// pagingActivity – returns the paging rates since the previous scrape.
+func (hmm *hardwareMetricMemory) pagingActivity(vm procf.ProcVmStat) domain.MemoryActivity {
+	cur := make(map[string]uint64, len(vmstatRates))
+	for _, key := range vmstatRates {
+		cur[key] = vm[key]
+	}
+
+	act := domain.MemoryActivity{
+		OOMKills: vm["oom_kill"],
+	}
+
+	prev, elapsed, ok := hmm.paging.advance(cur, time.Now())
+	if !ok {
+		return act
+	}
+
+	rate := func(key string) uint64 {
+		return counterRate(prev[key], cur[key], elapsed)
+	}
+
+	act.PageFaultsPerSec = rate("pgfault")
+	act.MajorFaultsPerSec = rate("pgmajfault")
+	act.SwapInPerSec = rate("pswpin")
+	act.SwapOutPerSec = rate("pswpout")
+	act.PageInPerSec = rate("pgpgin")
+	act.PageOutPerSec = rate("pgpgout")
+
+	return act
+}
+
+/*
+scrapeZram – sums mm_stat of all zram devices.
+
+	mm_stat fields are orig_data_size, compr_data_size, mem_used_total
+	and more, all in bytes. Devices without mm_stat are skipped.
+*/
+func (hmm *hardwareMetricMemory) scrapeZram() domain.Zram {
+	var zram domain.Zram
+
+	devices, _ := filepath.Glob(filepath.Join(hmm.sysDir, "zram*"))
+	for _, dev := range devices {
+		data, err := os.ReadFile(filepath.Join(dev, "mm_stat"))
+		if err != nil {
+			continue
+		}
+
+		fields := strings.Fields(string(data))
+		if len(fields) < 3 {
+			continue
+		}
+
+		value := func(i int) uint64 {
+			v, _ := strconv.ParseUint(fields[i], 10, 64)
+			return v
+		}
+
+		zram.Devices++
+		zram.OrigData += value(0)
+		zram.Compressed += value(1)
+		zram.MemUsed += value(2)
+	}
+
+	return zram
+}
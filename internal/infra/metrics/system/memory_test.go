@@ -0,0 +1,101 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/pkg/procf"
+	"github.com/prometheus/procfs"
+)
+
+const testMeminfo = `MemTotal:       16000000 kB
+MemFree:         2000000 kB
+MemAvailable:    8000000 kB
+Buffers:          100000 kB
+Cached:          4000000 kB
+SwapCached:        10000 kB
+Shmem:            300000 kB
+Slab:             500000 kB
+SReclaimable:     400000 kB
+SUnreclaim:       100000 kB
+Dirty:              1000 kB
+Writeback:             0 kB
+SwapTotal:       4000000 kB
+SwapFree:        3000000 kB
+Zswap:             50000 kB
+Zswapped:         200000 kB
+CommitLimit:    12000000 kB
+Committed_AS:   20000000 kB
+HugePages_Total:      16
+HugePages_Free:        8
+HugePages_Rsvd:        2
+HugePages_Surp:        0
+Hugepagesize:       2048 kB
+`
+
+func Test_hardwareMetricMemory(t *testing.T) {
+	proc := t.TempDir()
+	if err := os.WriteFile(filepath.Join(proc, "meminfo"), []byte(testMeminfo), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	zram := filepath.Join(t.TempDir(), "zram0")
+	if err := os.MkdirAll(zram, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(zram, "mm_stat"), []byte("  4096000  1024000  1200000        0  1200000       10        0        0        0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := procfs.NewFS(proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vm := procf.ProcVmStat{"pgfault": 1000, "pgmajfault": 10, "pswpin": 0, "pswpout": 0, "oom_kill": 3}
+
+	hmm := NewHardwareMetricMemory(fs)
+	hmm.sysDir = filepath.Dir(zram)
+	hmm.vmstat = func() (procf.ProcVmStat, error) { return vm, nil }
+
+	m, err := hmm.ScrapeMemoryMetrics(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const kb = 1024
+	if m.Total != 16000000*kb || m.Used != 8000000*kb || m.UsedPercent != 50 {
+		t.Errorf("ram = %d total, %d used, %.1f%%", m.Total, m.Used, m.UsedPercent)
+	}
+	if m.SwapUsed != 1000000*kb || m.SwapAvailable != 3010000*kb || m.SwapUsedPercent != 25 {
+		t.Errorf("swap = %d used, %d available, %.1f%%", m.SwapUsed, m.SwapAvailable, m.SwapUsedPercent)
+	}
+	if m.Cached != 4000000*kb || m.SReclaimable != 400000*kb || m.CommittedAS != 20000000*kb || m.Zswapped != 200000*kb {
+		t.Errorf("meminfo = %+v", m)
+	}
+	if m.HugePages != (domain.HugePages{Total: 16, Free: 8, Reserved: 2, PageSize: 2048 * kb}) {
+		t.Errorf("huge pages = %+v", m.HugePages)
+	}
+	if m.Zram != (domain.Zram{Devices: 1, OrigData: 4096000, Compressed: 1024000, MemUsed: 1200000}) {
+		t.Errorf("zram = %+v", m.Zram)
+	}
+	if m.Activity != (domain.MemoryActivity{OOMKills: 3}) {
+		t.Errorf("first scrape activity = %+v, want only the OOM kill count", m.Activity)
+	}
+
+	vm = procf.ProcVmStat{"pgfault": 1000000, "pgmajfault": 10, "pswpin": 0, "pswpout": 500, "oom_kill": 3}
+
+	m, err = hmm.ScrapeMemoryMetrics(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := m.Activity; a.PageFaultsPerSec == 0 || a.MajorFaultsPerSec != 0 || a.SwapOutPerSec == 0 || a.SwapInPerSec != 0 {
+		t.Errorf("activity = %+v", a)
+	}
+}
@@ -4,53 +4,122 @@
 package system
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/eterline/fstmon/internal/domain"
 	"github.com/prometheus/procfs"
+	"github.com/prometheus/procfs/sysfs"
+)
+
+const (
+	sysRoot         = "/sys"
+	arphrdLoopback  = 772 // ARPHRD_LOOPBACK of include/uapi/linux/if_arp.h
+	megabitsPerByte = 8.0 / 1e6
 )
 
 /*
 hardwareMetricNetwork  – provides network interface metrics for the host.
 
 	It allows fetching per-interface counters, including bytes sent/received,
-	packet counts, and error counts. Supports measuring speed over a short interval.
+	packet counts, and error counts. Speeds are computed from the counter
+	deltas between two consecutive scrapes. Link metadata is read from
+	/sys/class/net and addresses of all interfaces are requested
+	with a single netlink dump per scrape.
 */
 type hardwareMetricNetwork struct {
-	fs procfs.FS
+	fs      procfs.FS
+	filters *Filters
+	sysDir  string
+	addrs   func() (map[int64][]*net.IPNet, error)
+	delta   deltaTracker[procfs.NetDevLine]
 }
 
 /*
 NewHardwareMetricNetwork  – creates a new hardwareMetricNetwork instance.
 */
-func NewHardwareMetricNetwork(fs procfs.FS) *hardwareMetricNetwork {
+func NewHardwareMetricNetwork(fs procfs.FS, filters *Filters) *hardwareMetricNetwork {
 	return &hardwareMetricNetwork{
-		fs: fs,
+		fs:      fs,
+		filters: filters,
+		sysDir:  sysRoot,
+		addrs:   interfaceAddrs,
 	}
 }
 
 /*
-ScrapeInterfacesIO – collects network metrics for all interfaces.
+interfaceAddrs – returns addresses of all interfaces by interface index.
 
-	It performs two snapshots of per-interface I/O counters with a 1-second interval
-	to calculate approximate network speed.
+	A single RTM_GETADDR dump replaces net.Interface.Addrs, which
+	dumps the addresses of every interface on each call. IPv4 peers
+	of point-to-point links are skipped for the local address.
 */
-func (hmn *hardwareMetricNetwork) ScrapeInterfacesIO(ctx context.Context) (domain.InterfacesIOMap, error) {
-	io0, err := hmn.fs.NetDev()
+func interfaceAddrs() (map[int64][]*net.IPNet, error) {
+	rib, err := syscall.NetlinkRIB(syscall.RTM_GETADDR, syscall.AF_UNSPEC)
 	if err != nil {
-		return domain.InterfacesIOMap{},
-			ErrScrapeInterfacesIO.Wrap(err)
+		return nil, os.NewSyscallError("netlinkrib", err)
 	}
 
-	select {
-	case <-ctx.Done():
-		return domain.InterfacesIOMap{},
-			ErrScrapeInterfacesIO.Wrap(ctx.Err())
-	case <-time.After(1 * time.Second):
+	msgs, err := syscall.ParseNetlinkMessage(rib)
+	if err != nil {
+		return nil, os.NewSyscallError("parsenetlinkmessage", err)
 	}
 
-	io1, err := hmn.fs.NetDev()
+	addrs := make(map[int64][]*net.IPNet)
+
+	for _, m := range msgs {
+		if m.Header.Type == syscall.NLMSG_DONE {
+			break
+		}
+		if m.Header.Type != syscall.RTM_NEWADDR || len(m.Data) < syscall.SizeofIfAddrmsg {
+			continue
+		}
+
+		family, prefix := m.Data[0], int(m.Data[1])
+		index := int64(binary.NativeEndian.Uint32(m.Data[4:8]))
+
+		attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+		if err != nil {
+			continue
+		}
+
+		var ip net.IP
+		for _, a := range attrs {
+			switch {
+			case a.Attr.Type == syscall.IFA_LOCAL && family == syscall.AF_INET:
+				ip = net.IP(a.Value)
+			case a.Attr.Type == syscall.IFA_ADDRESS && ip == nil:
+				ip = net.IP(a.Value)
+			}
+		}
+		if ip == nil {
+			continue
+		}
+
+		addrs[index] = append(addrs[index], &net.IPNet{
+			IP:   ip,
+			Mask: net.CIDRMask(prefix, len(ip)*8),
+		})
+	}
+
+	return addrs, nil
+}
+
+/*
+ScrapeInterfacesIO – collects network metrics of the interfaces kept by the filters.
+
+	Speeds are averaged over the time since the previous scrape.
+	The first scrape and new interfaces report zero speeds. An
+	interface whose sysfs entry is unreadable keeps an empty link.
+*/
+func (hmn *hardwareMetricNetwork) ScrapeInterfacesIO(ctx context.Context) (domain.InterfacesIOMap, error) {
+	dev, err := hmn.fs.NetDev()
 	if err != nil {
 		return domain.InterfacesIOMap{},
 			ErrScrapeInterfacesIO.Wrap(err)
@@ -58,39 +127,150 @@ func (hmn *hardwareMetricNetwork) ScrapeInterfacesIO(ctx context.Context) (domai
 
 	if err := ctx.Err(); err != nil {
 		return domain.InterfacesIOMap{},
-			ErrScrapeInterfacesIO.Wrap(ctx.Err())
+			ErrScrapeInterfacesIO.Wrap(err)
 	}
 
-	data := make(domain.InterfacesIOMap, len(io1))
+	prev, elapsed, _ := hmn.delta.advance(dev, time.Now())
+
+	sys, sysErr := sysfs.NewFS(hmn.sysDir)
+	addrs, _ := hmn.addrs()
+
+	data := make(domain.InterfacesIOMap, len(dev))
 
-	/* Initialize map with full counters and assume speed = full counters initially */
-	for _, v := range io1 {
-		data[v.Name] = domain.InterfaceIO{
+	for name, v := range dev {
+		if !hmn.filters.Allow(FilterInterface, name) {
+			continue
+		}
+
+		io := domain.InterfaceIO{
 			BytesTotal:       domain.NewIO(v.RxBytes, v.TxBytes),
 			PacketsTotal:     domain.NewIO(v.RxPackets, v.TxPackets),
 			ErrPacketsTotal:  domain.NewIO(v.RxErrors, v.TxErrors),
 			DropPacketsTotal: domain.NewIO(v.RxDropped, v.TxDropped),
+		}
 
-			BytesPerSec:   domain.NewIO(v.RxBytes, v.TxBytes),
-			PacketsPerSec: domain.NewIO(v.RxPackets, v.TxPackets),
+		if p, ok := prev[name]; ok {
+			io.BytesPerSec = domain.NewIO(
+				counterRate(p.RxBytes, v.RxBytes, elapsed),
+				counterRate(p.TxBytes, v.TxBytes, elapsed),
+			)
+			io.PacketsPerSec = domain.NewIO(
+				counterRate(p.RxPackets, v.RxPackets, elapsed),
+				counterRate(p.TxPackets, v.TxPackets, elapsed),
+			)
 		}
+
+		if sysErr == nil {
+			io.Link = hmn.interfaceLink(sys, name, addrs)
+			io.UtilizationPercent = linkUtilization(io.Link, io.BytesPerSec)
+		}
+
+		data[name] = io
 	}
 
-	/* Subtract first snapshot to compute actual speed during the interval */
-	for _, v := range io0 {
-		c, ok := data[v.Name]
-		if !ok {
-			continue
+	return data, nil
+}
+
+// interfaceLink – reads the link metadata of an interface, addrs are indexed by ifindex.
+func (hmn *hardwareMetricNetwork) interfaceLink(sys sysfs.FS, name string, addrs map[int64][]*net.IPNet) domain.InterfaceLink {
+	class, err := sys.NetClassByIface(name)
+	if err != nil {
+		return domain.InterfaceLink{}
+	}
+
+	dir := filepath.Join(hmn.sysDir, "class", "net", name)
+
+	link := domain.InterfaceLink{
+		Kind:      interfaceKind(dir, class),
+		OperState: class.OperState,
+		Carrier:   uwPtr(class.Carrier) == 1,
+		SpeedMbps: uint64(max(uwPtr(class.Speed), 0)),
+		Duplex:    class.Duplex,
+		MTU:       uint64(max(uwPtr(class.MTU), 0)),
+		MAC:       class.Address,
+	}
+
+	if master, err := os.Readlink(filepath.Join(dir, "master")); err == nil {
+		link.Master = filepath.Base(master)
+	}
+
+	for _, ipnet := range addrs[uwPtr(class.IfIndex)] {
+		if ipnet.IP.To4() != nil {
+			link.IPv4 = append(link.IPv4, ipnet.String())
+		} else {
+			link.IPv6 = append(link.IPv6, ipnet.String())
+		}
+	}
+
+	return link
+}
+
+/*
+interfaceKind – classifies an interface by its sysfs entry.
+
+	Bridges, bonds, VLANs and WireGuard tunnels are named by DEVTYPE
+	of uevent. Tun and tap devices have tun_flags, physical ones a
+	device link. A veth has no device and is linked to its peer.
+*/
+func interfaceKind(dir string, class *sysfs.NetClassIface) domain.InterfaceKind {
+	exists := func(name string) bool {
+		_, err := os.Lstat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	if uevent, err := os.ReadFile(filepath.Join(dir, "uevent")); err == nil {
+		for line := range bytes.Lines(uevent) {
+			devtype, ok := bytes.CutPrefix(bytes.TrimSpace(line), []byte("DEVTYPE="))
+			if !ok {
+				continue
+			}
+			switch string(devtype) {
+			case "bridge":
+				return domain.InterfaceBridge
+			case "bond":
+				return domain.InterfaceBond
+			case "vlan":
+				return domain.InterfaceVlan
+			case "wireguard":
+				return domain.InterfaceWireGuard
+			}
 		}
+	}
 
-		c.BytesPerSec.DecRX(v.RxBytes)
-		c.BytesPerSec.DecTX(v.TxBytes)
+	switch {
+	case exists("bridge"):
+		return domain.InterfaceBridge
+	case exists("bonding"):
+		return domain.InterfaceBond
+	case exists("tun_flags"):
+		return domain.InterfaceTun
+	case uwPtr(class.Type) == arphrdLoopback:
+		return domain.InterfaceLoopback
+	case exists("device"):
+		return domain.InterfacePhysical
+	case class.IfLink != nil && class.IfIndex != nil && *class.IfLink != *class.IfIndex:
+		return domain.InterfaceVeth
+	}
+
+	return domain.InterfaceVirtual
+}
+
+/*
+linkUtilization – returns the share of the link speed in use.
 
-		c.PacketsPerSec.DecRX(v.RxPackets)
-		c.PacketsPerSec.DecTX(v.TxPackets)
+	A full-duplex link carries the speed in both directions, so the
+	busier one is reported. On half-duplex links both directions
+	share the speed.
+*/
+func linkUtilization(link domain.InterfaceLink, rate domain.IO[uint64]) float64 {
+	if link.SpeedMbps == 0 {
+		return 0
+	}
 
-		data[v.Name] = c
+	used := float64(max(rate.RX, rate.TX))
+	if link.Duplex == "half" {
+		used = float64(rate.RX + rate.TX)
 	}
 
-	return data, nil
+	return min(used*megabitsPerByte/float64(link.SpeedMbps)*100, 100)
 }
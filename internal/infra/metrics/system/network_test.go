@@ -0,0 +1,132 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+func Test_linkUtilization(t *testing.T) {
+	tests := []struct {
+		name string
+		link domain.InterfaceLink
+		rate domain.IO[uint64]
+		want float64
+	}{
+		{"unknown speed", domain.InterfaceLink{Duplex: "full"}, domain.NewIO[uint64](1e6, 1e6), 0},
+		{"full duplex busier tx", domain.InterfaceLink{SpeedMbps: 1000, Duplex: "full"}, domain.NewIO[uint64](1e6, 25e6), 20},
+		{"half duplex shares", domain.InterfaceLink{SpeedMbps: 100, Duplex: "half"}, domain.NewIO[uint64](2.5e6, 2.5e6), 40},
+		{"capped", domain.InterfaceLink{SpeedMbps: 10, Duplex: "full"}, domain.NewIO[uint64](5e6, 0), 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := linkUtilization(tt.link, tt.rate); got != tt.want {
+				t.Errorf("linkUtilization() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+const testNetDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+  eth0: 1000 10 0 0 0 0 0 0 2000 20 0 0 0 0 0 0
+   br0: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+vethab12: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+   wg0: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+  tun0: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+`
+
+func Test_hardwareMetricNetwork(t *testing.T) {
+	proc := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(proc, "net"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(proc, "net", "dev"), []byte(testNetDev), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	sys := t.TempDir()
+	class := filepath.Join(sys, "class", "net")
+
+	ifaces := map[string]map[string]string{
+		"eth0":     {"operstate": "up\n", "carrier": "1\n", "speed": "1000\n", "duplex": "full\n", "mtu": "1500\n", "address": "52:54:00:12:34:56\n", "type": "1\n", "ifindex": "2\n", "iflink": "2\n"},
+		"br0":      {"operstate": "up\n", "type": "1\n", "uevent": "DEVTYPE=bridge\nINTERFACE=br0\nIFINDEX=3\n", "ifindex": "3\n", "iflink": "3\n"},
+		"vethab12": {"operstate": "up\n", "type": "1\n", "uevent": "INTERFACE=vethab12\nIFINDEX=7\n", "ifindex": "7\n", "iflink": "6\n"},
+		"wg0":      {"operstate": "unknown\n", "type": "65534\n", "uevent": "DEVTYPE=wireguard\nINTERFACE=wg0\n", "ifindex": "8\n", "iflink": "8\n"},
+		"tun0":     {"operstate": "up\n", "type": "65534\n", "tun_flags": "0x1001\n", "ifindex": "9\n", "iflink": "9\n"},
+	}
+	for name, files := range ifaces {
+		dir := filepath.Join(class, name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		for file, data := range files {
+			if err := os.WriteFile(filepath.Join(dir, file), []byte(data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for link, target := range map[string]string{
+		"eth0/device":     "../../../devices/pci0000:00/0000:00:03.0",
+		"vethab12/master": "../br0",
+	} {
+		if err := os.Symlink(target, filepath.Join(class, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := procfs.NewFS(proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hmn := NewHardwareMetricNetwork(fs, nil)
+	hmn.sysDir = sys
+	hmn.addrs = func() (map[int64][]*net.IPNet, error) {
+		return map[int64][]*net.IPNet{
+			2: { // eth0
+				{IP: net.IPv4(192, 168, 1, 10).To4(), Mask: net.CIDRMask(24, 32)},
+				{IP: net.ParseIP("fe80::5054:ff:fe12:3456"), Mask: net.CIDRMask(64, 128)},
+			},
+		}, nil
+	}
+
+	m, err := hmn.ScrapeInterfacesIO(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eth := m["eth0"].Link
+	if eth.Kind != domain.InterfacePhysical || eth.OperState != "up" || !eth.Carrier || eth.SpeedMbps != 1000 ||
+		eth.Duplex != "full" || eth.MTU != 1500 || eth.MAC != "52:54:00:12:34:56" {
+		t.Errorf("eth0 = %+v", eth)
+	}
+	if !slices.Equal(eth.IPv4, []string{"192.168.1.10/24"}) || !slices.Equal(eth.IPv6, []string{"fe80::5054:ff:fe12:3456/64"}) {
+		t.Errorf("eth0 addresses = %v, %v", eth.IPv4, eth.IPv6)
+	}
+
+	kinds := map[string]domain.InterfaceKind{
+		"br0":      domain.InterfaceBridge,
+		"vethab12": domain.InterfaceVeth,
+		"wg0":      domain.InterfaceWireGuard,
+		"tun0":     domain.InterfaceTun,
+	}
+	for name, kind := range kinds {
+		if got := m[name].Link.Kind; got != kind {
+			t.Errorf("%s kind = %q, want %q", name, got, kind)
+		}
+	}
+	if master := m["vethab12"].Link.Master; master != "br0" {
+		t.Errorf("vethab12 master = %q, want br0", master)
+	}
+}
@@ -14,86 +14,96 @@ import (
 )
 
 type hardwareMetricPartitions struct {
-	fs procfs.FS
+	fs      procfs.FS
+	filters *Filters
+	delta   deltaTracker[diskPs.IOCountersStat]
 }
 
-func NewHardwareMetricPartitions(fs procfs.FS) *hardwareMetricPartitions {
+func NewHardwareMetricPartitions(fs procfs.FS, filters *Filters) *hardwareMetricPartitions {
 	return &hardwareMetricPartitions{
-		fs: fs,
+		fs:      fs,
+		filters: filters,
 	}
 }
 
+/*
+ScrapeDiskIO – collects I/O counters of the block devices kept by the filters.
+
+	Per-second values are averaged over the time since the previous scrape.
+	The first scrape and new devices report zero speeds.
+*/
 func (hmp *hardwareMetricPartitions) ScrapeDiskIO(ctx context.Context) (domain.DiskIOMap, error) {
-	ct0, err := diskPs.IOCountersWithContext(ctx)
+	counters, err := diskPs.IOCountersWithContext(ctx)
 	if err != nil {
 		return nil, ErrScrapeDiskIO.Wrap(err)
 	}
 
-	select {
-	case <-ctx.Done():
-		return domain.DiskIOMap{}, ErrScrapeDiskIO.Wrap(ctx.Err())
-	case <-time.After(1 * time.Second):
-	}
+	prev, elapsed, _ := hmp.delta.advance(counters, time.Now())
 
-	ct1, err := diskPs.IOCountersWithContext(ctx)
-	if err != nil {
-		return nil, ErrScrapeDiskIO.Wrap(err)
-	}
+	data := make(domain.DiskIOMap, len(counters))
 
-	data := make(domain.DiskIOMap, len(ct0))
+	for dev, io := range counters {
+		if !hmp.filters.Allow(FilterDiskDevice, dev) {
+			continue
+		}
 
-	for iface, io := range ct1 {
 		rxTime := usecase.MsToDuration(io.ReadTime)
 		txTime := usecase.MsToDuration(io.WriteTime)
 
-		data[iface] = domain.DiskIO{
+		stat := domain.DiskIO{
 			IopsInProgress: io.IopsInProgress,
 
 			Ops:       domain.NewIO(io.ReadCount, io.WriteCount),
 			MergedOps: domain.NewIO(io.MergedReadCount, io.MergedWriteCount),
 			Bytes:     domain.NewIO(io.ReadBytes, io.WriteBytes),
 
-			OpsPerSec:       domain.NewIO(io.ReadCount, io.WriteCount),
-			MergedOpsPerSec: domain.NewIO(io.MergedReadCount, io.MergedWriteCount),
-			BytesPerSec:     domain.NewIO(io.ReadBytes, io.WriteBytes),
-
 			Time:       domain.NewIO(rxTime, txTime),
 			IoTime:     usecase.MsToDuration(io.IoTime),
 			WeightedIO: usecase.MsToDuration(io.WeightedIO),
 		}
-	}
 
-	/* Subtract first snapshot to compute actual speed during the interval */
-	for iface, io := range ct0 {
-		c, ok := data[iface]
-		if !ok {
-			continue
+		if p, ok := prev[dev]; ok {
+			stat.OpsPerSec = domain.NewIO(
+				counterRate(p.ReadCount, io.ReadCount, elapsed),
+				counterRate(p.WriteCount, io.WriteCount, elapsed),
+			)
+			stat.MergedOpsPerSec = domain.NewIO(
+				counterRate(p.MergedReadCount, io.MergedReadCount, elapsed),
+				counterRate(p.MergedWriteCount, io.MergedWriteCount, elapsed),
+			)
+			stat.BytesPerSec = domain.NewIO(
+				counterRate(p.ReadBytes, io.ReadBytes, elapsed),
+				counterRate(p.WriteBytes, io.WriteBytes, elapsed),
+			)
 		}
 
-		c.OpsPerSec.DecRX(io.ReadCount)
-		c.OpsPerSec.DecTX(io.WriteCount)
-
-		c.MergedOpsPerSec.DecRX(io.MergedReadCount)
-		c.MergedOpsPerSec.DecTX(io.MergedWriteCount)
-
-		c.BytesPerSec.DecRX(io.ReadBytes)
-		c.BytesPerSec.DecTX(io.WriteBytes)
-
-		data[iface] = c
+		data[dev] = stat
 	}
 
 	return data, nil
 }
 
+/*
+ScrapePartitions – collects mounted partitions kept by the filters and their usage.
+
+	Devices, mount points and filesystem types are all matched,
+	usage of a dropped partition is not requested.
+*/
 func (hmp *hardwareMetricPartitions) ScrapePartitions(ctx context.Context) (domain.Partitions, error) {
 	prts, err := diskPs.PartitionsWithContext(ctx, true)
 	if err != nil {
 		return domain.Partitions{}, ErrScrapePartitions.Wrap(err)
 	}
 
-	data := make(domain.Partitions, len(prts))
+	data := make(domain.Partitions, 0, len(prts))
+
+	for _, v := range prts {
+		if !hmp.filters.Allow(FilterPartDevice, v.Device) ||
+			!hmp.filters.Allow(FilterPartMount, v.Mountpoint) ||
+			!hmp.filters.Allow(FilterPartFSType, v.Fstype) {
+			continue
+		}
 
-	for i, v := range prts {
 		part := domain.Partition{
 			Device:     v.Device,
 			Mount:      v.Mountpoint,
@@ -116,7 +126,7 @@ func (hmp *hardwareMetricPartitions) ScrapePartitions(ctx context.Context) (doma
 			}
 		}
 
-		data[i] = part
+		data = append(data, part)
 	}
 
 	return data, nil
@@ -0,0 +1,214 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+// pressureResources – PSI resources in the order of domain.Pressure fields.
+var pressureResources = [...]string{"cpu", "memory", "io"}
+
+// pressureDisabled – reports whether the error means PSI is not available on the kernel.
+func pressureDisabled(err error) bool {
+	// CONFIG_PSI=n has no pressure files, psi=0 fails reading them
+	return errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.EOPNOTSUPP)
+}
+
+/*
+parsePressure – parses a PSI file of the form
+
+	some avg10=0.12 avg60=0.08 avg300=0.02 total=123456
+	full avg10=0.00 avg60=0.00 avg300=0.00 total=7890
+*/
+func parsePressure(data []byte) (procfs.PSIStats, error) {
+	var stats procfs.PSIStats
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		kind, values, ok := strings.Cut(sc.Text(), " ")
+		if !ok {
+			continue
+		}
+
+		var line procfs.PSILine
+		if _, err := fmt.Sscanf(values, "avg10=%f avg60=%f avg300=%f total=%d",
+			&line.Avg10, &line.Avg60, &line.Avg300, &line.Total); err != nil {
+			return procfs.PSIStats{}, fmt.Errorf("pressure line %q: %w", sc.Text(), err)
+		}
+
+		switch kind {
+		case "some":
+			stats.Some = &line
+		case "full":
+			stats.Full = &line
+		}
+	}
+
+	return stats, nil
+}
+
+func pressureLine(l *procfs.PSILine) domain.PressureLine {
+	if l == nil {
+		return domain.PressureLine{}
+	}
+
+	return domain.PressureLine{
+		Avg10:  l.Avg10,
+		Avg60:  l.Avg60,
+		Avg300: l.Avg300,
+		Total:  time.Duration(l.Total) * time.Microsecond,
+	}
+}
+
+func pressureStats(s procfs.PSIStats) domain.PressureStats {
+	return domain.PressureStats{
+		Some: pressureLine(s.Some),
+		Full: pressureLine(s.Full),
+	}
+}
+
+// pressureResource – returns the stats of the resource named as in pressureResources.
+func pressureResource(p *domain.Pressure, name string) *domain.PressureStats {
+	switch name {
+	case "cpu":
+		return &p.CPU
+	case "memory":
+		return &p.Memory
+	default:
+		return &p.IO
+	}
+}
+
+// pressureTotals – records the stall totals of p in usec under prefix keys.
+func pressureTotals(p *domain.Pressure, prefix string, totals map[string]uint64) {
+	for _, res := range pressureResources {
+		stats := pressureResource(p, res)
+		totals[prefix+res+".some"] = uint64(stats.Some.Total / time.Microsecond)
+		totals[prefix+res+".full"] = uint64(stats.Full.Total / time.Microsecond)
+	}
+}
+
+// pressureStalls – sets the stall times of p since the previous totals under prefix keys.
+func pressureStalls(p *domain.Pressure, prefix string, prev map[string]uint64, elapsed time.Duration) {
+	stall := func(line *domain.PressureLine, key string) {
+		before, ok := prev[key]
+		if !ok || elapsed <= 0 {
+			return
+		}
+		line.Stall = time.Duration(counterDelta(before, uint64(line.Total/time.Microsecond))) * time.Microsecond
+		line.StallPercent = min(float64(line.Stall)/float64(elapsed)*100, 100)
+	}
+
+	for _, res := range pressureResources {
+		stats := pressureResource(p, res)
+		stall(&stats.Some, prefix+res+".some")
+		stall(&stats.Full, prefix+res+".full")
+	}
+}
+
+/*
+hardwareMetricPressure – provides Pressure Stall Information.
+
+	The host PSI is read from /proc/pressure, cgroup PSI from the
+	cpu.pressure, memory.pressure and io.pressure files of every
+	cgroup v2 group. Stall times are computed from the total
+	counter deltas between two consecutive scrapes.
+*/
+type hardwareMetricPressure struct {
+	fs         procfs.FS
+	cgroupRoot string
+	delta      deltaTracker[uint64]
+}
+
+/*
+NewHardwareMetricPressure – creates a new hardwareMetricPressure instance.
+
+	An empty cgroupRoot skips the cgroup PSI.
+*/
+func NewHardwareMetricPressure(fs procfs.FS, cgroupRoot string) *hardwareMetricPressure {
+	return &hardwareMetricPressure{
+		fs:         fs,
+		cgroupRoot: cgroupRoot,
+	}
+}
+
+// ScrapePressure – collects the PSI of the host and its cgroups.
+func (hmp *hardwareMetricPressure) ScrapePressure(ctx context.Context) (domain.PressureMetrics, error) {
+	data := domain.PressureMetrics{Available: true}
+
+	for _, res := range pressureResources {
+		stats, err := hmp.fs.PSIStatsForResource(res)
+		if err != nil {
+			if pressureDisabled(err) {
+				return domain.PressureMetrics{}, nil
+			}
+			return domain.PressureMetrics{}, ErrScrapePressure.Wrap(err)
+		}
+		*pressureResource(&data.Host, res) = pressureStats(stats)
+	}
+
+	if hmp.cgroupRoot != "" {
+		err := walkCgroups(ctx, hmp.cgroupRoot, func(dir, rel string) {
+			if p, ok := readCgroupPressure(dir); ok {
+				cp := domain.CgroupPressure{Path: rel, Pressure: p}
+				cp.Kind, cp.Name, _ = classifyCgroup(rel)
+				data.Cgroups = append(data.Cgroups, cp)
+			}
+		})
+		if err != nil {
+			return domain.PressureMetrics{}, ErrScrapePressure.Wrap(err)
+		}
+	}
+
+	totals := make(map[string]uint64, (len(data.Cgroups)+1)*len(pressureResources)*2)
+	pressureTotals(&data.Host, "", totals)
+	for i := range data.Cgroups {
+		pressureTotals(&data.Cgroups[i].Pressure, data.Cgroups[i].Path+":", totals)
+	}
+
+	prev, elapsed, _ := hmp.delta.advance(totals, time.Now())
+
+	pressureStalls(&data.Host, "", prev, elapsed)
+	for i := range data.Cgroups {
+		pressureStalls(&data.Cgroups[i].Pressure, data.Cgroups[i].Path+":", prev, elapsed)
+	}
+
+	return data, nil
+}
+
+/*
+readCgroupPressure – reads the PSI files of a cgroup.
+
+	ok is false when the cgroup has no readable PSI, e.g. it was
+	disabled for the cgroup by cgroup.pressure.
+*/
+func readCgroupPressure(dir string) (p domain.Pressure, ok bool) {
+	for _, res := range pressureResources {
+		data, err := os.ReadFile(filepath.Join(dir, res+".pressure"))
+		if err != nil {
+			return domain.Pressure{}, false
+		}
+
+		stats, err := parsePressure(data)
+		if err != nil {
+			return domain.Pressure{}, false
+		}
+		*pressureResource(&p, res) = pressureStats(stats)
+	}
+
+	return p, true
+}
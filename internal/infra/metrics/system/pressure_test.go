@@ -0,0 +1,93 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/procfs"
+)
+
+// fakePressure – PSI file content with the given some and full totals in usec.
+func fakePressure(some, full uint64) string {
+	return fmt.Sprintf(
+		"some avg10=1.50 avg60=0.75 avg300=0.25 total=%d\nfull avg10=0.50 avg60=0.00 avg300=0.00 total=%d\n",
+		some, full,
+	)
+}
+
+func Test_hardwareMetricPressure(t *testing.T) {
+	proc := t.TempDir()
+	writePressure := func(dir string, files map[string]string) {
+		t.Helper()
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		for name, data := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	fs, err := procfs.NewFS(proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// kernel without PSI
+	m, err := NewHardwareMetricPressure(fs, "").ScrapePressure(context.Background())
+	if err != nil || m.Available {
+		t.Fatalf("without PSI got %+v, %v, want unavailable and no error", m, err)
+	}
+
+	writePressure(filepath.Join(proc, "pressure"), map[string]string{
+		"cpu":    "some avg10=2.21 avg60=1.74 avg300=1.80 total=63302622\n",
+		"memory": fakePressure(1000, 500),
+		"io":     fakePressure(2000000, 1000000),
+	})
+
+	cgroups := t.TempDir()
+	writePressure(filepath.Join(cgroups, "system.slice", "nginx.service"), map[string]string{
+		"cpu.pressure":    fakePressure(0, 0),
+		"memory.pressure": fakePressure(0, 0),
+		"io.pressure":     fakePressure(500000, 0),
+	})
+
+	hmp := NewHardwareMetricPressure(fs, cgroups)
+
+	m, err = hmp.ScrapePressure(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Available || m.Host.CPU.Some.Avg10 != 2.21 || m.Host.CPU.Some.Total != 63302622*time.Microsecond || m.Host.CPU.Full.Total != 0 {
+		t.Errorf("host cpu = %+v", m.Host.CPU)
+	}
+	if m.Host.IO.Full.Total != time.Second || m.Host.IO.Some.Stall != 0 {
+		t.Errorf("host io = %+v, want no stall on the first scrape", m.Host.IO)
+	}
+	// system.slice has no PSI files
+	if len(m.Cgroups) != 1 || m.Cgroups[0].Name != "nginx.service" || m.Cgroups[0].Pressure.IO.Some.Total != 500*time.Millisecond {
+		t.Fatalf("cgroups = %+v", m.Cgroups)
+	}
+
+	writePressure(filepath.Join(proc, "pressure"), map[string]string{"io": fakePressure(2250000, 1000000)})
+	writePressure(filepath.Join(cgroups, "system.slice", "nginx.service"), map[string]string{"io.pressure": fakePressure(600000, 0)})
+
+	m, err = hmp.ScrapePressure(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if io := m.Host.IO; io.Some.Stall != 250*time.Millisecond || io.Full.Stall != 0 || io.Some.StallPercent <= 0 {
+		t.Errorf("host io = %+v, want 250ms some stall", io)
+	}
+	if io := m.Cgroups[0].Pressure.IO; io.Some.Stall != 100*time.Millisecond {
+		t.Errorf("nginx io = %+v, want 100ms some stall", io)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"os/user"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+// pssTopRSS – number of the largest processes by RSS whose PSS is read.
+const pssTopRSS = 32
+
+// processSample – counters of a process kept between scrapes.
+type processSample struct {
+	cpuTicks   uint64 // user and system time in clock ticks
+	readBytes  uint64
+	writeBytes uint64
+}
+
+/*
+hardwareMetricProcesses – provides per-process metrics of the host.
+
+	Processes are read from /proc/[pid]/stat, status, io and cmdline.
+	CPU usage and I/O rates are computed from the counter deltas
+	between two consecutive scrapes. A process is tracked by its PID
+	and start time, so a reused PID never inherits counters.
+*/
+type hardwareMetricProcesses struct {
+	fs    procfs.FS
+	delta deltaTracker[processSample]
+
+	usersMu sync.Mutex
+	users   map[uint64]string
+}
+
+/*
+NewHardwareMetricProcesses – creates a new hardwareMetricProcesses instance.
+*/
+func NewHardwareMetricProcesses(fs procfs.FS) *hardwareMetricProcesses {
+	return &hardwareMetricProcesses{
+		fs:    fs,
+		users: make(map[uint64]string),
+	}
+}
+
+/*
+ScrapeProcesses – collects metrics of all host processes.
+
+	Processes that exit while being read are skipped. Files that are
+	not readable without privileges (io, fd, smaps_rollup of other
+	users) leave their fields zero. PSS is read only for the largest
+	processes by RSS, since smaps_rollup walks the whole address space.
+	The first scrape and new processes report zero CPU usage and rates.
+*/
+func (hmp *hardwareMetricProcesses) ScrapeProcesses(ctx context.Context) (domain.ProcessList, error) {
+	procs, err := hmp.fs.AllProcs()
+	if err != nil {
+		return domain.ProcessList{}, ErrScrapeProcesses.Wrap(err)
+	}
+
+	now := time.Now()
+	list := make(domain.ProcessList, 0, len(procs))
+	samples := make(map[string]processSample, len(procs))
+	keys := make([]string, 0, len(procs))
+
+	for _, p := range procs {
+		if err := ctx.Err(); err != nil {
+			return domain.ProcessList{}, ErrScrapeProcesses.Wrap(err)
+		}
+
+		info, sample, key, ok := hmp.readProcess(p)
+		if !ok {
+			continue
+		}
+
+		list = append(list, info)
+		keys = append(keys, key)
+		samples[key] = sample
+	}
+
+	prev, elapsed, _ := hmp.delta.advance(samples, now)
+
+	for i, key := range keys {
+		p, ok := prev[key]
+		if !ok {
+			continue
+		}
+		cur := samples[key]
+
+		list[i].CPUPercent = processCPUPercent(p.cpuTicks, cur.cpuTicks, elapsed)
+		list[i].ReadRate = counterRate(p.readBytes, cur.readBytes, elapsed)
+		list[i].WriteRate = counterRate(p.writeBytes, cur.writeBytes, elapsed)
+	}
+
+	hmp.scrapePSS(list)
+
+	return list, nil
+}
+
+/*
+readProcess – reads a single process.
+
+	ok is false when the process exited or its stat is unreadable.
+*/
+func (hmp *hardwareMetricProcesses) readProcess(p procfs.Proc) (domain.ProcessInfo, processSample, string, bool) {
+	stat, err := p.Stat()
+	if err != nil {
+		return domain.ProcessInfo{}, processSample{}, "", false
+	}
+
+	info := domain.ProcessInfo{
+		PID:     stat.PID,
+		PPID:    stat.PPID,
+		Name:    stat.Comm,
+		State:   stat.State,
+		RSS:     uint64(max(stat.ResidentMemory(), 0)),
+		Threads: stat.NumThreads,
+	}
+	sample := processSample{
+		cpuTicks: uint64(stat.UTime) + uint64(stat.STime),
+	}
+
+	if status, err := p.NewStatus(); err == nil {
+		info.User = hmp.userName(status.UIDs[0])
+		info.RSS = status.VmRSS
+	}
+
+	if cmd, err := p.CmdLine(); err == nil {
+		info.Command = strings.Join(cmd, " ")
+	}
+
+	if fds, err := p.FileDescriptorsLen(); err == nil {
+		info.FDs = fds
+	}
+
+	if io, err := p.IO(); err == nil {
+		info.ReadBytes, info.WriteBytes = io.ReadBytes, io.WriteBytes
+		sample.readBytes, sample.writeBytes = io.ReadBytes, io.WriteBytes
+	}
+
+	key := strconv.Itoa(stat.PID) + "/" + strconv.FormatUint(stat.Starttime, 10)
+
+	return info, sample, key, true
+}
+
+// scrapePSS – reads the PSS of the largest processes by RSS, the others keep a nil PSS.
+func (hmp *hardwareMetricProcesses) scrapePSS(list domain.ProcessList) {
+	idx := make([]int, len(list))
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.SortFunc(idx, func(a, b int) int {
+		return cmp.Compare(list[b].RSS, list[a].RSS)
+	})
+
+	for _, i := range idx[:min(len(idx), pssTopRSS)] {
+		if list[i].RSS == 0 {
+			break // kernel threads have no address space
+		}
+
+		p, err := hmp.fs.Proc(list[i].PID)
+		if err != nil {
+			continue
+		}
+
+		rollup, err := p.ProcSMapsRollup()
+		if err != nil {
+			continue
+		}
+		list[i].PSS = &rollup.Pss
+	}
+}
+
+/*
+userName – resolves the uid to a user name.
+
+	Names are cached for the agent lifetime, an unknown uid is
+	reported as the number.
+*/
+func (hmp *hardwareMetricProcesses) userName(uid uint64) string {
+	hmp.usersMu.Lock()
+	defer hmp.usersMu.Unlock()
+
+	if name, ok := hmp.users[uid]; ok {
+		return name
+	}
+
+	id := strconv.FormatUint(uid, 10)
+	name := id
+
+	u, err := user.LookupId(id)
+	var unknown user.UnknownUserIdError
+	switch {
+	case err == nil:
+		name = u.Username
+	case !errors.As(err, &unknown):
+		return name // lookup failed, retry with the next scrape
+	}
+
+	hmp.users[uid] = name
+	return name
+}
+
+/*
+processCPUPercent – returns the CPU usage of a process between two samples.
+
+	100 percent is one core fully busy, a multithreaded process may
+	exceed it. Decreasing counters report zero.
+*/
+func processCPUPercent(prevTicks, curTicks uint64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || curTicks < prevTicks {
+		return 0
+	}
+
+	busy := ticksDuration(curTicks - prevTicks)
+	return busy.Seconds() / elapsed.Seconds() * 100
+}
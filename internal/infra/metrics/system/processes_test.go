@@ -0,0 +1,131 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+// writeFakeProcUsage – writes status, io, fd and smaps_rollup of a process written by writeFakeProc.
+func writeFakeProcUsage(t *testing.T, root string, pid int, uid int, rssKB, pssKB, readBytes uint64, fds int) {
+	t.Helper()
+
+	dir := filepath.Join(root, fmt.Sprint(pid))
+	files := map[string]string{
+		"status": fmt.Sprintf("Name:\tproc%d\nUid:\t%d\t%d\t%d\t%d\nVmRSS:\t%8d kB\n", pid, uid, uid, uid, uid, rssKB),
+		"io": fmt.Sprintf("rchar: 0\nwchar: 0\nsyscr: 0\nsyscw: 0\nread_bytes: %d\nwrite_bytes: 0\ncancelled_write_bytes: 0\n",
+			readBytes),
+		"smaps_rollup": fmt.Sprintf("00400000-7ffc0000 ---p 00000000 00:00 0                          [rollup]\n"+
+			"Rss:            %8d kB\nPss:            %8d kB\n", rssKB, pssKB),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fd := filepath.Join(dir, "fd")
+	if err := os.MkdirAll(fd, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for i := range fds {
+		if err := os.Symlink("/dev/null", filepath.Join(fd, strconv.Itoa(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_hardwareMetricProcesses(t *testing.T) {
+	root := t.TempDir()
+
+	// process 100 is the smallest, one more than pssTopRSS processes are larger
+	uid := os.Getuid()
+	for i := range pssTopRSS + 1 {
+		pid := 100 + i
+		writeFakeProc(t, root, pid, "worker", "", 100, "worker", "--id", strconv.Itoa(i))
+		writeFakeProcUsage(t, root, pid, uid, uint64(1000+i), uint64(500+i), 4096, 3)
+	}
+	writeFakeProc(t, root, 2, "kthreadd", "", 1) // no status, io and fd
+
+	fs, err := procfs.NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hmp := NewHardwareMetricProcesses(fs)
+
+	list, err := hmp.ScrapeProcesses(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != pssTopRSS+2 {
+		t.Fatalf("processes = %d, want %d", len(list), pssTopRSS+2)
+	}
+
+	byPID := make(map[int]domain.ProcessInfo, len(list))
+	for _, p := range list {
+		byPID[p.PID] = p
+	}
+
+	wantUser := strconv.Itoa(uid)
+	if u, err := user.LookupId(wantUser); err == nil {
+		wantUser = u.Username
+	}
+
+	largest := byPID[100+pssTopRSS]
+	if largest.Name != "worker" || largest.Command != fmt.Sprintf("worker --id %d", pssTopRSS) || largest.PPID != 1 ||
+		largest.State != "S" || largest.Threads != 1 {
+		t.Errorf("stat and cmdline = %+v", largest)
+	}
+	if largest.RSS != uint64(1000+pssTopRSS)*1024 || largest.FDs != 3 || largest.ReadBytes != 4096 || largest.User != wantUser {
+		t.Errorf("status, fd and io = %+v, want user %q", largest, wantUser)
+	}
+	if largest.PSS == nil || *largest.PSS != uint64(500+pssTopRSS)*1024 {
+		t.Errorf("largest pss = %v, want read", largest.PSS)
+	}
+
+	if pss := byPID[100].PSS; pss != nil {
+		t.Errorf("pss of the smallest process = %d, want not read beyond the top %d", *pss, pssTopRSS)
+	}
+	if kthread := byPID[2]; kthread.FDs != 0 || kthread.User != "" || kthread.ReadBytes != 0 {
+		t.Errorf("process without status, io and fd = %+v, want zero fields", kthread)
+	}
+	if largest.CPUPercent != 0 || largest.ReadRate != 0 {
+		t.Errorf("first scrape = %+v, want zero rates", largest)
+	}
+
+	// 200 more ticks of user time and 1MB more read
+	stat := filepath.Join(root, "100", "stat")
+	data, err := os.ReadFile(stat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stat, []byte(strings.Replace(string(data), " 100 50 ", " 300 50 ", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	writeFakeProcUsage(t, root, 100, uid, 1000, 500, 4096+1<<20, 0)
+
+	list, err = hmp.ScrapeProcesses(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range list {
+		if p.PID == 100 && (p.CPUPercent <= 0 || p.ReadRate == 0) {
+			t.Errorf("second scrape = %+v, want CPU usage and read rate", p)
+		}
+		if p.PID == 101 && (p.CPUPercent != 0 || p.ReadRate != 0) {
+			t.Errorf("idle process = %+v, want zero rates", p)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/pkg/procf"
+)
+
+/*
+hardwareMetricProtocols – provides kernel network protocol counters.
+
+	TCP, UDP and ICMP counters come from net/snmp read by
+	procf.NetCounters, extended TCP counters from TcpExt of
+	net/netstat, both of the proc filesystem mounted at procRoot.
+	Rates are computed from the counter deltas between two
+	consecutive scrapes.
+*/
+type hardwareMetricProtocols struct {
+	procRoot string
+	delta    deltaTracker[uint64]
+}
+
+func NewHardwareMetricProtocols(procRoot string) *hardwareMetricProtocols {
+	return &hardwareMetricProtocols{
+		procRoot: procRoot,
+	}
+}
+
+// snmpCounter – converts a /proc/net/snmp value, which is signed in procf, to a counter.
+func snmpCounter(v int64) uint64 {
+	return uint64(max(v, 0))
+}
+
+/*
+ScrapeProtocols – collects the protocol counters and their rates.
+
+	A missing /proc/net/netstat (network namespaces of old kernels)
+	leaves the TcpExt counters zero.
+*/
+func (hmp *hardwareMetricProtocols) ScrapeProtocols(ctx context.Context) (domain.NetProtocolMetrics, error) {
+	counters, err := procf.NetCountersAt(hmp.procRoot)
+	if err != nil {
+		return domain.NetProtocolMetrics{}, ErrScrapeProtocols.Wrap(err)
+	}
+	snmp := counters.Snmp
+
+	netstat, err := procf.ReadProcNetstat(hmp.procRoot)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return domain.NetProtocolMetrics{}, ErrScrapeProtocols.Wrap(err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return domain.NetProtocolMetrics{}, ErrScrapeProtocols.Wrap(err)
+	}
+
+	tcpExt := netstat["TcpExt"]
+
+	cur := map[string]uint64{
+		"tcp.active_opens":     snmpCounter(snmp.Tcp.ActiveOpens),
+		"tcp.passive_opens":    snmpCounter(snmp.Tcp.PassiveOpens),
+		"tcp.attempt_fails":    snmpCounter(snmp.Tcp.AttemptFails),
+		"tcp.estab_resets":     snmpCounter(snmp.Tcp.EstabResets),
+		"tcp.out_resets":       snmpCounter(snmp.Tcp.OutRsts),
+		"tcp.in_segs":          snmpCounter(snmp.Tcp.InSegs),
+		"tcp.out_segs":         snmpCounter(snmp.Tcp.OutSegs),
+		"tcp.retransmits":      snmpCounter(snmp.Tcp.RetransSegs),
+		"tcp.in_errors":        snmpCounter(snmp.Tcp.InErrs),
+		"tcp.in_csum_errors":   snmpCounter(snmp.Tcp.InCsumErrors),
+		"tcp.listen_overflows": tcpExt["ListenOverflows"],
+		"tcp.listen_drops":     tcpExt["ListenDrops"],
+		"tcp.timeouts":         tcpExt["TCPTimeouts"],
+		"tcp.syn_retrans":      tcpExt["TCPSynRetrans"],
+		"tcp.fast_retrans":     tcpExt["TCPFastRetrans"],
+		"tcp.lost_retransmit":  tcpExt["TCPLostRetransmit"],
+		"tcp.abort_on_timeout": tcpExt["TCPAbortOnTimeout"],
+		"tcp.backlog_drop":     tcpExt["TCPBacklogDrop"],
+
+		"udp.in_datagrams":  snmpCounter(snmp.Udp.InDatagrams),
+		"udp.out_datagrams": snmpCounter(snmp.Udp.OutDatagrams),
+		"udp.no_ports":      snmpCounter(snmp.Udp.NoPorts),
+		"udp.in_errors":     snmpCounter(snmp.Udp.InErrors),
+		"udp.rcvbuf_errors": snmpCounter(snmp.Udp.RcvbufErrors),
+		"udp.sndbuf_errors": snmpCounter(snmp.Udp.SndbufErrors),
+
+		"icmp.in_msgs":          snmpCounter(snmp.Icmp.InMsgs),
+		"icmp.out_msgs":         snmpCounter(snmp.Icmp.OutMsgs),
+		"icmp.in_errors":        snmpCounter(snmp.Icmp.InErrors),
+		"icmp.out_errors":       snmpCounter(snmp.Icmp.OutErrors),
+		"icmp.in_dest_unreachs": snmpCounter(snmp.Icmp.InDestUnreachs),
+	}
+
+	prev, elapsed, ok := hmp.delta.advance(cur, time.Now())
+
+	counter := func(key string) domain.ProtocolCounter {
+		c := domain.ProtocolCounter{Total: cur[key]}
+		if ok {
+			c.PerSec = counterRateFloat(prev[key], cur[key], elapsed)
+		}
+		return c
+	}
+
+	data := domain.NetProtocolMetrics{
+		TCP: domain.TCPProtocol{
+			CurrEstab:       snmpCounter(snmp.Tcp.CurrEstab),
+			ActiveOpens:     counter("tcp.active_opens"),
+			PassiveOpens:    counter("tcp.passive_opens"),
+			AttemptFails:    counter("tcp.attempt_fails"),
+			EstabResets:     counter("tcp.estab_resets"),
+			OutResets:       counter("tcp.out_resets"),
+			InSegs:          counter("tcp.in_segs"),
+			OutSegs:         counter("tcp.out_segs"),
+			Retransmits:     counter("tcp.retransmits"),
+			InErrors:        counter("tcp.in_errors"),
+			InCsumErrors:    counter("tcp.in_csum_errors"),
+			ListenOverflows: counter("tcp.listen_overflows"),
+			ListenDrops:     counter("tcp.listen_drops"),
+			Timeouts:        counter("tcp.timeouts"),
+			SynRetrans:      counter("tcp.syn_retrans"),
+			FastRetrans:     counter("tcp.fast_retrans"),
+			LostRetransmit:  counter("tcp.lost_retransmit"),
+			AbortOnTimeout:  counter("tcp.abort_on_timeout"),
+			BacklogDrop:     counter("tcp.backlog_drop"),
+		},
+		UDP: domain.UDPProtocol{
+			InDatagrams:  counter("udp.in_datagrams"),
+			OutDatagrams: counter("udp.out_datagrams"),
+			NoPorts:      counter("udp.no_ports"),
+			InErrors:     counter("udp.in_errors"),
+			RcvbufErrors: counter("udp.rcvbuf_errors"),
+			SndbufErrors: counter("udp.sndbuf_errors"),
+		},
+		ICMP: domain.ICMPProtocol{
+			InMsgs:         counter("icmp.in_msgs"),
+			OutMsgs:        counter("icmp.out_msgs"),
+			InErrors:       counter("icmp.in_errors"),
+			OutErrors:      counter("icmp.out_errors"),
+			InDestUnreachs: counter("icmp.in_dest_unreachs"),
+		},
+	}
+
+	if ok {
+		sent := counterDelta(prev["tcp.out_segs"], cur["tcp.out_segs"])
+		retrans := counterDelta(prev["tcp.retransmits"], cur["tcp.retransmits"])
+		data.TCP.RetransmitPercent = min(usedPercent[uint64, float64](retrans, sent), 100)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFakeNetProc – writes net/dev, net/snmp and, unless tcpExt is empty, net/netstat of a fake /proc.
+func writeFakeNetProc(t *testing.T, root string, outSegs, retrans, rcvbufErrors int, tcpExt string) {
+	t.Helper()
+
+	dir := filepath.Join(root, "net")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"dev": testNetDev,
+		"snmp": fmt.Sprintf(
+			"Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens PassiveOpens AttemptFails EstabResets CurrEstab InSegs OutSegs RetransSegs InErrs OutRsts InCsumErrors\n"+
+				"Tcp: 1 200 120000 -1 92 11 3 2 7 2614 %d %d 0 40 0\n"+
+				"Udp: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti MemErrors\n"+
+				"Udp: 388 50 0 440 %d 0 0 12 0\n",
+			outSegs, retrans, rcvbufErrors,
+		),
+	}
+	if tcpExt != "" {
+		files["netstat"] = "TcpExt: ListenOverflows ListenDrops TCPTimeouts\nTcpExt: " + tcpExt + "\n"
+	} else {
+		os.Remove(filepath.Join(dir, "netstat"))
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_hardwareMetricProtocols(t *testing.T) {
+	root := t.TempDir()
+	writeFakeNetProc(t, root, 1000, 10, 5, "")
+
+	hmp := NewHardwareMetricProtocols(root)
+
+	m, err := hmp.ScrapeProtocols(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.TCP.CurrEstab != 7 || m.TCP.Retransmits.Total != 10 || m.TCP.Retransmits.PerSec != 0 || m.TCP.RetransmitPercent != 0 {
+		t.Errorf("first scrape tcp = %+v, want totals without rates", m.TCP)
+	}
+	if m.TCP.ListenDrops.Total != 0 {
+		t.Errorf("listen drops = %+v, want zero without net/netstat", m.TCP.ListenDrops)
+	}
+
+	// 1000 segments sent, 50 of them retransmitted
+	writeFakeNetProc(t, root, 2000, 60, 5, "0 3 8")
+
+	m, err = hmp.ScrapeProtocols(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.TCP.RetransmitPercent != 5 || m.TCP.Retransmits.PerSec <= 0 || m.TCP.EstabResets.PerSec != 0 {
+		t.Errorf("tcp = %+v, want 5%% retransmits", m.TCP)
+	}
+	if m.TCP.ListenDrops.Total != 3 || m.TCP.Timeouts.Total != 8 || m.UDP.RcvbufErrors.Total != 5 {
+		t.Errorf("listen drops = %+v, timeouts = %+v, rcvbuf errors = %+v", m.TCP.ListenDrops, m.TCP.Timeouts, m.UDP.RcvbufErrors)
+	}
+
+	if _, err := NewHardwareMetricProtocols(t.TempDir()).ScrapeProtocols(context.Background()); err == nil {
+		t.Error("scrape of a proc root without net/snmp must fail")
+	}
+}
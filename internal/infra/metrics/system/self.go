@@ -0,0 +1,152 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/eterline/fstmon/pkg/procf"
+	"github.com/prometheus/procfs"
+)
+
+type WorkerLister interface {
+	Workers() []domain.WorkerInfo
+}
+
+/*
+agentMetricSelf – provides the self monitoring metrics of the agent.
+
+	Status is parsed by procf, descriptors and CPU times by procfs,
+	both from the proc filesystem mounted at procRoot, the root fs
+	was opened at.
+*/
+type agentMetricSelf struct {
+	fs       procfs.FS
+	procRoot string
+	workers  WorkerLister
+}
+
+func NewAgentMetricSelf(fs procfs.FS, procRoot string, wl WorkerLister) *agentMetricSelf {
+	return &agentMetricSelf{
+		fs:       fs,
+		procRoot: procRoot,
+		workers:  wl,
+	}
+}
+
+/*
+RegisterSelfCollector – registers the agent self monitoring collector.
+
+	Kept apart from RegisterCollectors, because it reads the worker
+	stats from the pooler the collectors run in. procRoot is the
+	mount point fs is opened at.
+*/
+func RegisterSelfCollector(reg *monitor.Registry, fs procfs.FS, procRoot string, wl WorkerLister) error {
+	return reg.Register(
+		monitor.NewCollector(domain.KeySelf, 10*time.Second, NewAgentMetricSelf(fs, procRoot, wl).ScrapeSelfMetrics,
+			monitor.WithDescription("Agent scrape cost, Go runtime and process usage"),
+		),
+	)
+}
+
+func (ams *agentMetricSelf) ScrapeSelfMetrics(ctx context.Context) (domain.SelfMetrics, error) {
+	proc, err := ams.scrapeProcess()
+	if err != nil {
+		return domain.SelfMetrics{}, ErrScrapeSelfMetrics.Wrap(err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return domain.SelfMetrics{}, ErrScrapeSelfMetrics.Wrap(err)
+	}
+
+	return domain.SelfMetrics{
+		Workers: ams.scrapeWorkers(),
+		Runtime: scrapeRuntime(),
+		Process: proc,
+	}, nil
+}
+
+func (ams *agentMetricSelf) scrapeWorkers() []domain.WorkerSelfStats {
+	workers := ams.workers.Workers()
+	stats := make([]domain.WorkerSelfStats, len(workers))
+
+	for i, w := range workers {
+		stats[i] = domain.WorkerSelfStats{
+			Key:          w.Key,
+			LastDuration: w.Status.LastDuration,
+			AvgDuration:  w.Status.AvgDuration(),
+			MaxDuration:  w.Status.MaxDuration,
+			Successes:    w.Status.Successes,
+			Errors:       w.Status.Errors,
+			Timeouts:     w.Status.Timeouts,
+			Panics:       w.Status.Panics,
+			Skipped:      w.Status.Skipped,
+			Overlaps:     w.Status.Overlaps,
+		}
+	}
+
+	return stats
+}
+
+func scrapeRuntime() domain.RuntimeStats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	rs := domain.RuntimeStats{
+		Goroutines:   runtime.NumGoroutine(),
+		HeapAlloc:    ms.HeapAlloc,
+		HeapInuse:    ms.HeapInuse,
+		HeapSys:      ms.HeapSys,
+		HeapObjects:  ms.HeapObjects,
+		NextGC:       ms.NextGC,
+		NumGC:        ms.NumGC,
+		GCPauseTotal: time.Duration(ms.PauseTotalNs),
+	}
+
+	if ms.NumGC > 0 {
+		rs.GCPauseLast = time.Duration(ms.PauseNs[(ms.NumGC+255)%256])
+	}
+
+	return rs
+}
+
+func (ams *agentMetricSelf) scrapeProcess() (domain.ProcessStats, error) {
+	status, err := procf.FetchSelfStatusAt(ams.procRoot)
+	if err != nil {
+		return domain.ProcessStats{}, err
+	}
+
+	ps := domain.ProcessStats{
+		Pid:             status.Pid,
+		Threads:         status.Threads,
+		RSS:             status.VmRSS,
+		VirtualMemory:   status.VmSize,
+		FDSize:          status.FDSize,
+		VoluntaryCtxt:   status.VoluntaryCtxtSwitches,
+		InvoluntaryCtxt: status.NonvoluntaryCtxtSwitches,
+	}
+
+	self, err := ams.fs.Self()
+	if err != nil {
+		return domain.ProcessStats{}, err
+	}
+
+	if ps.OpenFDs, err = self.FileDescriptorsLen(); err != nil {
+		return domain.ProcessStats{}, err
+	}
+
+	stat, err := self.Stat()
+	if err != nil {
+		return domain.ProcessStats{}, err
+	}
+
+	ps.CPUUser = ticksDuration(uint64(stat.UTime))
+	ps.CPUSystem = ticksDuration(uint64(stat.STime))
+
+	return ps, nil
+}
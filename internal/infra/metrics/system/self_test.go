@@ -0,0 +1,144 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+	metricstore "github.com/eterline/fstmon/internal/infra/metrics/metric_store"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/prometheus/procfs"
+)
+
+func Test_agentMetricSelf(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.WrapLoggerToContext(
+		context.Background(),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	))
+	defer cancel()
+
+	// agent 30 with 100 user and 50 system ticks and 4 open descriptors
+	root := t.TempDir()
+	writeFakeProc(t, root, 30, "fstmon", "", 100, "fstmon")
+	writeFakeProcUsage(t, root, 30, os.Getuid(), 1000, 500, 0, 4)
+	if err := os.Symlink("30", filepath.Join(root, "self")); err != nil {
+		t.Fatal(err)
+	}
+
+	status := "Name:\tfstmon\nPid:\t30\nFDSize:\t64\nVmSize:\t  2048 kB\nVmRSS:\t  1000 kB\n" +
+		"Threads:\t9\nvoluntary_ctxt_switches:\t120\nnonvoluntary_ctxt_switches:\t7\n"
+	if err := os.WriteFile(filepath.Join(root, "30", "status"), []byte(status), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := procfs.NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sp := monitor.NewServicePooler(metricstore.NewMetricInMemoryStore())
+	sp.AddMetricPooling(func(context.Context) (any, error) {
+		return 1, nil
+	}, "cpu", time.Hour)
+	sp.AddMetricPooling(func(context.Context) (any, error) {
+		return nil, errors.New("sensor unreadable")
+	}, "thermal", time.Hour)
+
+	// paused workers skip the first planned scrape, only triggers run
+	for _, key := range []string{"cpu", "thermal"} {
+		if _, err := sp.PauseWorker(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sp.RunPooling(ctx)
+	defer func() {
+		cancel()
+		sp.Wait()
+	}()
+
+	for range 2 {
+		if _, err := sp.TriggerScrape(ctx, "cpu"); err != nil {
+			t.Fatalf("trigger cpu: %v", err)
+		}
+	}
+	if _, err := sp.TriggerScrape(ctx, "thermal"); err == nil {
+		t.Fatal("trigger thermal: want scrape error")
+	}
+
+	sm, err := NewAgentMetricSelf(fs, root, sp).ScrapeSelfMetrics(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := domain.ProcessStats{
+		Pid:             30,
+		Threads:         9,
+		RSS:             1000 * 1024,
+		VirtualMemory:   2048 * 1024,
+		OpenFDs:         4,
+		FDSize:          64,
+		CPUUser:         time.Second,
+		CPUSystem:       500 * time.Millisecond,
+		VoluntaryCtxt:   120,
+		InvoluntaryCtxt: 7,
+	}
+	if sm.Process != want {
+		t.Errorf("process = %+v, want %+v", sm.Process, want)
+	}
+
+	wantWorkers := map[string][2]int{ // successes, errors
+		"cpu":     {2, 0},
+		"thermal": {0, 1},
+	}
+	if len(sm.Workers) != len(wantWorkers) {
+		t.Fatalf("workers = %+v, want %d", sm.Workers, len(wantWorkers))
+	}
+	for _, w := range sm.Workers {
+		if got := [2]int{w.Successes, w.Errors}; got != wantWorkers[w.Key] {
+			t.Errorf("worker %s successes and errors = %v, want %v", w.Key, got, wantWorkers[w.Key])
+		}
+		if w.AvgDuration > w.MaxDuration || w.LastDuration > w.MaxDuration {
+			t.Errorf("worker %s durations = %+v, want at most the max", w.Key, w)
+		}
+	}
+
+	if sm.Runtime.Goroutines == 0 || sm.Runtime.HeapSys == 0 {
+		t.Errorf("runtime = %+v, want read", sm.Runtime)
+	}
+}
+
+func Test_agentMetricSelf_workersOrder(t *testing.T) {
+	workers := fakeWorkerLister{
+		{Key: "cpu", Status: domain.ScrapeStatus{Successes: 3, Errors: 1, TotalDuration: 40 * time.Millisecond}},
+		{Key: "disk_io", Status: domain.ScrapeStatus{Timeouts: 2, Panics: 1, Skipped: 5, Overlaps: 4}},
+	}
+
+	stats := NewAgentMetricSelf(procfs.FS{}, "", workers).scrapeWorkers()
+
+	if len(stats) != 2 || stats[0].Key != "cpu" || stats[1].Key != "disk_io" {
+		t.Fatalf("stats = %+v, want workers in the pooler order", stats)
+	}
+	if stats[0].AvgDuration != 10*time.Millisecond {
+		t.Errorf("avg duration = %v, want total over successes and errors", stats[0].AvgDuration)
+	}
+	if s := stats[1]; s.Timeouts != 2 || s.Panics != 1 || s.Skipped != 5 || s.Overlaps != 4 {
+		t.Errorf("disk_io = %+v, want counters copied", s)
+	}
+}
+
+type fakeWorkerLister []domain.WorkerInfo
+
+func (fwl fakeWorkerLister) Workers() []domain.WorkerInfo {
+	return fwl
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+const (
+	tcpListen      = 0x0a
+	unixFlagListen = 1 << 16 // __SO_ACCEPTCON
+)
+
+// tcpStates – names of the kernel TCP states from include/net/tcp_states.h.
+var tcpStates = map[uint64]string{
+	0x01: "ESTABLISHED",
+	0x02: "SYN_SENT",
+	0x03: "SYN_RECV",
+	0x04: "FIN_WAIT1",
+	0x05: "FIN_WAIT2",
+	0x06: "TIME_WAIT",
+	0x07: "CLOSE",
+	0x08: "CLOSE_WAIT",
+	0x09: "LAST_ACK",
+	0x0a: "LISTEN",
+	0x0b: "CLOSING",
+	0x0c: "NEW_SYN_RECV",
+}
+
+func tcpStateName(st uint64) string {
+	if name, ok := tcpStates[st]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// socketOwner – process holding a socket file descriptor.
+type socketOwner struct {
+	pid  int
+	comm string
+}
+
+/*
+hardwareMetricSockets – provides socket metrics of the host.
+
+	Sockets are read from /proc/net/tcp, tcp6, udp, udp6 and unix.
+	Owners of listening sockets are found by matching the socket
+	inodes with the /proc/[pid]/fd links, so without privileges only
+	the sockets of the agent user have an owner.
+*/
+type hardwareMetricSockets struct {
+	fs procfs.FS
+}
+
+/*
+NewHardwareMetricSockets – creates a new hardwareMetricSockets instance.
+*/
+func NewHardwareMetricSockets(fs procfs.FS) *hardwareMetricSockets {
+	return &hardwareMetricSockets{
+		fs: fs,
+	}
+}
+
+/*
+ScrapeSockets – collects socket counts, listening sockets and remotes.
+
+	A missing tcp6 or udp6 table (IPv6 disabled) is treated as empty.
+	UDP sockets without a remote address are reported as listening.
+*/
+func (hms *hardwareMetricSockets) ScrapeSockets(ctx context.Context) (domain.SocketMetrics, error) {
+	tables := [...]struct {
+		proto string
+		read  func() ([]socketLine, error)
+	}{
+		{"tcp", func() ([]socketLine, error) { return tcpSocketLines(hms.fs.NetTCP()) }},
+		{"tcp6", func() ([]socketLine, error) { return tcpSocketLines(hms.fs.NetTCP6()) }},
+		{"udp", func() ([]socketLine, error) { return udpSocketLines(hms.fs.NetUDP()) }},
+		{"udp6", func() ([]socketLine, error) { return udpSocketLines(hms.fs.NetUDP6()) }},
+	}
+
+	data := domain.SocketMetrics{
+		TCPStates: make(map[string]int),
+	}
+	remotes := make(map[string]int)
+
+	for _, table := range tables {
+		lines, err := table.read()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) && strings.HasSuffix(table.proto, "6") {
+				continue
+			}
+			return domain.SocketMetrics{}, ErrScrapeSockets.Wrap(err)
+		}
+
+		tcp := strings.HasPrefix(table.proto, "tcp")
+
+		for _, line := range lines {
+			if tcp {
+				data.TCP++
+				data.TCPStates[tcpStateName(line.state)]++
+			} else {
+				data.UDP++
+			}
+
+			listening := tcp && line.state == tcpListen || !tcp && line.remotePort == 0
+			if listening {
+				data.Listening = append(data.Listening, domain.ListeningSocket{
+					Protocol: table.proto,
+					Address:  line.local.String(),
+					Port:     line.localPort,
+					UID:      line.uid,
+					Inode:    line.inode,
+				})
+				continue
+			}
+
+			if tcp {
+				remotes[line.remote.String()]++
+			}
+		}
+	}
+
+	unix, err := hms.fs.NetUNIX()
+	if err != nil {
+		return domain.SocketMetrics{}, ErrScrapeSockets.Wrap(err)
+	}
+
+	data.UNIX = len(unix.Rows)
+	for _, row := range unix.Rows {
+		if row.Flags&unixFlagListen != 0 {
+			data.UNIXListen++
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return domain.SocketMetrics{}, ErrScrapeSockets.Wrap(err)
+	}
+
+	owners := hms.socketOwners(ctx, data.Listening)
+	for i := range data.Listening {
+		if o, ok := owners[data.Listening[i].Inode]; ok {
+			data.Listening[i].PID, data.Listening[i].Process = o.pid, o.comm
+		}
+	}
+
+	slices.SortFunc(data.Listening, func(a, b domain.ListeningSocket) int {
+		return cmp.Or(
+			cmp.Compare(a.Port, b.Port),
+			cmp.Compare(a.Protocol, b.Protocol),
+			cmp.Compare(a.Address, b.Address),
+		)
+	})
+
+	data.Remotes = make([]domain.RemoteConnections, 0, len(remotes))
+	for addr, n := range remotes {
+		data.Remotes = append(data.Remotes, domain.RemoteConnections{Address: addr, Connections: n})
+	}
+	slices.SortFunc(data.Remotes, func(a, b domain.RemoteConnections) int {
+		return cmp.Or(
+			cmp.Compare(b.Connections, a.Connections),
+			cmp.Compare(a.Address, b.Address),
+		)
+	})
+
+	return data, nil
+}
+
+// socketLine – fields of a /proc/net/tcp or udp table line used by the collector.
+type socketLine struct {
+	local      net.IP
+	localPort  uint64
+	remote     net.IP
+	remotePort uint64
+	state      uint64
+	uid        uint64
+	inode      uint64
+}
+
+func tcpSocketLines(lines procfs.NetTCP, err error) ([]socketLine, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]socketLine, len(lines))
+	for i, l := range lines {
+		out[i] = socketLine{l.LocalAddr, l.LocalPort, l.RemAddr, l.RemPort, l.St, l.UID, l.Inode}
+	}
+	return out, nil
+}
+
+func udpSocketLines(lines procfs.NetUDP, err error) ([]socketLine, error) {
+	// both tables share the line type, only the slice types differ
+	return tcpSocketLines(procfs.NetTCP(lines), err)
+}
+
+/*
+socketOwners – maps inodes of the listening sockets to their processes.
+
+	Processes exiting while being read and fd directories that are
+	not readable are skipped. A socket shared by several processes
+	(e.g. pre-forked workers) is owned by the one with the lowest PID.
+*/
+func (hms *hardwareMetricSockets) socketOwners(ctx context.Context, listening []domain.ListeningSocket) map[uint64]socketOwner {
+	owners := make(map[uint64]socketOwner, len(listening))
+	if len(listening) == 0 {
+		return owners
+	}
+
+	wanted := make(map[uint64]struct{}, len(listening))
+	for _, l := range listening {
+		wanted[l.Inode] = struct{}{}
+	}
+
+	procs, err := hms.fs.AllProcs()
+	if err != nil {
+		return owners
+	}
+
+	// the first match is the lowest PID
+	sort.Sort(procs)
+
+	for _, p := range procs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		targets, err := p.FileDescriptorTargets()
+		if err != nil {
+			continue
+		}
+
+		for _, target := range targets {
+			inode, ok := socketInode(target)
+			if !ok {
+				continue
+			}
+			if _, ok := wanted[inode]; !ok {
+				continue
+			}
+			if _, ok := owners[inode]; ok {
+				continue
+			}
+
+			comm, _ := p.Comm()
+			owners[inode] = socketOwner{pid: p.PID, comm: comm}
+		}
+	}
+
+	return owners
+}
+
+// socketInode – parses the inode of a "socket:[12345]" fd link target.
+func socketInode(target string) (uint64, bool) {
+	s, ok := strings.CutPrefix(target, "socket:[")
+	if !ok {
+		return 0, false
+	}
+	s, ok = strings.CutSuffix(s, "]")
+	if !ok {
+		return 0, false
+	}
+
+	inode, err := strconv.ParseUint(s, 10, 64)
+	return inode, err == nil
+}
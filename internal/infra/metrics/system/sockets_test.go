@@ -0,0 +1,97 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/prometheus/procfs"
+)
+
+const (
+	testNetTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:0016 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1001 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000    33        0 1002 1 0000000000000000 100 0 0 10 0
+   2: 0A00000A:0016 0200000A:D431 01 00000000:00000000 02:000AFC34 00000000     0        0 1003 2 0000000000000000 20 4 30 10 -1
+   3: 0A00000A:0016 0200000A:D432 01 00000000:00000000 02:000AFC34 00000000     0        0 1004 2 0000000000000000 20 4 30 10 -1
+   4: 0A00000A:9C40 0300000A:01BB 06 00000000:00000000 03:00001770 00000000     0        0 0 3 0000000000000000
+`
+	testNetUDP = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  100: 00000000:0044 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 1005 2 0000000000000000 0
+  101: 0A00000A:A1B2 0800000A:0035 01 00000000:00000000 00:00000000 00000000     0        0 1006 2 0000000000000000 0
+`
+	testNetUNIX = `Num       RefCount Protocol Flags    Type St Inode Path
+0000000000000000: 00000002 00000000 00010000 0001 01 2001 /run/systemd/notify
+0000000000000000: 00000003 00000000 00000000 0001 03 2002
+`
+)
+
+func Test_hardwareMetricSockets(t *testing.T) {
+	proc := t.TempDir()
+	fd := filepath.Join(proc, "812", "fd")
+	for _, dir := range []string{filepath.Join(proc, "net"), fd} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// IPv6 disabled: no tcp6 and udp6
+	files := map[string]string{
+		"net/tcp":  testNetTCP,
+		"net/udp":  testNetUDP,
+		"net/unix": testNetUNIX,
+		"812/comm": "sshd\n",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(proc, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// sshd owns the ssh listener, the web server socket has no readable fd
+	for name, target := range map[string]string{"0": "/dev/null", "3": "socket:[1001]", "4": "socket:[1003]"} {
+		if err := os.Symlink(target, filepath.Join(fd, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := procfs.NewFS(proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewHardwareMetricSockets(fs).ScrapeSockets(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.TCP != 5 || m.UDP != 2 || m.UNIX != 2 || m.UNIXListen != 1 {
+		t.Errorf("counts = %d tcp, %d udp, %d unix, %d unix listen", m.TCP, m.UDP, m.UNIX, m.UNIXListen)
+	}
+	if m.TCPStates["LISTEN"] != 2 || m.TCPStates["ESTABLISHED"] != 2 || m.TCPStates["TIME_WAIT"] != 1 {
+		t.Errorf("tcp states = %v", m.TCPStates)
+	}
+
+	want := []domain.ListeningSocket{
+		{Protocol: "tcp", Address: "0.0.0.0", Port: 22, Inode: 1001, PID: 812, Process: "sshd"},
+		{Protocol: "udp", Address: "0.0.0.0", Port: 68, Inode: 1005},
+		{Protocol: "tcp", Address: "127.0.0.1", Port: 8080, UID: 33, Inode: 1002},
+	}
+	if len(m.Listening) != len(want) {
+		t.Fatalf("listening = %+v", m.Listening)
+	}
+	for i := range want {
+		if m.Listening[i] != want[i] {
+			t.Errorf("listening[%d] = %+v, want %+v", i, m.Listening[i], want[i])
+		}
+	}
+
+	if len(m.Remotes) != 2 || m.Remotes[0] != (domain.RemoteConnections{Address: "10.0.0.2", Connections: 2}) || m.Remotes[1].Address != "10.0.0.3" {
+		t.Errorf("remotes = %+v", m.Remotes)
+	}
+}
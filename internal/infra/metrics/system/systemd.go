@@ -0,0 +1,261 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	systemdDest    = "org.freedesktop.systemd1"
+	systemdPath    = dbus.ObjectPath("/org/freedesktop/systemd1")
+	systemdManager = "org.freedesktop.systemd1.Manager"
+	systemdUnit    = "org.freedesktop.systemd1.Unit"
+	systemdService = "org.freedesktop.systemd1.Service"
+	systemdTimer   = "org.freedesktop.systemd1.Timer"
+
+	systemdNoSuchUnit = "org.freedesktop.systemd1.NoSuchUnit"
+	dbusGetAll        = "org.freedesktop.DBus.Properties.GetAll"
+)
+
+// systemdUnitStatus – element of the Manager.ListUnits reply, a(ssssssouso).
+type systemdUnitStatus struct {
+	Name        string
+	Description string
+	LoadState   string
+	ActiveState string
+	SubState    string
+	Following   string
+	Path        dbus.ObjectPath
+	JobID       uint32
+	JobType     string
+	JobPath     dbus.ObjectPath
+}
+
+/*
+hardwareMetricSystemd – provides systemd unit states over D-Bus.
+
+	One connection to the bus is kept between scrapes and dialed
+	again after it breaks. Unit counts come from a single ListUnits
+	call, the watched units are read by their properties. CPU usage
+	is computed from the CPUUsageNSec deltas between two scrapes.
+*/
+type hardwareMetricSystemd struct {
+	address string
+	units   []string
+	cpu     deltaTracker[uint64]
+
+	mu   sync.Mutex
+	conn *dbus.Conn
+}
+
+/*
+NewHardwareMetricSystemd – creates a new hardwareMetricSystemd instance.
+
+	An empty address is the system bus.
+*/
+func NewHardwareMetricSystemd(address string, units []string) *hardwareMetricSystemd {
+	return &hardwareMetricSystemd{
+		address: address,
+		units:   units,
+	}
+}
+
+/*
+RegisterSystemdCollector – registers the systemd units collector.
+
+	Kept apart from RegisterCollectors, because hosts without systemd
+	would only report a failing collector.
+*/
+func RegisterSystemdCollector(reg *monitor.Registry, address string, units []string) error {
+	return reg.Register(
+		monitor.NewCollector(domain.KeySystemd, 30*time.Second, NewHardwareMetricSystemd(address, units).ScrapeSystemd,
+			monitor.WithDescription("Systemd unit counts, failed units and watched unit states"),
+		),
+	)
+}
+
+// connection – returns the bus connection, dialing it when there is none or it broke.
+func (hms *hardwareMetricSystemd) connection() (*dbus.Conn, error) {
+	hms.mu.Lock()
+	defer hms.mu.Unlock()
+
+	if hms.conn != nil && hms.conn.Connected() {
+		return hms.conn, nil
+	}
+
+	var (
+		conn *dbus.Conn
+		err  error
+	)
+	if hms.address == "" {
+		conn, err = dbus.ConnectSystemBus()
+	} else {
+		conn, err = dbus.Connect(hms.address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hms.conn = conn
+	return conn, nil
+}
+
+func (hms *hardwareMetricSystemd) ScrapeSystemd(ctx context.Context) (domain.SystemdMetrics, error) {
+	conn, err := hms.connection()
+	if err != nil {
+		return domain.SystemdMetrics{}, ErrScrapeSystemd.Wrap(err)
+	}
+
+	var list []systemdUnitStatus
+	manager := conn.Object(systemdDest, systemdPath)
+	if err := manager.CallWithContext(ctx, systemdManager+".ListUnits", 0).Store(&list); err != nil {
+		return domain.SystemdMetrics{}, ErrScrapeSystemd.Wrap(err)
+	}
+
+	data := domain.SystemdMetrics{
+		Total: len(list),
+		Units: make([]domain.SystemdUnit, 0, len(hms.units)),
+	}
+
+	for _, u := range list {
+		switch u.ActiveState {
+		case "active":
+			data.Active++
+		case "inactive":
+			data.Inactive++
+		case "failed":
+			data.Failed++
+			data.FailedUnits = append(data.FailedUnits, u.Name)
+		default:
+			data.Changing++
+		}
+	}
+	slices.Sort(data.FailedUnits)
+
+	usage := make(map[string]uint64, len(hms.units))
+
+	for _, name := range hms.units {
+		unit, err := hms.scrapeUnit(ctx, conn, name)
+		if err != nil {
+			return domain.SystemdMetrics{}, ErrScrapeSystemd.Wrap(err)
+		}
+
+		usage[name] = uint64(unit.CPUUsage)
+		data.Units = append(data.Units, unit)
+	}
+
+	prev, elapsed, _ := hms.cpu.advance(usage, time.Now())
+	for i := range data.Units {
+		if p, ok := prev[data.Units[i].Name]; ok && elapsed > 0 {
+			cur := uint64(data.Units[i].CPUUsage)
+			if cur >= p {
+				data.Units[i].CPUPercent = float64(cur-p) / float64(elapsed) * 100
+			}
+		}
+	}
+
+	return data, nil
+}
+
+/*
+scrapeUnit – reads the properties of the named unit.
+
+	A unit systemd has not loaded is reported with the "not-found"
+	load state instead of an error.
+*/
+func (hms *hardwareMetricSystemd) scrapeUnit(ctx context.Context, conn *dbus.Conn, name string) (domain.SystemdUnit, error) {
+	unit := domain.SystemdUnit{Name: name}
+
+	var path dbus.ObjectPath
+	err := conn.Object(systemdDest, systemdPath).CallWithContext(ctx, systemdManager+".GetUnit", 0, name).Store(&path)
+	if err != nil {
+		var derr dbus.Error
+		if errors.As(err, &derr) && derr.Name == systemdNoSuchUnit {
+			unit.LoadState, unit.ActiveState, unit.SubState = "not-found", "inactive", "dead"
+			return unit, nil
+		}
+		return unit, err
+	}
+
+	obj := conn.Object(systemdDest, path)
+
+	props, err := unitProperties(ctx, obj, systemdUnit)
+	if err != nil {
+		return unit, err
+	}
+	unit.Description = propString(props, "Description")
+	unit.LoadState = propString(props, "LoadState")
+	unit.ActiveState = propString(props, "ActiveState")
+	unit.SubState = propString(props, "SubState")
+	unit.ActiveSince = propTime(props, "ActiveEnterTimestamp")
+
+	switch {
+	case strings.HasSuffix(name, ".service"):
+		props, err := unitProperties(ctx, obj, systemdService)
+		if err != nil {
+			return unit, err
+		}
+		unit.Result = propString(props, "Result")
+		unit.MainPID = propValue[uint32](props, "MainPID")
+		unit.Restarts = propValue[uint32](props, "NRestarts")
+		unit.Memory = propAccounting(props, "MemoryCurrent")
+		unit.CPUUsage = time.Duration(propAccounting(props, "CPUUsageNSec"))
+
+	case strings.HasSuffix(name, ".timer"):
+		props, err := unitProperties(ctx, obj, systemdTimer)
+		if err != nil {
+			return unit, err
+		}
+		unit.Result = propString(props, "Result")
+		unit.LastTrigger = propTime(props, "LastTriggerUSec")
+		unit.NextElapse = propTime(props, "NextElapseUSecRealtime")
+	}
+
+	return unit, nil
+}
+
+func unitProperties(ctx context.Context, obj dbus.BusObject, iface string) (map[string]dbus.Variant, error) {
+	var props map[string]dbus.Variant
+	err := obj.CallWithContext(ctx, dbusGetAll, 0, iface).Store(&props)
+	return props, err
+}
+
+// propValue – returns the property of type T, zero when it is missing or has another type.
+func propValue[T any](props map[string]dbus.Variant, name string) T {
+	v, _ := props[name].Value().(T)
+	return v
+}
+
+func propString(props map[string]dbus.Variant, name string) string {
+	return propValue[string](props, name)
+}
+
+// propTime – converts a microseconds since epoch property, zero is never.
+func propTime(props map[string]dbus.Variant, name string) time.Time {
+	usec := propValue[uint64](props, name)
+	if usec == 0 || usec == math.MaxUint64 {
+		return time.Time{}
+	}
+	return time.UnixMicro(int64(usec))
+}
+
+// propAccounting – returns an accounting counter, systemd reports disabled accounting as MaxUint64.
+func propAccounting(props map[string]dbus.Variant, name string) uint64 {
+	v := propValue[uint64](props, name)
+	if v == math.MaxUint64 {
+		return 0
+	}
+	return v
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"net"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+/*
+fakeSystemdBus – D-Bus peer serving a fixed systemd unit set.
+
+	Speaks just enough of the protocol for the collector: EXTERNAL
+	authentication, Hello, Manager.ListUnits, Manager.GetUnit and
+	Properties.GetAll on unit objects.
+*/
+type fakeSystemdBus struct {
+	t     *testing.T
+	units []systemdUnitStatus
+	props map[dbus.ObjectPath]map[string]map[string]dbus.Variant // path -> interface -> properties
+}
+
+// listen – serves the bus on a unix socket and returns its D-Bus address.
+func (fb *fakeSystemdBus) listen() string {
+	sock := filepath.Join(fb.t.TempDir(), "bus")
+
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		fb.t.Fatal(err)
+	}
+	fb.t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go fb.serve(c)
+		}
+	}()
+
+	return "unix:path=" + sock
+}
+
+func (fb *fakeSystemdBus) serve(c net.Conn) {
+	defer c.Close()
+	rd := bufio.NewReader(c)
+
+	if _, err := rd.ReadByte(); err != nil { // credentials byte
+		return
+	}
+
+	for {
+		line, err := rd.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch cmd := strings.TrimSpace(line); {
+		case cmd == "AUTH":
+			c.Write([]byte("REJECTED EXTERNAL\r\n"))
+		case strings.HasPrefix(cmd, "AUTH EXTERNAL"):
+			c.Write([]byte("OK 00112233445566778899aabbccddeeff\r\n"))
+		case cmd == "NEGOTIATE_UNIX_FD":
+			c.Write([]byte("AGREE_UNIX_FD\r\n"))
+		case cmd == "BEGIN":
+			fb.serveMessages(c, rd)
+			return
+		default:
+			c.Write([]byte("ERROR\r\n"))
+		}
+	}
+}
+
+func (fb *fakeSystemdBus) serveMessages(c net.Conn, rd *bufio.Reader) {
+	for {
+		msg, err := dbus.DecodeMessage(rd)
+		if err != nil {
+			return
+		}
+		if msg.Type != dbus.TypeMethodCall {
+			continue
+		}
+
+		iface, _ := msg.Headers[dbus.FieldInterface].Value().(string)
+		member, _ := msg.Headers[dbus.FieldMember].Value().(string)
+		path, _ := msg.Headers[dbus.FieldPath].Value().(dbus.ObjectPath)
+
+		body, errName := fb.call(path, iface+"."+member, msg.Body)
+
+		reply := &dbus.Message{
+			Type: dbus.TypeMethodReply,
+			Headers: map[dbus.HeaderField]dbus.Variant{
+				dbus.FieldReplySerial: dbus.MakeVariant(msg.Serial()),
+			},
+			Body: body,
+		}
+		if errName != "" {
+			reply.Type = dbus.TypeError
+			reply.Headers[dbus.FieldErrorName] = dbus.MakeVariant(errName)
+		}
+		if len(body) > 0 {
+			reply.Headers[dbus.FieldSignature] = dbus.MakeVariant(dbus.SignatureOf(body...))
+		}
+
+		if err := reply.EncodeTo(c, binary.LittleEndian); err != nil {
+			fb.t.Error(err)
+			return
+		}
+	}
+}
+
+// call – returns the reply body of the method, or the D-Bus error name.
+func (fb *fakeSystemdBus) call(path dbus.ObjectPath, method string, args []any) ([]any, string) {
+	switch method {
+	case "org.freedesktop.DBus.Hello":
+		return []any{":1.42"}, ""
+
+	case systemdManager + ".ListUnits":
+		units := slices.Clone(fb.units)
+		for i := range units {
+			units[i].JobPath = "/" // no pending job
+		}
+		return []any{units}, ""
+
+	case systemdManager + ".GetUnit":
+		for _, u := range fb.units {
+			if u.Name == args[0] {
+				return []any{u.Path}, ""
+			}
+		}
+		return []any{"Unit " + args[0].(string) + " not loaded."}, systemdNoSuchUnit
+
+	case dbusGetAll:
+		if props, ok := fb.props[path][args[0].(string)]; ok {
+			return []any{props}, ""
+		}
+		return []any{map[string]dbus.Variant{}}, ""
+	}
+
+	return []any{"unknown method " + method}, "org.freedesktop.DBus.Error.UnknownMethod"
+}
+
+func Test_hardwareMetricSystemd(t *testing.T) {
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	fb := &fakeSystemdBus{
+		t: t,
+		units: []systemdUnitStatus{
+			{Name: "nginx.service", ActiveState: "active", SubState: "running", LoadState: "loaded", Path: "/org/freedesktop/systemd1/unit/nginx_2eservice"},
+			{Name: "backup.timer", ActiveState: "active", SubState: "waiting", LoadState: "loaded", Path: "/org/freedesktop/systemd1/unit/backup_2etimer"},
+			{Name: "postgresql.service", ActiveState: "failed", SubState: "failed", LoadState: "loaded", Path: "/org/freedesktop/systemd1/unit/postgresql_2eservice"},
+			{Name: "cron.service", ActiveState: "inactive", SubState: "dead", LoadState: "loaded", Path: "/org/freedesktop/systemd1/unit/cron_2eservice"},
+			{Name: "jellyfin.service", ActiveState: "activating", SubState: "start", LoadState: "loaded", Path: "/org/freedesktop/systemd1/unit/jellyfin_2eservice"},
+		},
+		props: map[dbus.ObjectPath]map[string]map[string]dbus.Variant{
+			"/org/freedesktop/systemd1/unit/nginx_2eservice": {
+				systemdUnit: {
+					"Description":          dbus.MakeVariant("nginx web server"),
+					"LoadState":            dbus.MakeVariant("loaded"),
+					"ActiveState":          dbus.MakeVariant("active"),
+					"SubState":             dbus.MakeVariant("running"),
+					"ActiveEnterTimestamp": dbus.MakeVariant(uint64(since.UnixMicro())),
+				},
+				systemdService: {
+					"Result":        dbus.MakeVariant("success"),
+					"MainPID":       dbus.MakeVariant(uint32(812)),
+					"NRestarts":     dbus.MakeVariant(uint32(3)),
+					"MemoryCurrent": dbus.MakeVariant(uint64(64 << 20)),
+					"CPUUsageNSec":  dbus.MakeVariant(uint64(1500 * time.Millisecond)),
+				},
+			},
+			"/org/freedesktop/systemd1/unit/backup_2etimer": {
+				systemdUnit: {
+					"ActiveState": dbus.MakeVariant("active"),
+					"SubState":    dbus.MakeVariant("waiting"),
+				},
+				systemdTimer: {
+					"Result":                 dbus.MakeVariant("success"),
+					"LastTriggerUSec":        dbus.MakeVariant(uint64(since.UnixMicro())),
+					"NextElapseUSecRealtime": dbus.MakeVariant(uint64(since.Add(24 * time.Hour).UnixMicro())),
+				},
+			},
+			"/org/freedesktop/systemd1/unit/postgresql_2eservice": {
+				systemdUnit: {
+					"ActiveState": dbus.MakeVariant("failed"),
+					"SubState":    dbus.MakeVariant("failed"),
+				},
+				systemdService: {
+					"Result":        dbus.MakeVariant("exit-code"),
+					"MemoryCurrent": dbus.MakeVariant(uint64(1<<64 - 1)), // accounting disabled
+				},
+			},
+		},
+	}
+
+	hms := NewHardwareMetricSystemd(fb.listen(), []string{"nginx.service", "backup.timer", "postgresql.service", "absent.service"})
+	t.Cleanup(func() {
+		if hms.conn != nil {
+			hms.conn.Close()
+		}
+	})
+
+	m, err := hms.ScrapeSystemd(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Total != 5 || m.Active != 2 || m.Inactive != 1 || m.Failed != 1 || m.Changing != 1 {
+		t.Errorf("counts = %+v", m)
+	}
+	if !slices.Equal(m.FailedUnits, []string{"postgresql.service"}) {
+		t.Errorf("failed units = %v", m.FailedUnits)
+	}
+	if len(m.Units) != 4 {
+		t.Fatalf("got %d units, want 4", len(m.Units))
+	}
+
+	nginx := m.Units[0]
+	if nginx.SubState != "running" || nginx.Restarts != 3 || nginx.MainPID != 812 ||
+		nginx.Memory != 64<<20 || nginx.CPUUsage != 1500*time.Millisecond || !nginx.ActiveSince.Equal(since) {
+		t.Errorf("nginx = %+v", nginx)
+	}
+
+	timer := m.Units[1]
+	if !timer.LastTrigger.Equal(since) || !timer.NextElapse.Equal(since.Add(24*time.Hour)) || timer.Result != "success" {
+		t.Errorf("timer = %+v", timer)
+	}
+
+	if pg := m.Units[2]; pg.ActiveState != "failed" || pg.Result != "exit-code" || pg.Memory != 0 {
+		t.Errorf("postgresql = %+v", pg)
+	}
+
+	if absent := m.Units[3]; absent.LoadState != "not-found" || absent.ActiveState != "inactive" {
+		t.Errorf("absent = %+v", absent)
+	}
+
+	// the connection is kept and reused
+	conn := hms.conn
+	if _, err := hms.ScrapeSystemd(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if hms.conn != conn {
+		t.Error("connection must be reused between scrapes")
+	}
+}
@@ -10,10 +10,14 @@ import (
 	sensorPs "github.com/shirou/gopsutil/v4/sensors"
 )
 
-type hardwareThermalMetrics struct{}
+type hardwareThermalMetrics struct {
+	filters *Filters
+}
 
-func NewHardwareThermalMetrics() *hardwareThermalMetrics {
-	return &hardwareThermalMetrics{}
+func NewHardwareThermalMetrics(filters *Filters) *hardwareThermalMetrics {
+	return &hardwareThermalMetrics{
+		filters: filters,
+	}
 }
 
 func (hms *hardwareThermalMetrics) ScrapeThermalMetrics(ctx context.Context) (domain.ThermalMetricsMap, error) {
@@ -26,6 +30,9 @@ func (hms *hardwareThermalMetrics) ScrapeThermalMetrics(ctx context.Context) (do
 	data := make(domain.ThermalMetricsMap, len(stat))
 
 	for _, s := range stat {
+		if !hms.filters.Allow(FilterThermalSensor, s.SensorKey) {
+			continue
+		}
 		data[s.SensorKey] = domain.ThermalMetrics{
 			Current: s.Temperature,
 			Max:     s.High,
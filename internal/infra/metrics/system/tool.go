@@ -3,6 +3,12 @@
 // Licensed under the MIT License. See the LICENSE file for details.
 package system
 
+import (
+	"time"
+
+	"github.com/prometheus/procfs"
+)
+
 type numerable interface {
 	~float32 | ~float64 |
 		~uint | ~uint32 | ~uint64 |
@@ -23,3 +29,11 @@ func usedPercent[T, N numerable](frac, full T) N {
 	}
 	return 0
 }
+
+// clockTick – duration of a clock tick of /proc/[pid]/stat, taken from procfs to share its USER_HZ.
+var clockTick = time.Duration(procfs.ProcStat{UTime: 1}.CPUTime() * float64(time.Second))
+
+// ticksDuration – converts clock ticks of /proc/[pid]/stat to a duration.
+func ticksDuration(ticks uint64) time.Duration {
+	return time.Duration(ticks) * clockTick
+}
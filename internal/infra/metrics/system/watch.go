@@ -0,0 +1,359 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/prometheus/procfs"
+)
+
+// watchKind – process attribute a watch entry is matched by.
+type watchKind string
+
+const (
+	watchName    watchKind = "name"    // regex of the command name (comm, 15 chars at most)
+	watchExe     watchKind = "exe"     // exact executable path
+	watchCmdline watchKind = "cmdline" // regex of the full command line
+	watchPidfile watchKind = "pidfile" // process whose PID is stored in the file
+)
+
+/*
+WatchEntry – watched service with its process matcher.
+
+	Matchers are written as KIND:VALUE:
+	  name:REGEX     – command name as in /proc/[pid]/comm
+	  exe:PATH       – executable path as in /proc/[pid]/exe
+	  cmdline:REGEX  – command line joined by spaces
+	  pidfile:PATH   – PID read from the file
+*/
+type WatchEntry struct {
+	Name string
+
+	kind  watchKind
+	value string
+	re    *regexp.Regexp
+}
+
+// ParseWatchEntry – parses the KIND:VALUE matcher of the named service.
+func ParseWatchEntry(name, match string) (WatchEntry, error) {
+	kind, value, ok := strings.Cut(match, ":")
+	if !ok || value == "" {
+		return WatchEntry{}, fmt.Errorf("watch %q: matcher %q is not KIND:VALUE", name, match)
+	}
+
+	we := WatchEntry{
+		Name:  name,
+		kind:  watchKind(kind),
+		value: value,
+	}
+
+	switch we.kind {
+	case watchName, watchCmdline:
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return WatchEntry{}, fmt.Errorf("watch %q: %w", name, err)
+		}
+		we.re = re
+	case watchExe, watchPidfile:
+	default:
+		return WatchEntry{}, fmt.Errorf("watch %q: unknown matcher kind %q, expected name, exe, cmdline or pidfile", name, kind)
+	}
+
+	return we, nil
+}
+
+// Match – returns the matcher as written in the configuration.
+func (we WatchEntry) Match() string {
+	return string(we.kind) + ":" + we.value
+}
+
+// watchProc – lazily read attributes of a process under match.
+type watchProc struct {
+	proc procfs.Proc
+	stat procfs.ProcStat
+
+	exe     *string
+	cmdline *string
+}
+
+func (wp *watchProc) executable() string {
+	if wp.exe == nil {
+		exe, _ := wp.proc.Executable() // unreadable for other users without privileges
+		exe = strings.TrimSuffix(exe, " (deleted)")
+		wp.exe = &exe
+	}
+	return *wp.exe
+}
+
+func (wp *watchProc) commandLine() string {
+	if wp.cmdline == nil {
+		cmd, _ := wp.proc.CmdLine()
+		line := strings.Join(cmd, " ")
+		wp.cmdline = &line
+	}
+	return *wp.cmdline
+}
+
+func (we WatchEntry) matches(wp *watchProc) bool {
+	switch we.kind {
+	case watchName:
+		return we.re.MatchString(wp.stat.Comm)
+	case watchExe:
+		return wp.executable() == we.value
+	case watchCmdline:
+		return we.re.MatchString(wp.commandLine())
+	default:
+		return false
+	}
+}
+
+// pidfilePID – reads the PID stored in the pidfile, ok is false for a missing or invalid file.
+func (we WatchEntry) pidfilePID() (int, bool) {
+	data, err := os.ReadFile(we.value)
+	if err != nil {
+		return 0, false
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	return pid, err == nil && pid > 0
+}
+
+// watchInstance – process matched by a watch entry.
+type watchInstance struct {
+	pid   int
+	key   string // PID and start time, stable for the process lifetime
+	start uint64 // start time in clock ticks after boot
+	rss   uint64
+	ticks uint64 // user and system time in clock ticks
+}
+
+func newWatchInstance(stat procfs.ProcStat) watchInstance {
+	return watchInstance{
+		pid:   stat.PID,
+		key:   strconv.Itoa(stat.PID) + "/" + strconv.FormatUint(stat.Starttime, 10),
+		start: stat.Starttime,
+		rss:   uint64(max(stat.ResidentMemory(), 0)),
+		ticks: uint64(stat.UTime) + uint64(stat.STime),
+	}
+}
+
+/*
+watchState – restart tracking of a watched service.
+
+	keys are the instance keys seen by the last scrape, oldest first,
+	so keys[0] is the main instance. Empty while the service is down.
+*/
+type watchState struct {
+	keys     []string
+	seen     bool
+	restarts int
+}
+
+/*
+observe – records the instance keys of the scrape, oldest first.
+
+	A restart is counted when the service comes back after being
+	down, or when the main instance is gone and a new instance has
+	replaced it. The main instance exiting while the other instances
+	keep running is not a restart.
+*/
+func (ws *watchState) observe(keys []string) {
+	if len(keys) > 0 && ws.seen {
+		switch {
+		case len(ws.keys) == 0:
+			ws.restarts++
+		case !slices.Contains(keys, ws.keys[0]) && slices.ContainsFunc(keys, func(k string) bool {
+			return !slices.Contains(ws.keys, k)
+		}):
+			ws.restarts++
+		}
+	}
+	if len(keys) > 0 {
+		ws.seen = true
+	}
+	ws.keys = keys
+}
+
+/*
+hardwareMetricWatch – provides the state of the watched services.
+
+	Every scrape walks /proc once and matches each process against
+	all entries. Executable paths and command lines are read only
+	for entries that need them. CPU usage is computed from the
+	counter deltas between two consecutive scrapes.
+*/
+type hardwareMetricWatch struct {
+	fs      procfs.FS
+	entries []WatchEntry
+	self    int // PID of the agent, its command line contains the matchers
+	delta   deltaTracker[uint64]
+
+	mu     sync.Mutex
+	states []watchState
+}
+
+/*
+NewHardwareMetricWatch – creates a new hardwareMetricWatch instance.
+*/
+func NewHardwareMetricWatch(fs procfs.FS, entries []WatchEntry) *hardwareMetricWatch {
+	return &hardwareMetricWatch{
+		fs:      fs,
+		entries: entries,
+		self:    os.Getpid(),
+		states:  make([]watchState, len(entries)),
+	}
+}
+
+/*
+RegisterWatchCollector – registers the watched services collector.
+
+	Kept apart from RegisterCollectors, because it is only useful
+	with a configured watch list.
+*/
+func RegisterWatchCollector(reg *monitor.Registry, fs procfs.FS, entries []WatchEntry) error {
+	return reg.Register(
+		monitor.NewCollector(domain.KeyServices, 15*time.Second, NewHardwareMetricWatch(fs, entries).ScrapeServices,
+			monitor.WithDescription("Watched services up/down state, instances, restarts and usage"),
+		),
+	)
+}
+
+// ScrapeServices – collects the state of all watched services.
+func (hmw *hardwareMetricWatch) ScrapeServices(ctx context.Context) (domain.ServiceList, error) {
+	kstat, err := hmw.fs.Stat()
+	if err != nil {
+		return domain.ServiceList{}, ErrScrapeServices.Wrap(err)
+	}
+
+	instances, err := hmw.matchInstances(ctx)
+	if err != nil {
+		return domain.ServiceList{}, ErrScrapeServices.Wrap(err)
+	}
+
+	samples := make(map[string]uint64)
+	for _, list := range instances {
+		for _, inst := range list {
+			samples[inst.key] = inst.ticks
+		}
+	}
+	prev, elapsed, _ := hmw.delta.advance(samples, time.Now())
+
+	hmw.mu.Lock()
+	defer hmw.mu.Unlock()
+
+	services := make(domain.ServiceList, len(hmw.entries))
+
+	for i, we := range hmw.entries {
+		list := instances[i]
+		slices.SortFunc(list, func(a, b watchInstance) int {
+			return cmp.Or(cmp.Compare(a.start, b.start), cmp.Compare(a.pid, b.pid))
+		})
+
+		svc := domain.ServiceStatus{
+			Name:      we.Name,
+			Match:     we.Match(),
+			Running:   len(list) > 0,
+			Instances: len(list),
+			PIDs:      make([]int, len(list)),
+		}
+
+		keys := make([]string, len(list))
+		for j, inst := range list {
+			keys[j] = inst.key
+			svc.PIDs[j] = inst.pid
+			svc.RSS += inst.rss
+			if p, ok := prev[inst.key]; ok {
+				svc.CPUPercent += processCPUPercent(p, inst.ticks, elapsed)
+			}
+		}
+
+		if svc.Running {
+			svc.StartedAt = bootTicksTime(kstat.BootTime, list[0].start)
+		}
+		hmw.states[i].observe(keys)
+		svc.Restarts = hmw.states[i].restarts
+
+		services[i] = svc
+	}
+
+	return services, nil
+}
+
+/*
+matchInstances – returns the processes matched by each entry.
+
+	The agent itself is skipped: its command line holds the
+	--watch flags, so every cmdline matcher would match it.
+	Threads are not listed in /proc, skipping the PID is enough.
+*/
+func (hmw *hardwareMetricWatch) matchInstances(ctx context.Context) ([][]watchInstance, error) {
+	instances := make([][]watchInstance, len(hmw.entries))
+	scan := false
+
+	for i, we := range hmw.entries {
+		if we.kind != watchPidfile {
+			scan = true
+			continue
+		}
+
+		pid, ok := we.pidfilePID()
+		if !ok {
+			continue
+		}
+		if p, err := hmw.fs.Proc(pid); err == nil {
+			if stat, err := p.Stat(); err == nil {
+				instances[i] = append(instances[i], newWatchInstance(stat))
+			}
+		}
+	}
+
+	if !scan {
+		return instances, nil
+	}
+
+	procs, err := hmw.fs.AllProcs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range procs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if p.PID == hmw.self {
+			continue
+		}
+
+		stat, err := p.Stat()
+		if err != nil {
+			continue // exited while being read
+		}
+		wp := &watchProc{proc: p, stat: stat}
+
+		for i, we := range hmw.entries {
+			if we.matches(wp) {
+				instances[i] = append(instances[i], newWatchInstance(stat))
+			}
+		}
+	}
+
+	return instances, nil
+}
+
+// bootTicksTime – converts clock ticks after boot to the wall time.
+func bootTicksTime(bootTime, ticks uint64) time.Time {
+	return time.Unix(int64(bootTime), 0).Add(ticksDuration(ticks))
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package system
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prometheus/procfs"
+)
+
+func Test_ParseWatchEntry(t *testing.T) {
+	tests := []struct {
+		match string
+		ok    bool
+	}{
+		{"name:^nginx$", true},
+		{"exe:/usr/sbin/nginx", true},
+		{"cmdline:java .*jellyfin", true},
+		{"pidfile:/run/nginx.pid", true},
+		{"name:(", false},
+		{"nginx", false},
+		{"exe:", false},
+		{"unit:nginx.service", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.match, func(t *testing.T) {
+			we, err := ParseWatchEntry("svc", tt.match)
+			if (err == nil) != tt.ok {
+				t.Fatalf("ParseWatchEntry(%q) error = %v, want ok %v", tt.match, err, tt.ok)
+			}
+			if tt.ok && we.Match() != tt.match {
+				t.Errorf("Match() = %q, want %q", we.Match(), tt.match)
+			}
+		})
+	}
+}
+
+// writeFakeProc – writes /proc/[pid] files of a process into the fake procfs root.
+func writeFakeProc(t *testing.T, root string, pid int, comm, exe string, start uint64, cmdline ...string) {
+	t.Helper()
+
+	dir := filepath.Join(root, fmt.Sprint(pid))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	stat := fmt.Sprintf(
+		"%d (%s) S 1 %d %d 0 -1 4194304 83 0 0 0 100 50 0 0 20 0 1 0 %d 2703360 305 "+
+			"18446744073709551615 1 1 1 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0\n",
+		pid, comm, pid, pid, start,
+	)
+	files := map[string]string{
+		"stat":    stat,
+		"cmdline": "",
+	}
+	for _, arg := range cmdline {
+		files["cmdline"] += arg + "\x00"
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if exe != "" {
+		if err := os.Symlink(exe, filepath.Join(dir, "exe")); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_hardwareMetricWatch(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "stat"), []byte("btime 1700000000\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFakeProc(t, root, 10, "nginx", "/usr/sbin/nginx", 100, "nginx: master process")
+	writeFakeProc(t, root, 11, "nginx", "/usr/sbin/nginx", 150, "nginx: worker process")
+	writeFakeProc(t, root, 20, "java", "/usr/bin/java", 200, "/usr/bin/java", "-jar", "jellyfin.jar")
+	writeFakeProc(t, root, 30, "fstmon", "/usr/bin/fstmon", 50, "fstmon", "--watch", "jellyfin=cmdline:jellyfin.jar")
+
+	pidfile := filepath.Join(t.TempDir(), "java.pid")
+	if err := os.WriteFile(pidfile, []byte("20\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []WatchEntry
+	for _, m := range [][2]string{
+		{"nginx", "exe:/usr/sbin/nginx"},
+		{"jellyfin", "cmdline:jellyfin\\.jar"},
+		{"pidfile", "pidfile:" + pidfile},
+		{"absent", "name:^postgres$"},
+	} {
+		we, err := ParseWatchEntry(m[0], m[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, we)
+	}
+
+	fs, err := procfs.NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hmw := NewHardwareMetricWatch(fs, entries)
+	hmw.self = 30
+
+	services, err := hmw.ScrapeServices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nginx := services[0]
+	if !nginx.Running || nginx.Instances != 2 || !slices.Equal(nginx.PIDs, []int{10, 11}) {
+		t.Errorf("nginx = %+v, want 2 running instances", nginx)
+	}
+	if want := time.Unix(1700000001, 0); !nginx.StartedAt.Equal(want) {
+		t.Errorf("nginx started at %v, want %v", nginx.StartedAt, want)
+	}
+	if !services[1].Running || !slices.Equal(services[1].PIDs, []int{20}) {
+		t.Errorf("jellyfin = %+v, want pid 20", services[1])
+	}
+	if !services[2].Running || !slices.Equal(services[2].PIDs, []int{20}) {
+		t.Errorf("pidfile = %+v, want pid 20", services[2])
+	}
+	if services[3].Running || services[3].Instances != 0 {
+		t.Errorf("absent = %+v, want not running", services[3])
+	}
+
+	// master process replaced
+	if err := os.RemoveAll(filepath.Join(root, "10")); err != nil {
+		t.Fatal(err)
+	}
+	writeFakeProc(t, root, 12, "nginx", "/usr/sbin/nginx", 300, "nginx: master process")
+
+	services, err = hmw.ScrapeServices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if services[0].Restarts != 1 || services[1].Restarts != 0 {
+		t.Errorf("restarts = %d, %d, want 1, 0", services[0].Restarts, services[1].Restarts)
+	}
+
+	// the oldest instance exits, the new master keeps running
+	if err := os.RemoveAll(filepath.Join(root, "11")); err != nil {
+		t.Fatal(err)
+	}
+
+	services, err = hmw.ScrapeServices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(services[0].PIDs, []int{12}) || services[0].Restarts != 1 {
+		t.Errorf("nginx = %+v, want pid 12 without a new restart", services[0])
+	}
+
+	// service down, the pidfile still points to an exited process,
+	// only the agent's own command line matches the pattern
+	if err := os.RemoveAll(filepath.Join(root, "20")); err != nil {
+		t.Fatal(err)
+	}
+
+	services, err = hmw.ScrapeServices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if services[1].Running || services[2].Running || services[1].Restarts != 0 {
+		t.Errorf("jellyfin = %+v, pidfile = %+v, want down", services[1], services[2])
+	}
+}
+
+func Test_watchState(t *testing.T) {
+	tests := []struct {
+		name   string
+		scrape [][]string
+		want   int
+	}{
+		{"down and up", [][]string{nil, {"10/1"}, {"10/1"}, nil, nil, {"12/5"}}, 1},
+		{"main replaced", [][]string{{"10/1"}, {"12/5"}, {"13/7"}}, 2},
+		{"oldest of several exits", [][]string{{"10/1", "11/2", "12/3"}, {"11/2", "12/3"}, {"12/3"}}, 0},
+		{"worker replaced", [][]string{{"10/1", "11/2"}, {"10/1", "13/4"}}, 0},
+		{"main replaced among several", [][]string{{"10/1", "11/2"}, {"11/2", "14/5"}}, 1},
+		{"never seen", [][]string{nil, nil}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ws watchState
+			for _, keys := range tt.scrape {
+				ws.observe(keys)
+			}
+			if ws.restarts != tt.want {
+				t.Errorf("restarts = %d, want %d", ws.restarts, tt.want)
+			}
+		})
+	}
+}
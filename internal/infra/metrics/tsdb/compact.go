@@ -0,0 +1,132 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package tsdb
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"time"
+)
+
+/*
+CompactionLevel – downsampling of the segments older than After.
+
+	Finer segments ending before now-After are merged into blocks of
+	Resolution buckets, one block per Span-long window aligned to the
+	Unix epoch. Segments passing the cutoff on a later pass are merged
+	into the block already written for their window.
+*/
+type CompactionLevel struct {
+	After      time.Duration
+	Resolution time.Duration
+	Span       time.Duration
+}
+
+// DefaultCompactionLevels – 1m buckets after a day in daily blocks, 1h buckets after a week in weekly blocks.
+var DefaultCompactionLevels = []CompactionLevel{
+	{After: 24 * time.Hour, Resolution: time.Minute, Span: 24 * time.Hour},
+	{After: 7 * 24 * time.Hour, Resolution: time.Hour, Span: 7 * 24 * time.Hour},
+}
+
+// compact – runs every compaction level from the finest one.
+func (db *DB) compact(now time.Time) error {
+	var errs []error
+	for _, level := range db.levels {
+		if err := db.compactLevel(now, level); err != nil {
+			errs = append(errs, fmt.Errorf("compact %s: %w", level.Resolution, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (db *DB) compactLevel(now time.Time, level CompactionLevel) error {
+	cutoff := now.Add(-level.After).UnixNano()
+	windows := make(map[int64][]*segmentMeta)
+
+	db.mu.RLock()
+	for _, seg := range db.segments {
+		if seg.resolution >= level.Resolution || seg.maxT >= cutoff {
+			continue
+		}
+		w := seg.minT - seg.minT%int64(level.Span)
+		windows[w] = append(windows[w], seg)
+	}
+
+	// blocks of earlier passes are rewritten with the new sources
+	for _, seg := range db.segments {
+		if seg.resolution != level.Resolution {
+			continue
+		}
+		w := seg.minT - seg.minT%int64(level.Span)
+		if _, ok := windows[w]; ok {
+			windows[w] = append(windows[w], seg)
+		}
+	}
+	db.mu.RUnlock()
+
+	var errs []error
+	for _, w := range slices.Sorted(maps.Keys(windows)) {
+		if err := db.mergeSegments(windows[w], level.Resolution); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+/*
+mergeSegments – replaces the segments with a single downsampled block.
+
+	The block is written before the sources are removed, a crash in
+	between leaves sources covered by the block, which Open removes.
+	Points of a former block are already downsampled and merge into
+	the same buckets again.
+*/
+func (db *DB) mergeSegments(sources []*segmentMeta, res time.Duration) error {
+	data := make(map[seriesID][]point)
+	span := emptySpan
+
+	for _, seg := range sources {
+		_, segData, err := readSegment(seg.path, true)
+		if err != nil {
+			return err
+		}
+
+		for id, points := range segData {
+			data[id] = append(data[id], points...)
+		}
+		span.minT = min(span.minT, seg.minT)
+		span.maxT = max(span.maxT, seg.maxT)
+	}
+
+	for id, points := range data {
+		sortPoints(points)
+		data[id] = downsample(points, res)
+	}
+
+	block, err := writeSegment(db.dir, res, data, span)
+	if err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	db.segments = slices.DeleteFunc(db.segments, func(seg *segmentMeta) bool {
+		return slices.Contains(sources, seg)
+	})
+	db.segments = append(db.segments, block)
+	db.sortSegments()
+	db.mu.Unlock()
+
+	var errs []error
+	for _, seg := range sources {
+		if err := os.Remove(seg.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
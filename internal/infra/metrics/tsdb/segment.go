@@ -0,0 +1,472 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package tsdb
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+const (
+	segmentMagic   = "FSTS"
+	segmentVersion = 1
+	segmentExt     = ".seg"
+	tmpExt         = ".tmp"
+	corruptExt     = ".corrupt"
+)
+
+var (
+	ErrSegmentCorrupted = errors.New("segment corrupted")
+	ErrSegmentVersion   = errors.New("unsupported segment version")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// seriesID – numeric series of the metric key, e.g. net_io / eth0.bytes_per_sec.rx.
+type seriesID struct {
+	key  string
+	name string
+}
+
+// point – aggregate of the samples within a resolution step, raw samples have count 1.
+type point struct {
+	t     int64 // unix nanoseconds
+	min   float64
+	max   float64
+	sum   float64
+	last  float64
+	count uint32
+}
+
+func rawPoint(t int64, v float64) point {
+	return point{t: t, min: v, max: v, sum: v, last: v, count: 1}
+}
+
+func (p *point) merge(o point) {
+	p.min = min(p.min, o.min)
+	p.max = max(p.max, o.max)
+	p.sum += o.sum
+	p.last = o.last
+	p.count += o.count
+}
+
+func (p point) seriesPoint() domain.SeriesPoint {
+	return domain.SeriesPoint{
+		Timestamp: time.Unix(0, p.t),
+		Min:       p.min,
+		Max:       p.max,
+		Avg:       p.sum / float64(p.count),
+		Last:      p.last,
+		Count:     int(p.count),
+	}
+}
+
+/*
+downsample – merges time sorted points into resolution buckets.
+
+	Buckets are aligned to the Unix epoch, the average stays weighted
+	by sample count since sums are merged.
+*/
+func downsample(points []point, res time.Duration) []point {
+	if res <= 0 || len(points) == 0 {
+		return points
+	}
+
+	out := make([]point, 0, len(points))
+	for _, p := range points {
+		p.t -= p.t % int64(res)
+
+		if n := len(out); n > 0 && out[n-1].t == p.t {
+			out[n-1].merge(p)
+			continue
+		}
+		out = append(out, p)
+	}
+
+	return out
+}
+
+// sortPoints – orders the points by time keeping the arrival order of equal timestamps.
+func sortPoints(points []point) {
+	slices.SortStableFunc(points, func(a, b point) int {
+		return cmp.Compare(a.t, b.t)
+	})
+}
+
+/*
+seriesRef – location of the encoded points of a series in the segment file.
+
+	Queries read and decode only this range instead of the whole file,
+	the file checksum is verified once when the segment is loaded.
+*/
+type seriesRef struct {
+	off   int64 // first byte of the points
+	size  int64
+	count uint64
+}
+
+/*
+segmentMeta – immutable segment file description kept in memory.
+
+	resolution is zero for raw samples flushed from the head and the
+	level resolution for compacted blocks.
+*/
+type segmentMeta struct {
+	path       string
+	minT       int64
+	maxT       int64
+	resolution time.Duration
+	size       int64
+	series     map[seriesID]seriesRef
+}
+
+func (m *segmentMeta) overlaps(since, until int64) bool {
+	return m.minT <= until && m.maxT >= since
+}
+
+func segmentName(res time.Duration, minT, maxT int64) string {
+	return fmt.Sprintf("%016x-%016x-r%d%s", minT, maxT, int64(res/time.Second), segmentExt)
+}
+
+/*
+timeSpan – time range of a segment.
+
+	Blocks keep the range of their source segments, so it stays
+	visible which segments a block replaced even though its
+	downsampled points start and end on bucket boundaries.
+*/
+type timeSpan struct {
+	minT int64
+	maxT int64
+}
+
+// emptySpan – span widened to the points only.
+var emptySpan = timeSpan{minT: math.MaxInt64, maxT: math.MinInt64}
+
+/*
+encodeSegment – encodes the series into the segment format.
+
+	header: magic, version, resolution, minT, maxT, series count
+	series: key, name, point count, points
+	point:  time delta varint, then the value for raw segments
+	        or min, max, sum, last and count for blocks
+	footer: CRC32-C of everything before it
+
+All integers are little endian, strings are uvarint length prefixed.
+*/
+func encodeSegment(res time.Duration, data map[seriesID][]point, span timeSpan) (b []byte, meta segmentMeta) {
+	ids := make([]seriesID, 0, len(data))
+	minT, maxT := span.minT, span.maxT
+
+	for id, points := range data {
+		if len(points) == 0 {
+			continue
+		}
+		ids = append(ids, id)
+		minT = min(minT, points[0].t)
+		maxT = max(maxT, points[len(points)-1].t)
+	}
+
+	slices.SortFunc(ids, func(a, b seriesID) int {
+		if a.key != b.key {
+			return cmp.Compare(a.key, b.key)
+		}
+		return cmp.Compare(a.name, b.name)
+	})
+
+	b = append(b, segmentMagic...)
+	b = append(b, segmentVersion)
+	b = binary.LittleEndian.AppendUint64(b, uint64(res))
+	b = binary.LittleEndian.AppendUint64(b, uint64(minT))
+	b = binary.LittleEndian.AppendUint64(b, uint64(maxT))
+	b = binary.AppendUvarint(b, uint64(len(ids)))
+
+	meta = segmentMeta{
+		minT:       minT,
+		maxT:       maxT,
+		resolution: res,
+		series:     make(map[seriesID]seriesRef, len(ids)),
+	}
+
+	for _, id := range ids {
+		points := data[id]
+
+		b = appendString(b, id.key)
+		b = appendString(b, id.name)
+		b = binary.AppendUvarint(b, uint64(len(points)))
+
+		ref := seriesRef{off: int64(len(b)), count: uint64(len(points))}
+
+		prev := int64(0)
+		for _, p := range points {
+			b = binary.AppendVarint(b, p.t-prev)
+			prev = p.t
+
+			if res == 0 {
+				b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.last))
+				continue
+			}
+
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.min))
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.max))
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.sum))
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p.last))
+			b = binary.AppendUvarint(b, uint64(p.count))
+		}
+
+		ref.size = int64(len(b)) - ref.off
+		meta.series[id] = ref
+	}
+
+	return binary.LittleEndian.AppendUint32(b, crc32.Checksum(b, crcTable)), meta
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// decoder – sticky error reader over the encoded bytes.
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) fail() {
+	if d.err == nil {
+		d.err = ErrSegmentCorrupted
+	}
+	d.b = nil
+}
+
+func (d *decoder) uint64() uint64 {
+	if len(d.b) < 8 {
+		d.fail()
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.b)
+	d.b = d.b[8:]
+	return v
+}
+
+func (d *decoder) float64() float64 {
+	return math.Float64frombits(d.uint64())
+}
+
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	v, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.uvarint()
+	if uint64(len(d.b)) < n {
+		d.fail()
+		return ""
+	}
+	s := string(d.b[:n])
+	d.b = d.b[n:]
+	return s
+}
+
+// decodeSegment – decodes and verifies the segment, data is skipped when withData is false.
+func decodeSegment(b []byte, withData bool) (meta segmentMeta, data map[seriesID][]point, err error) {
+	if len(b) < len(segmentMagic)+1+24+4 || string(b[:len(segmentMagic)]) != segmentMagic {
+		return meta, nil, ErrSegmentCorrupted
+	}
+
+	body, sum := b[:len(b)-4], binary.LittleEndian.Uint32(b[len(b)-4:])
+	if crc32.Checksum(body, crcTable) != sum {
+		return meta, nil, fmt.Errorf("%w: checksum mismatch", ErrSegmentCorrupted)
+	}
+
+	if v := body[len(segmentMagic)]; v != segmentVersion {
+		return meta, nil, fmt.Errorf("%w: %d", ErrSegmentVersion, v)
+	}
+
+	d := &decoder{b: body[len(segmentMagic)+1:]}
+	meta.resolution = time.Duration(d.uint64())
+	meta.minT = int64(d.uint64())
+	meta.maxT = int64(d.uint64())
+
+	count := d.uvarint()
+	if count > uint64(len(d.b)) {
+		d.fail()
+	}
+	if d.err == nil {
+		meta.series = make(map[seriesID]seriesRef, count)
+	}
+	if withData && d.err == nil {
+		data = make(map[seriesID][]point, count)
+	}
+
+	for i := uint64(0); i < count && d.err == nil; i++ {
+		id := seriesID{key: d.string(), name: d.string()}
+		ref := seriesRef{count: d.uvarint()}
+		ref.off = int64(len(body) - len(d.b))
+
+		points := decodePoints(d, meta.resolution, ref.count)
+		ref.size = int64(len(body)-len(d.b)) - ref.off
+
+		meta.series[id] = ref
+		if withData {
+			data[id] = points
+		}
+	}
+
+	if d.err != nil {
+		return segmentMeta{}, nil, d.err
+	}
+
+	return meta, data, nil
+}
+
+// decodePoints – decodes n points of a series encoded with the resolution.
+func decodePoints(d *decoder, res time.Duration, n uint64) []point {
+	if n > uint64(len(d.b)) { // every point takes at least 9 bytes
+		d.fail()
+		return nil
+	}
+
+	points := make([]point, 0, n)
+	prev := int64(0)
+	for j := uint64(0); j < n && d.err == nil; j++ {
+		t := prev + d.varint()
+		prev = t
+
+		if res == 0 {
+			points = append(points, rawPoint(t, d.float64()))
+			continue
+		}
+
+		p := point{t: t, min: d.float64(), max: d.float64(), sum: d.float64(), last: d.float64()}
+		p.count = uint32(d.uvarint())
+		points = append(points, p)
+	}
+
+	return points
+}
+
+// readSegment – reads and verifies the segment file.
+func readSegment(path string, withData bool) (segmentMeta, map[seriesID][]point, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return segmentMeta{}, nil, err
+	}
+
+	meta, data, err := decodeSegment(b, withData)
+	if err != nil {
+		return segmentMeta{}, nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+
+	meta.path = path
+	meta.size = int64(len(b))
+
+	return meta, data, nil
+}
+
+// readSeries – reads and decodes the points of a single series of the segment file.
+func readSeries(meta *segmentMeta, ref seriesRef) ([]point, error) {
+	f, err := os.Open(meta.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b := make([]byte, ref.size)
+	if _, err := f.ReadAt(b, ref.off); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(meta.path), err)
+	}
+
+	d := &decoder{b: b}
+	points := decodePoints(d, meta.resolution, ref.count)
+	if d.err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(meta.path), d.err)
+	}
+
+	return points, nil
+}
+
+/*
+writeSegment – writes the series into a new segment file of the directory.
+
+	The file is synced under a temporary name and renamed, so a
+	crash leaves either the complete segment or a temp file that
+	is removed on the next open.
+*/
+func writeSegment(dir string, res time.Duration, data map[seriesID][]point, span timeSpan) (*segmentMeta, error) {
+	b, meta := encodeSegment(res, data, span)
+	path := filepath.Join(dir, segmentName(res, meta.minT, meta.maxT))
+
+	if err := writeFileSync(path+tmpExt, b); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(path+tmpExt, path); err != nil {
+		os.Remove(path + tmpExt)
+		return nil, err
+	}
+
+	if err := syncDir(dir); err != nil {
+		return nil, err
+	}
+
+	meta.path = path
+	meta.size = int64(len(b))
+
+	return &meta, nil
+}
+
+func writeFileSync(path string, b []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
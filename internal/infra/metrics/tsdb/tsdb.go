@@ -0,0 +1,540 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package tsdb
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+	metricstore "github.com/eterline/fstmon/internal/infra/metrics/metric_store"
+)
+
+const (
+	DefaultSegmentDuration = 2 * time.Hour
+	DefaultRetention       = 30 * 24 * time.Hour
+)
+
+var ErrClosed = errors.New("tsdb closed")
+
+// RecoveryInfo – what Open had to repair in the directory.
+type RecoveryInfo struct {
+	Segments        int      // loaded segment files
+	ReplayedRecords int      // WAL records replayed into the head
+	TruncatedBytes  int64    // torn WAL tail dropped
+	RemovedTemp     []string // files of interrupted writes
+	Corrupted       []string // segments renamed to *.corrupt
+	Superseded      []string // sources of an interrupted compaction
+}
+
+// DBOption – functional option for Open.
+type DBOption func(*DB)
+
+// WithSegmentDuration – sets how long the head collects samples before it is flushed into a segment.
+func WithSegmentDuration(d time.Duration) DBOption {
+	return func(db *DB) {
+		if d > 0 {
+			db.segmentDuration = d
+		}
+	}
+}
+
+// WithRetention – sets the age after which segments are deleted.
+func WithRetention(d time.Duration) DBOption {
+	return func(db *DB) {
+		if d > 0 {
+			db.retention = d
+		}
+	}
+}
+
+// WithMaxSize – limits the total segment size in bytes, the oldest segments are deleted first. Zero – unlimited.
+func WithMaxSize(bytes int64) DBOption {
+	return func(db *DB) {
+		if bytes >= 0 {
+			db.maxSize = bytes
+		}
+	}
+}
+
+// WithCompactionLevels – replaces the default compaction levels. Levels must go from the finest resolution.
+func WithCompactionLevels(levels ...CompactionLevel) DBOption {
+	return func(db *DB) {
+		if len(levels) > 0 {
+			db.levels = levels
+		}
+	}
+}
+
+/*
+DB – embedded append-only storage of the numeric metric series.
+
+	Values are flattened by metricstore.FlattenSeries and appended to
+	the WAL and the in-memory head. Once the head spans the segment
+	duration it is flushed into an immutable segment file and the WAL
+	is reset. Maintenance compacts old segments into downsampled blocks
+	and applies age and size retention.
+
+	Directory layout:
+		wal.log                      – head samples since the last flush
+		<minT>-<maxT>-r<res>.seg     – raw segments (r0) and blocks
+*/
+type DB struct {
+	dir             string
+	segmentDuration time.Duration
+	retention       time.Duration
+	maxSize         int64
+	levels          []CompactionLevel
+
+	mu       sync.RWMutex
+	wal      *wal
+	walErr   error
+	head     map[seriesID][]point
+	headMinT int64
+	segments []*segmentMeta // ordered by minT
+	series   map[string]map[string]struct{}
+	recovery RecoveryInfo
+}
+
+/*
+Open – opens or creates the storage in the directory.
+
+	Recovery removes temp files of interrupted writes, renames
+	segments failing verification to *.corrupt, drops the sources of an
+	interrupted compaction and replays the WAL up to its first torn
+	record. What was repaired is reported by Recovery.
+*/
+func Open(dir string, opts ...DBOption) (*DB, error) {
+	db := &DB{
+		dir:             dir,
+		segmentDuration: DefaultSegmentDuration,
+		retention:       DefaultRetention,
+		levels:          DefaultCompactionLevels,
+		head:            make(map[seriesID][]point),
+		series:          make(map[string]map[string]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(db)
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	if err := db.loadSegments(); err != nil {
+		return nil, err
+	}
+
+	flushedUntil := int64(0)
+	for _, seg := range db.segments {
+		flushedUntil = max(flushedUntil, seg.maxT)
+	}
+
+	w, truncated, err := openWal(filepath.Join(dir, walFile), func(rec walRecord) {
+		db.recovery.ReplayedRecords++
+		if rec.t > flushedUntil { // already flushed when the crash hit before the WAL reset
+			db.appendHead(rec)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open wal: %w", err)
+	}
+
+	db.wal = w
+	db.recovery.TruncatedBytes = truncated
+	db.recovery.Segments = len(db.segments)
+	db.rebuildIndex()
+
+	return db, nil
+}
+
+func (db *DB) loadSegments() error {
+	entries, err := os.ReadDir(db.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		path := filepath.Join(db.dir, e.Name())
+
+		switch {
+		case strings.HasSuffix(e.Name(), tmpExt):
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			db.recovery.RemovedTemp = append(db.recovery.RemovedTemp, e.Name())
+
+		case strings.HasSuffix(e.Name(), segmentExt):
+			meta, _, err := readSegment(path, false)
+			if err != nil {
+				if err := os.Rename(path, path+corruptExt); err != nil {
+					return err
+				}
+				db.recovery.Corrupted = append(db.recovery.Corrupted, e.Name())
+				continue
+			}
+			db.segments = append(db.segments, &meta)
+		}
+	}
+
+	db.sortSegments()
+
+	// a block covering a finer segment or a former block of its
+	// resolution means the compaction crashed after writing the block
+	// and before removing its sources
+	kept := make([]*segmentMeta, 0, len(db.segments))
+	for _, seg := range db.segments {
+		superseded := slices.ContainsFunc(db.segments, func(b *segmentMeta) bool {
+			return b != seg && b.resolution > 0 && b.resolution >= seg.resolution &&
+				b.minT <= seg.minT && b.maxT >= seg.maxT
+		})
+		if !superseded {
+			kept = append(kept, seg)
+			continue
+		}
+		if err := os.Remove(seg.path); err != nil {
+			return err
+		}
+		db.recovery.Superseded = append(db.recovery.Superseded, filepath.Base(seg.path))
+	}
+	db.segments = kept
+
+	return nil
+}
+
+func (db *DB) sortSegments() {
+	slices.SortFunc(db.segments, func(a, b *segmentMeta) int {
+		return cmp.Compare(a.minT, b.minT)
+	})
+}
+
+// rebuildIndex – collects the series names of the head and the segments.
+func (db *DB) rebuildIndex() {
+	db.series = make(map[string]map[string]struct{})
+
+	for _, seg := range db.segments {
+		for id := range seg.series {
+			db.index(id)
+		}
+	}
+	for id := range db.head {
+		db.index(id)
+	}
+}
+
+func (db *DB) index(id seriesID) {
+	names, ok := db.series[id.key]
+	if !ok {
+		names = make(map[string]struct{})
+		db.series[id.key] = names
+	}
+	names[id.name] = struct{}{}
+}
+
+func (db *DB) appendHead(rec walRecord) {
+	if len(db.head) == 0 || rec.t < db.headMinT {
+		db.headMinT = rec.t
+	}
+
+	for i, name := range rec.names {
+		id := seriesID{key: rec.key, name: name}
+		db.head[id] = append(db.head[id], rawPoint(rec.t, rec.values[i]))
+	}
+}
+
+// Recovery – returns what Open had to repair.
+func (db *DB) Recovery() RecoveryInfo {
+	return db.recovery
+}
+
+// Record – appends the numeric series of the value. WAL errors are reported by the maintenance loop.
+func (db *DB) Record(key string, value any, ts time.Time) {
+	flat := metricstore.FlattenSeries(value)
+	if len(flat) == 0 {
+		return
+	}
+
+	rec := walRecord{
+		t:      ts.UnixNano(),
+		key:    key,
+		names:  make([]string, 0, len(flat)),
+		values: make([]float64, 0, len(flat)),
+	}
+	for name, v := range flat {
+		rec.names = append(rec.names, name)
+		rec.values = append(rec.values, v)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.wal == nil {
+		db.walErr = ErrClosed
+		return
+	}
+
+	if err := db.wal.append(rec); err != nil {
+		db.walErr = err
+	}
+
+	db.appendHead(rec)
+	for _, name := range rec.names {
+		db.index(seriesID{key: key, name: name})
+	}
+}
+
+// Series – returns the sorted series names of the key.
+func (db *DB) Series(key string) ([]string, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	names, ok := db.series[key]
+	if !ok {
+		return nil, false
+	}
+
+	out := make([]string, 0, len(names))
+	for name := range names {
+		out = append(out, name)
+	}
+	slices.Sort(out)
+
+	return out, true
+}
+
+/*
+Query – returns the series points within [since, until].
+
+	Zero until means now, zero since means one hour ago. Only the
+	series range of each overlapping segment is read, its points
+	and the head ones are merged in time order, a positive step
+	downsamples them. resolution is the coarsest stored resolution
+	among the returned points, zero for raw samples only.
+*/
+func (db *DB) Query(key, name string, since, until time.Time, step time.Duration) (points []domain.SeriesPoint, resolution time.Duration, ok bool) {
+	now := time.Now()
+	if until.IsZero() {
+		until = now
+	}
+	if since.IsZero() {
+		since = now.Add(-time.Hour)
+	}
+
+	from, to := since.UnixNano(), until.UnixNano()
+	id := seriesID{key: key, name: name}
+
+	db.mu.RLock()
+	if _, ok := db.series[key][name]; !ok {
+		db.mu.RUnlock()
+		return nil, 0, false
+	}
+
+	var segments []*segmentMeta
+	for _, seg := range db.segments {
+		if _, ok := seg.series[id]; ok && seg.overlaps(from, to) {
+			segments = append(segments, seg)
+		}
+	}
+	head := inRange(db.head[id], from, to)
+	db.mu.RUnlock()
+
+	var raw []point
+	for _, seg := range segments {
+		data, err := readSeries(seg, seg.series[id])
+		if err != nil { // removed by retention meanwhile
+			continue
+		}
+
+		if found := inRange(data, from, to); len(found) > 0 {
+			raw = append(raw, found...)
+			resolution = max(resolution, seg.resolution)
+		}
+	}
+	raw = append(raw, head...)
+
+	sortPoints(raw)
+	raw = downsample(raw, step)
+
+	points = make([]domain.SeriesPoint, len(raw))
+	for i, p := range raw {
+		points[i] = p.seriesPoint()
+	}
+
+	return points, resolution, true
+}
+
+// inRange – returns a copy of the time sorted points within [from, to].
+func inRange(points []point, from, to int64) []point {
+	i, _ := slices.BinarySearchFunc(points, from, func(p point, t int64) int {
+		return cmp.Compare(p.t, t)
+	})
+	j, _ := slices.BinarySearchFunc(points, to+1, func(p point, t int64) int {
+		return cmp.Compare(p.t, t)
+	})
+	if i >= j {
+		return nil
+	}
+	return slices.Clone(points[i:j])
+}
+
+/*
+flushHead – writes the head into a raw segment once it spans the segment duration.
+
+	The lock is held for the whole flush, so no sample is appended
+	to the WAL between the segment write and the WAL reset.
+*/
+func (db *DB) flushHead(now time.Time, force bool) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.wal == nil {
+		return ErrClosed
+	}
+	if len(db.head) == 0 {
+		return nil
+	}
+	if !force && now.UnixNano()-db.headMinT < int64(db.segmentDuration) {
+		return nil
+	}
+
+	for _, points := range db.head {
+		sortPoints(points)
+	}
+
+	meta, err := writeSegment(db.dir, 0, db.head, emptySpan)
+	if err != nil {
+		return fmt.Errorf("flush head: %w", err)
+	}
+
+	db.segments = append(db.segments, meta)
+	db.sortSegments()
+	db.head = make(map[seriesID][]point)
+
+	return db.wal.reset()
+}
+
+/*
+applyRetention – deletes segments older than the retention,
+then the oldest ones while the total size exceeds the limit.
+*/
+func (db *DB) applyRetention(now time.Time) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	cutoff := now.Add(-db.retention).UnixNano()
+	total := int64(0)
+	for _, seg := range db.segments {
+		total += seg.size
+	}
+
+	var errs []error
+	kept := db.segments[:0]
+	for _, seg := range db.segments {
+		expired := seg.maxT < cutoff
+		oversize := db.maxSize > 0 && total > db.maxSize
+
+		if !expired && !oversize {
+			kept = append(kept, seg)
+			continue
+		}
+
+		if err := os.Remove(seg.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+			kept = append(kept, seg)
+			continue
+		}
+		total -= seg.size
+	}
+
+	if len(kept) != len(db.segments) {
+		db.segments = kept
+		db.rebuildIndex()
+	}
+
+	return errors.Join(errs...)
+}
+
+// Maintain – runs a single maintenance pass: WAL sync, head flush, compaction and retention.
+func (db *DB) Maintain(now time.Time) error {
+	db.mu.Lock()
+	err := db.walErr
+	db.walErr = nil
+	if db.wal == nil {
+		db.mu.Unlock()
+		return ErrClosed
+	}
+	if syncErr := db.wal.sync(); syncErr != nil {
+		err = syncErr
+	}
+	db.mu.Unlock()
+
+	if err != nil {
+		err = fmt.Errorf("wal: %w", err)
+	}
+
+	return errors.Join(
+		err,
+		db.flushHead(now, false),
+		db.compact(now),
+		db.applyRetention(now),
+	)
+}
+
+// Run – runs maintenance every interval until ctx is done.
+func (db *DB) Run(ctx context.Context, interval time.Duration) {
+	logger := log.MustLoggerFromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := db.Maintain(now); err != nil {
+				logger.Error("tsdb maintenance error", "error", err, "dir", db.dir)
+			}
+		}
+	}
+}
+
+// Size – returns the total bytes of the segments and the WAL.
+func (db *DB) Size() int64 {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	total := int64(0)
+	for _, seg := range db.segments {
+		total += seg.size
+	}
+	if db.wal != nil {
+		total += db.wal.size
+	}
+	return total
+}
+
+// Close – syncs and closes the WAL. The head is replayed from it on the next open.
+func (db *DB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.wal == nil {
+		return nil
+	}
+
+	err := db.wal.close()
+	db.wal = nil
+	return err
+}
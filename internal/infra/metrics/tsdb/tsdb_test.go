@@ -0,0 +1,308 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package tsdb
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+func memory(used uint64) domain.MemoryMetrics {
+	return domain.MemoryMetrics{Used: used}
+}
+
+func Test_DB_WalRecovery(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Now().Add(-10 * time.Minute)
+
+	db, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range 5 {
+		db.Record("memory", memory(uint64(i)), base.Add(time.Duration(i)*time.Minute))
+	}
+	db.Close()
+
+	// torn record of a crash in the middle of the append
+	f, _ := os.OpenFile(filepath.Join(dir, walFile), os.O_WRONLY|os.O_APPEND, 0)
+	f.Write([]byte{42, 0, 0, 0, 1, 2})
+	f.Close()
+
+	db, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rec := db.Recovery()
+	if rec.ReplayedRecords != 5 || rec.TruncatedBytes != 6 {
+		t.Errorf("recovery %+v", rec)
+	}
+
+	points, res, ok := db.Query("memory", "used", base, time.Time{}, 0)
+	if !ok || res != 0 || len(points) != 5 || points[4].Last != 4 {
+		t.Errorf("res=%v points=%+v", res, points)
+	}
+}
+
+func Test_DB_CompactionRetention(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	base := now.Add(-10 * time.Hour).Truncate(time.Hour)
+
+	db, err := Open(dir,
+		WithSegmentDuration(time.Hour),
+		WithRetention(8*time.Hour),
+		WithCompactionLevels(CompactionLevel{After: time.Hour, Resolution: time.Minute, Span: 24 * time.Hour}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// 10s samples for 3 hours, then a fresh one
+	for i := range 3 * 360 {
+		db.Record("memory", memory(uint64(i%6)), base.Add(time.Duration(i)*10*time.Second))
+	}
+
+	if err := db.Maintain(now); err != nil {
+		t.Fatal(err)
+	}
+	db.Record("memory", memory(100), now)
+
+	if len(db.segments) != 1 || db.segments[0].resolution != time.Minute {
+		t.Fatalf("segments %+v", db.segments)
+	}
+
+	points, res, _ := db.Query("memory", "used", base, now, 0)
+	if res != time.Minute || len(points) != 181 {
+		t.Fatalf("res=%v len=%d", res, len(points))
+	}
+
+	expected := domain.SeriesPoint{Timestamp: base, Min: 0, Max: 5, Avg: 2.5, Last: 5, Count: 6}
+	if points[0] != expected {
+		t.Errorf("got %+v, want %+v", points[0], expected)
+	}
+	if points[180].Last != 100 {
+		t.Errorf("head point %+v", points[180])
+	}
+
+	// the block ends 7 hours ago, past the 5 hours retention of a later pass
+	db.retention = 5 * time.Hour
+	if err := db.Maintain(now); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(db.segments) != 0 {
+		t.Errorf("segments %+v", db.segments)
+	}
+	if points, _, _ := db.Query("memory", "used", base, now, 0); len(points) != 1 {
+		t.Errorf("points after retention %+v", points)
+	}
+}
+
+func Test_DB_SegmentRecovery(t *testing.T) {
+	dir := t.TempDir()
+
+	db, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db.Record("memory", memory(1), time.Now().Add(-time.Hour))
+	if err := db.flushHead(time.Now(), true); err != nil {
+		t.Fatal(err)
+	}
+	path := db.segments[0].path
+	db.Close()
+
+	b, _ := os.ReadFile(path)
+	b[len(b)/2] ^= 0xff
+	os.WriteFile(path, b, 0o640)
+	os.WriteFile(filepath.Join(dir, "x.seg"+tmpExt), nil, 0o640)
+
+	db, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rec := db.Recovery()
+	if len(rec.Corrupted) != 1 || len(rec.RemovedTemp) != 1 || rec.Segments != 0 {
+		t.Errorf("recovery %+v", rec)
+	}
+	if _, err := os.Stat(path + corruptExt); err != nil {
+		t.Error(err)
+	}
+}
+
+func Test_readSeries(t *testing.T) {
+	dir := t.TempDir()
+	used := seriesID{key: "memory", name: "used"}
+	free := seriesID{key: "memory", name: "free"}
+
+	for _, res := range []time.Duration{0, time.Minute} {
+		data := map[seriesID][]point{
+			used: {rawPoint(60e9, 1), rawPoint(120e9, 2), rawPoint(180e9, 3)},
+			free: {rawPoint(60e9, 7)},
+		}
+
+		written, err := writeSegment(dir, res, data, emptySpan)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		loaded, _, err := readSegment(written.path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !maps.Equal(loaded.series, written.series) {
+			t.Fatalf("res %v loaded refs %v, written %v", res, loaded.series, written.series)
+		}
+
+		for id, want := range data {
+			got, err := readSeries(&loaded, loaded.series[id])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("res %v %s = %+v, want %+v", res, id.name, got, want)
+			}
+		}
+	}
+}
+
+func Test_DB_CompactionIntoWindowBlock(t *testing.T) {
+	day := time.Now().Truncate(24 * time.Hour).Add(-48 * time.Hour)
+
+	db, err := Open(t.TempDir(),
+		WithSegmentDuration(time.Hour),
+		WithCompactionLevels(CompactionLevel{After: time.Hour, Resolution: time.Minute, Span: 24 * time.Hour}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// an hour of 10s samples compacted by each of two passes
+	for _, start := range []time.Duration{0, 4 * time.Hour} {
+		for i := range 360 {
+			db.Record("memory", memory(uint64(i%6)), day.Add(start+time.Duration(i)*10*time.Second))
+		}
+		if err := db.Maintain(day.Add(start + 3*time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(db.segments) != 1 || db.segments[0].resolution != time.Minute || db.segments[0].minT != day.UnixNano() {
+		t.Fatalf("segments %+v, want a single block of the day", db.segments)
+	}
+
+	points, res, _ := db.Query("memory", "used", day, day.Add(6*time.Hour), 0)
+	if res != time.Minute || len(points) != 120 {
+		t.Fatalf("res=%v len=%d, want both hours in 1m points", res, len(points))
+	}
+	if points[0].Count != 6 || points[119].Count != 6 {
+		t.Errorf("first %+v, last %+v, want 6 samples each", points[0], points[119])
+	}
+}
+
+func Test_DB_SizeRetention(t *testing.T) {
+	now := time.Now()
+
+	db, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for i := range 3 {
+		db.Record("memory", memory(uint64(i)), now.Add(time.Duration(i-3)*time.Hour))
+		if err := db.flushHead(now, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldest := db.segments[0]
+	db.maxSize = db.segments[1].size + db.segments[2].size
+
+	if err := db.applyRetention(now); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(db.segments) != 2 || slices.Contains(db.segments, oldest) {
+		t.Fatalf("segments %+v, want the oldest one deleted", db.segments)
+	}
+	if _, err := os.Stat(oldest.path); !os.IsNotExist(err) {
+		t.Errorf("oldest segment file: %v, want deleted", err)
+	}
+	if db.Size() > db.maxSize+db.wal.size {
+		t.Errorf("size %d over the limit %d", db.Size(), db.maxSize)
+	}
+}
+
+func Test_DB_SupersededRecovery(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Now().Add(-3 * time.Hour).Truncate(time.Minute)
+
+	db, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range 12 {
+		db.Record("memory", memory(uint64(i)), base.Add(time.Duration(i)*10*time.Second))
+	}
+	if err := db.flushHead(time.Now(), true); err != nil {
+		t.Fatal(err)
+	}
+	raw := db.segments[0]
+	db.Close()
+
+	_, data, err := readSegment(raw.path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, points := range data {
+		data[id] = downsample(points, time.Minute)
+	}
+
+	// crashes after writing a block and after rewriting it with a later source
+	block, err := writeSegment(dir, time.Minute, data, timeSpan{minT: raw.minT, maxT: raw.maxT})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wider, err := writeSegment(dir, time.Minute, data, timeSpan{minT: raw.minT, maxT: raw.maxT + int64(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rec := db.Recovery()
+	want := []string{filepath.Base(raw.path), filepath.Base(block.path)}
+	slices.Sort(rec.Superseded)
+	slices.Sort(want)
+	if !slices.Equal(rec.Superseded, want) {
+		t.Errorf("superseded %v, want %v", rec.Superseded, want)
+	}
+	if len(db.segments) != 1 || db.segments[0].path != wider.path {
+		t.Fatalf("segments %+v, want only the latest block", db.segments)
+	}
+
+	points, res, _ := db.Query("memory", "used", base, time.Now(), 0)
+	if res != time.Minute || len(points) != 2 || points[0].Count+points[1].Count != 12 {
+		t.Errorf("res=%v points=%+v, want 12 samples counted once", res, points)
+	}
+}
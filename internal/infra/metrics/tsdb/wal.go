@@ -0,0 +1,172 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package tsdb
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+)
+
+const (
+	walFile      = "wal.log"
+	walHeaderLen = 8       // payload length and CRC32-C
+	walMaxRecord = 1 << 24 // larger length means a torn header
+)
+
+// walRecord – flattened series of a single saved metric value.
+type walRecord struct {
+	t      int64 // unix nanoseconds
+	key    string
+	names  []string
+	values []float64
+}
+
+func (rec walRecord) encode(b []byte) []byte {
+	b = binary.AppendVarint(b, rec.t)
+	b = appendString(b, rec.key)
+	b = binary.AppendUvarint(b, uint64(len(rec.names)))
+	for i, name := range rec.names {
+		b = appendString(b, name)
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(rec.values[i]))
+	}
+	return b
+}
+
+func decodeWalRecord(b []byte) (rec walRecord, err error) {
+	d := &decoder{b: b}
+	rec.t = d.varint()
+	rec.key = d.string()
+
+	n := d.uvarint()
+	if n > uint64(len(d.b)) {
+		d.fail()
+	}
+
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		rec.names = append(rec.names, d.string())
+		rec.values = append(rec.values, d.float64())
+	}
+
+	return rec, d.err
+}
+
+/*
+wal – write-ahead log of the head samples.
+
+	Every record is framed by its length and checksum and flushed to
+	the OS on append, so only a crash of the host may lose the samples
+	written after the last sync. Replay stops at the first torn or
+	corrupted record and truncates the log there.
+*/
+type wal struct {
+	f    *os.File
+	w    *bufio.Writer
+	buf  []byte
+	size int64
+}
+
+// openWal – opens the log and replays its valid records.
+func openWal(path string, replay func(walRecord)) (w *wal, truncated int64, err error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o640)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	valid := int64(0)
+	for rest := b; len(rest) >= walHeaderLen; {
+		n := binary.LittleEndian.Uint32(rest)
+		sum := binary.LittleEndian.Uint32(rest[4:])
+
+		if n > walMaxRecord || uint64(len(rest)-walHeaderLen) < uint64(n) {
+			break
+		}
+
+		payload := rest[walHeaderLen : walHeaderLen+n]
+		if crc32.Checksum(payload, crcTable) != sum {
+			break
+		}
+
+		rec, err := decodeWalRecord(payload)
+		if err != nil {
+			break
+		}
+
+		replay(rec)
+		rest = rest[walHeaderLen+n:]
+		valid += int64(walHeaderLen + n)
+	}
+
+	if truncated = int64(len(b)) - valid; truncated > 0 {
+		if err := f.Truncate(valid); err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+	}
+
+	if _, err := f.Seek(valid, io.SeekStart); err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	return &wal{f: f, w: bufio.NewWriter(f), size: valid}, truncated, nil
+}
+
+func (w *wal) append(rec walRecord) error {
+	w.buf = rec.encode(w.buf[:0])
+
+	var header [walHeaderLen]byte
+	binary.LittleEndian.PutUint32(header[:], uint32(len(w.buf)))
+	binary.LittleEndian.PutUint32(header[4:], crc32.Checksum(w.buf, crcTable))
+
+	if _, err := w.w.Write(header[:]); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.buf); err != nil {
+		return err
+	}
+
+	w.size += int64(walHeaderLen + len(w.buf))
+	return w.w.Flush()
+}
+
+// sync – persists the appended records.
+func (w *wal) sync() error {
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// reset – drops all records after the head was flushed into a segment.
+func (w *wal) reset() error {
+	w.w.Reset(w.f)
+
+	if err := w.f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := w.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	w.size = 0
+	return w.f.Sync()
+}
+
+func (w *wal) close() error {
+	if err := w.sync(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
@@ -28,7 +28,7 @@ var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\rfstmon.common\x1a\tdto.proto2\xa3\x05\n" +
+	"\fcommon.proto\x12\rfstmon.common\x1a\tdto.proto2\xef\a\n" +
 	"\x12MachineInfoService\x12K\n" +
 	"\n" +
 	"GetCpuInfo\x12\x1d.fstmon.dto.GetCpuInfoRequest\x1a\x1e.fstmon.dto.CpuPackageResponse\x12Q\n" +
@@ -39,25 +39,44 @@ const file_common_proto_rawDesc = "" +
 	"\n" +
 	"GetThermal\x12\x1d.fstmon.dto.GetThermalRequest\x1a\x1b.fstmon.dto.ThermalResponse\x12Q\n" +
 	"\rGetPartitions\x12 .fstmon.dto.GetPartitionsRequest\x1a\x1e.fstmon.dto.PartitionsResponse\x12H\n" +
-	"\tGetDiskIO\x12\x1c.fstmon.dto.GetDiskIORequest\x1a\x1d.fstmon.dto.DiskIOMapResponseB>Z<github.com/eterline/fstmon/internal/interface/grpc/flugel/commonb\x06proto3"
+	"\tGetDiskIO\x12\x1c.fstmon.dto.GetDiskIORequest\x1a\x1d.fstmon.dto.DiskIOMapResponse\x12T\n" +
+	"\x0eGetSelfMetrics\x12!.fstmon.dto.GetSelfMetricsRequest\x1a\x1f.fstmon.dto.SelfMetricsResponse\x12N\n" +
+	"\fGetProcesses\x12\x1f.fstmon.dto.GetProcessesRequest\x1a\x1d.fstmon.dto.ProcessesResponse\x12K\n" +
+	"\vGetPressure\x12\x1e.fstmon.dto.GetPressureRequest\x1a\x1c.fstmon.dto.PressureResponse\x12W\n" +
+	"\x0fGetNetProtocols\x12\".fstmon.dto.GetNetProtocolsRequest\x1a .fstmon.dto.NetProtocolsResponse2\xcd\x01\n" +
+	"\x10CollectorService\x12W\n" +
+	"\x0eListCollectors\x12!.fstmon.dto.ListCollectorsRequest\x1a\".fstmon.dto.ListCollectorsResponse\x12`\n" +
+	"\x12GetCollectorMetric\x12%.fstmon.dto.GetCollectorMetricRequest\x1a#.fstmon.dto.CollectorMetricResponseBBZ@github.com/eterline/fstmon/internal/interface/grpc/flugel/commonb\x06proto3"
 
 var file_common_proto_goTypes = []any{
-	(*GetCpuInfoRequest)(nil),       // 0: fstmon.dto.GetCpuInfoRequest
-	(*GetCpuMetricsRequest)(nil),    // 1: fstmon.dto.GetCpuMetricsRequest
-	(*GetInterfacesIORequest)(nil),  // 2: fstmon.dto.GetInterfacesIORequest
-	(*GetSystemInfoRequest)(nil),    // 3: fstmon.dto.GetSystemInfoRequest
-	(*GetMemoryMetricsRequest)(nil), // 4: fstmon.dto.GetMemoryMetricsRequest
-	(*GetThermalRequest)(nil),       // 5: fstmon.dto.GetThermalRequest
-	(*GetPartitionsRequest)(nil),    // 6: fstmon.dto.GetPartitionsRequest
-	(*GetDiskIORequest)(nil),        // 7: fstmon.dto.GetDiskIORequest
-	(*CpuPackageResponse)(nil),      // 8: fstmon.dto.CpuPackageResponse
-	(*CpuMetricsResponse)(nil),      // 9: fstmon.dto.CpuMetricsResponse
-	(*InterfacesIOResponse)(nil),    // 10: fstmon.dto.InterfacesIOResponse
-	(*SystemInfoResponse)(nil),      // 11: fstmon.dto.SystemInfoResponse
-	(*MemoryMetricsResponse)(nil),   // 12: fstmon.dto.MemoryMetricsResponse
-	(*ThermalResponse)(nil),         // 13: fstmon.dto.ThermalResponse
-	(*PartitionsResponse)(nil),      // 14: fstmon.dto.PartitionsResponse
-	(*DiskIOMapResponse)(nil),       // 15: fstmon.dto.DiskIOMapResponse
+	(*GetCpuInfoRequest)(nil),         // 0: fstmon.dto.GetCpuInfoRequest
+	(*GetCpuMetricsRequest)(nil),      // 1: fstmon.dto.GetCpuMetricsRequest
+	(*GetInterfacesIORequest)(nil),    // 2: fstmon.dto.GetInterfacesIORequest
+	(*GetSystemInfoRequest)(nil),      // 3: fstmon.dto.GetSystemInfoRequest
+	(*GetMemoryMetricsRequest)(nil),   // 4: fstmon.dto.GetMemoryMetricsRequest
+	(*GetThermalRequest)(nil),         // 5: fstmon.dto.GetThermalRequest
+	(*GetPartitionsRequest)(nil),      // 6: fstmon.dto.GetPartitionsRequest
+	(*GetDiskIORequest)(nil),          // 7: fstmon.dto.GetDiskIORequest
+	(*GetSelfMetricsRequest)(nil),     // 8: fstmon.dto.GetSelfMetricsRequest
+	(*GetProcessesRequest)(nil),       // 9: fstmon.dto.GetProcessesRequest
+	(*GetPressureRequest)(nil),        // 10: fstmon.dto.GetPressureRequest
+	(*GetNetProtocolsRequest)(nil),    // 11: fstmon.dto.GetNetProtocolsRequest
+	(*ListCollectorsRequest)(nil),     // 12: fstmon.dto.ListCollectorsRequest
+	(*GetCollectorMetricRequest)(nil), // 13: fstmon.dto.GetCollectorMetricRequest
+	(*CpuPackageResponse)(nil),        // 14: fstmon.dto.CpuPackageResponse
+	(*CpuMetricsResponse)(nil),        // 15: fstmon.dto.CpuMetricsResponse
+	(*InterfacesIOResponse)(nil),      // 16: fstmon.dto.InterfacesIOResponse
+	(*SystemInfoResponse)(nil),        // 17: fstmon.dto.SystemInfoResponse
+	(*MemoryMetricsResponse)(nil),     // 18: fstmon.dto.MemoryMetricsResponse
+	(*ThermalResponse)(nil),           // 19: fstmon.dto.ThermalResponse
+	(*PartitionsResponse)(nil),        // 20: fstmon.dto.PartitionsResponse
+	(*DiskIOMapResponse)(nil),         // 21: fstmon.dto.DiskIOMapResponse
+	(*SelfMetricsResponse)(nil),       // 22: fstmon.dto.SelfMetricsResponse
+	(*ProcessesResponse)(nil),         // 23: fstmon.dto.ProcessesResponse
+	(*PressureResponse)(nil),          // 24: fstmon.dto.PressureResponse
+	(*NetProtocolsResponse)(nil),      // 25: fstmon.dto.NetProtocolsResponse
+	(*ListCollectorsResponse)(nil),    // 26: fstmon.dto.ListCollectorsResponse
+	(*CollectorMetricResponse)(nil),   // 27: fstmon.dto.CollectorMetricResponse
 }
 var file_common_proto_depIdxs = []int32{
 	0,  // 0: fstmon.common.MachineInfoService.GetCpuInfo:input_type -> fstmon.dto.GetCpuInfoRequest
@@ -68,16 +87,28 @@ var file_common_proto_depIdxs = []int32{
 	5,  // 5: fstmon.common.MachineInfoService.GetThermal:input_type -> fstmon.dto.GetThermalRequest
 	6,  // 6: fstmon.common.MachineInfoService.GetPartitions:input_type -> fstmon.dto.GetPartitionsRequest
 	7,  // 7: fstmon.common.MachineInfoService.GetDiskIO:input_type -> fstmon.dto.GetDiskIORequest
-	8,  // 8: fstmon.common.MachineInfoService.GetCpuInfo:output_type -> fstmon.dto.CpuPackageResponse
-	9,  // 9: fstmon.common.MachineInfoService.GetCpuMetrics:output_type -> fstmon.dto.CpuMetricsResponse
-	10, // 10: fstmon.common.MachineInfoService.GetInterfacesIO:output_type -> fstmon.dto.InterfacesIOResponse
-	11, // 11: fstmon.common.MachineInfoService.GetSystemInfo:output_type -> fstmon.dto.SystemInfoResponse
-	12, // 12: fstmon.common.MachineInfoService.GetMemoryMetrics:output_type -> fstmon.dto.MemoryMetricsResponse
-	13, // 13: fstmon.common.MachineInfoService.GetThermal:output_type -> fstmon.dto.ThermalResponse
-	14, // 14: fstmon.common.MachineInfoService.GetPartitions:output_type -> fstmon.dto.PartitionsResponse
-	15, // 15: fstmon.common.MachineInfoService.GetDiskIO:output_type -> fstmon.dto.DiskIOMapResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	8,  // 8: fstmon.common.MachineInfoService.GetSelfMetrics:input_type -> fstmon.dto.GetSelfMetricsRequest
+	9,  // 9: fstmon.common.MachineInfoService.GetProcesses:input_type -> fstmon.dto.GetProcessesRequest
+	10, // 10: fstmon.common.MachineInfoService.GetPressure:input_type -> fstmon.dto.GetPressureRequest
+	11, // 11: fstmon.common.MachineInfoService.GetNetProtocols:input_type -> fstmon.dto.GetNetProtocolsRequest
+	12, // 12: fstmon.common.CollectorService.ListCollectors:input_type -> fstmon.dto.ListCollectorsRequest
+	13, // 13: fstmon.common.CollectorService.GetCollectorMetric:input_type -> fstmon.dto.GetCollectorMetricRequest
+	14, // 14: fstmon.common.MachineInfoService.GetCpuInfo:output_type -> fstmon.dto.CpuPackageResponse
+	15, // 15: fstmon.common.MachineInfoService.GetCpuMetrics:output_type -> fstmon.dto.CpuMetricsResponse
+	16, // 16: fstmon.common.MachineInfoService.GetInterfacesIO:output_type -> fstmon.dto.InterfacesIOResponse
+	17, // 17: fstmon.common.MachineInfoService.GetSystemInfo:output_type -> fstmon.dto.SystemInfoResponse
+	18, // 18: fstmon.common.MachineInfoService.GetMemoryMetrics:output_type -> fstmon.dto.MemoryMetricsResponse
+	19, // 19: fstmon.common.MachineInfoService.GetThermal:output_type -> fstmon.dto.ThermalResponse
+	20, // 20: fstmon.common.MachineInfoService.GetPartitions:output_type -> fstmon.dto.PartitionsResponse
+	21, // 21: fstmon.common.MachineInfoService.GetDiskIO:output_type -> fstmon.dto.DiskIOMapResponse
+	22, // 22: fstmon.common.MachineInfoService.GetSelfMetrics:output_type -> fstmon.dto.SelfMetricsResponse
+	23, // 23: fstmon.common.MachineInfoService.GetProcesses:output_type -> fstmon.dto.ProcessesResponse
+	24, // 24: fstmon.common.MachineInfoService.GetPressure:output_type -> fstmon.dto.PressureResponse
+	25, // 25: fstmon.common.MachineInfoService.GetNetProtocols:output_type -> fstmon.dto.NetProtocolsResponse
+	26, // 26: fstmon.common.CollectorService.ListCollectors:output_type -> fstmon.dto.ListCollectorsResponse
+	27, // 27: fstmon.common.CollectorService.GetCollectorMetric:output_type -> fstmon.dto.CollectorMetricResponse
+	14, // [14:28] is the sub-list for method output_type
+	0,  // [0:14] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -97,7 +128,7 @@ func file_common_proto_init() {
 			NumEnums:      0,
 			NumMessages:   0,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_common_proto_goTypes,
 		DependencyIndexes: file_common_proto_depIdxs,
@@ -30,6 +30,10 @@ const (
 	MachineInfoService_GetThermal_FullMethodName       = "/fstmon.common.MachineInfoService/GetThermal"
 	MachineInfoService_GetPartitions_FullMethodName    = "/fstmon.common.MachineInfoService/GetPartitions"
 	MachineInfoService_GetDiskIO_FullMethodName        = "/fstmon.common.MachineInfoService/GetDiskIO"
+	MachineInfoService_GetSelfMetrics_FullMethodName   = "/fstmon.common.MachineInfoService/GetSelfMetrics"
+	MachineInfoService_GetProcesses_FullMethodName     = "/fstmon.common.MachineInfoService/GetProcesses"
+	MachineInfoService_GetPressure_FullMethodName      = "/fstmon.common.MachineInfoService/GetPressure"
+	MachineInfoService_GetNetProtocols_FullMethodName  = "/fstmon.common.MachineInfoService/GetNetProtocols"
 )
 
 // MachineInfoServiceClient is the client API for MachineInfoService service.
@@ -44,6 +48,10 @@ type MachineInfoServiceClient interface {
 	GetThermal(ctx context.Context, in *GetThermalRequest, opts ...grpc.CallOption) (*ThermalResponse, error)
 	GetPartitions(ctx context.Context, in *GetPartitionsRequest, opts ...grpc.CallOption) (*PartitionsResponse, error)
 	GetDiskIO(ctx context.Context, in *GetDiskIORequest, opts ...grpc.CallOption) (*DiskIOMapResponse, error)
+	GetSelfMetrics(ctx context.Context, in *GetSelfMetricsRequest, opts ...grpc.CallOption) (*SelfMetricsResponse, error)
+	GetProcesses(ctx context.Context, in *GetProcessesRequest, opts ...grpc.CallOption) (*ProcessesResponse, error)
+	GetPressure(ctx context.Context, in *GetPressureRequest, opts ...grpc.CallOption) (*PressureResponse, error)
+	GetNetProtocols(ctx context.Context, in *GetNetProtocolsRequest, opts ...grpc.CallOption) (*NetProtocolsResponse, error)
 }
 
 type machineInfoServiceClient struct {
@@ -134,6 +142,46 @@ func (c *machineInfoServiceClient) GetDiskIO(ctx context.Context, in *GetDiskIOR
 	return out, nil
 }
 
+func (c *machineInfoServiceClient) GetSelfMetrics(ctx context.Context, in *GetSelfMetricsRequest, opts ...grpc.CallOption) (*SelfMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelfMetricsResponse)
+	err := c.cc.Invoke(ctx, MachineInfoService_GetSelfMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineInfoServiceClient) GetProcesses(ctx context.Context, in *GetProcessesRequest, opts ...grpc.CallOption) (*ProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessesResponse)
+	err := c.cc.Invoke(ctx, MachineInfoService_GetProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineInfoServiceClient) GetPressure(ctx context.Context, in *GetPressureRequest, opts ...grpc.CallOption) (*PressureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PressureResponse)
+	err := c.cc.Invoke(ctx, MachineInfoService_GetPressure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineInfoServiceClient) GetNetProtocols(ctx context.Context, in *GetNetProtocolsRequest, opts ...grpc.CallOption) (*NetProtocolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetProtocolsResponse)
+	err := c.cc.Invoke(ctx, MachineInfoService_GetNetProtocols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineInfoServiceServer is the server API for MachineInfoService service.
 // All implementations must embed UnimplementedMachineInfoServiceServer
 // for forward compatibility.
@@ -146,6 +194,10 @@ type MachineInfoServiceServer interface {
 	GetThermal(context.Context, *GetThermalRequest) (*ThermalResponse, error)
 	GetPartitions(context.Context, *GetPartitionsRequest) (*PartitionsResponse, error)
 	GetDiskIO(context.Context, *GetDiskIORequest) (*DiskIOMapResponse, error)
+	GetSelfMetrics(context.Context, *GetSelfMetricsRequest) (*SelfMetricsResponse, error)
+	GetProcesses(context.Context, *GetProcessesRequest) (*ProcessesResponse, error)
+	GetPressure(context.Context, *GetPressureRequest) (*PressureResponse, error)
+	GetNetProtocols(context.Context, *GetNetProtocolsRequest) (*NetProtocolsResponse, error)
 	mustEmbedUnimplementedMachineInfoServiceServer()
 }
 
@@ -180,6 +232,18 @@ func (UnimplementedMachineInfoServiceServer) GetPartitions(context.Context, *Get
 func (UnimplementedMachineInfoServiceServer) GetDiskIO(context.Context, *GetDiskIORequest) (*DiskIOMapResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetDiskIO not implemented")
 }
+func (UnimplementedMachineInfoServiceServer) GetSelfMetrics(context.Context, *GetSelfMetricsRequest) (*SelfMetricsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSelfMetrics not implemented")
+}
+func (UnimplementedMachineInfoServiceServer) GetProcesses(context.Context, *GetProcessesRequest) (*ProcessesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProcesses not implemented")
+}
+func (UnimplementedMachineInfoServiceServer) GetPressure(context.Context, *GetPressureRequest) (*PressureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPressure not implemented")
+}
+func (UnimplementedMachineInfoServiceServer) GetNetProtocols(context.Context, *GetNetProtocolsRequest) (*NetProtocolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNetProtocols not implemented")
+}
 func (UnimplementedMachineInfoServiceServer) mustEmbedUnimplementedMachineInfoServiceServer() {}
 func (UnimplementedMachineInfoServiceServer) testEmbeddedByValue()                            {}
 
@@ -345,6 +409,78 @@ func _MachineInfoService_GetDiskIO_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineInfoService_GetSelfMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSelfMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineInfoServiceServer).GetSelfMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineInfoService_GetSelfMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineInfoServiceServer).GetSelfMetrics(ctx, req.(*GetSelfMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineInfoService_GetProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineInfoServiceServer).GetProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineInfoService_GetProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineInfoServiceServer).GetProcesses(ctx, req.(*GetProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineInfoService_GetPressure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPressureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineInfoServiceServer).GetPressure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineInfoService_GetPressure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineInfoServiceServer).GetPressure(ctx, req.(*GetPressureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineInfoService_GetNetProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetProtocolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineInfoServiceServer).GetNetProtocols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineInfoService_GetNetProtocols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineInfoServiceServer).GetNetProtocols(ctx, req.(*GetNetProtocolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineInfoService_ServiceDesc is the grpc.ServiceDesc for MachineInfoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -384,6 +520,162 @@ var MachineInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDiskIO",
 			Handler:    _MachineInfoService_GetDiskIO_Handler,
 		},
+		{
+			MethodName: "GetSelfMetrics",
+			Handler:    _MachineInfoService_GetSelfMetrics_Handler,
+		},
+		{
+			MethodName: "GetProcesses",
+			Handler:    _MachineInfoService_GetProcesses_Handler,
+		},
+		{
+			MethodName: "GetPressure",
+			Handler:    _MachineInfoService_GetPressure_Handler,
+		},
+		{
+			MethodName: "GetNetProtocols",
+			Handler:    _MachineInfoService_GetNetProtocols_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "common.proto",
+}
+
+const (
+	CollectorService_ListCollectors_FullMethodName     = "/fstmon.common.CollectorService/ListCollectors"
+	CollectorService_GetCollectorMetric_FullMethodName = "/fstmon.common.CollectorService/GetCollectorMetric"
+)
+
+// CollectorServiceClient is the client API for CollectorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CollectorServiceClient interface {
+	ListCollectors(ctx context.Context, in *ListCollectorsRequest, opts ...grpc.CallOption) (*ListCollectorsResponse, error)
+	GetCollectorMetric(ctx context.Context, in *GetCollectorMetricRequest, opts ...grpc.CallOption) (*CollectorMetricResponse, error)
+}
+
+type collectorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectorServiceClient(cc grpc.ClientConnInterface) CollectorServiceClient {
+	return &collectorServiceClient{cc}
+}
+
+func (c *collectorServiceClient) ListCollectors(ctx context.Context, in *ListCollectorsRequest, opts ...grpc.CallOption) (*ListCollectorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectorsResponse)
+	err := c.cc.Invoke(ctx, CollectorService_ListCollectors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorServiceClient) GetCollectorMetric(ctx context.Context, in *GetCollectorMetricRequest, opts ...grpc.CallOption) (*CollectorMetricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectorMetricResponse)
+	err := c.cc.Invoke(ctx, CollectorService_GetCollectorMetric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectorServiceServer is the server API for CollectorService service.
+// All implementations must embed UnimplementedCollectorServiceServer
+// for forward compatibility.
+type CollectorServiceServer interface {
+	ListCollectors(context.Context, *ListCollectorsRequest) (*ListCollectorsResponse, error)
+	GetCollectorMetric(context.Context, *GetCollectorMetricRequest) (*CollectorMetricResponse, error)
+	mustEmbedUnimplementedCollectorServiceServer()
+}
+
+// UnimplementedCollectorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCollectorServiceServer struct{}
+
+func (UnimplementedCollectorServiceServer) ListCollectors(context.Context, *ListCollectorsRequest) (*ListCollectorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectors not implemented")
+}
+func (UnimplementedCollectorServiceServer) GetCollectorMetric(context.Context, *GetCollectorMetricRequest) (*CollectorMetricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollectorMetric not implemented")
+}
+func (UnimplementedCollectorServiceServer) mustEmbedUnimplementedCollectorServiceServer() {}
+func (UnimplementedCollectorServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeCollectorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectorServiceServer will
+// result in compilation errors.
+type UnsafeCollectorServiceServer interface {
+	mustEmbedUnimplementedCollectorServiceServer()
+}
+
+func RegisterCollectorServiceServer(s grpc.ServiceRegistrar, srv CollectorServiceServer) {
+	// If the following call panics, it indicates UnimplementedCollectorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CollectorService_ServiceDesc, srv)
+}
+
+func _CollectorService_ListCollectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServiceServer).ListCollectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectorService_ListCollectors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServiceServer).ListCollectors(ctx, req.(*ListCollectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectorService_GetCollectorMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectorMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServiceServer).GetCollectorMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectorService_GetCollectorMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServiceServer).GetCollectorMetric(ctx, req.(*GetCollectorMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollectorService_ServiceDesc is the grpc.ServiceDesc for CollectorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollectorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fstmon.common.CollectorService",
+	HandlerType: (*CollectorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCollectors",
+			Handler:    _CollectorService_ListCollectors_Handler,
+		},
+		{
+			MethodName: "GetCollectorMetric",
+			Handler:    _CollectorService_GetCollectorMetric_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "common.proto",
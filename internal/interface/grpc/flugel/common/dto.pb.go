@@ -17,6 +17,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -209,6 +210,139 @@ func (x *IODuration) GetTx() *durationpb.Duration {
 	return nil
 }
 
+// Scrape health of a served metric
+type MetricStatus struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Stale               bool                   `protobuf:"varint,1,opt,name=stale,proto3" json:"stale,omitempty"`
+	LastUpdate          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	LastSuccess         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError           string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,6,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	RetryIn             *durationpb.Duration   `protobuf:"bytes,7,opt,name=retry_in,json=retryIn,proto3" json:"retry_in,omitempty"`
+	Timeouts            int32                  `protobuf:"varint,8,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	Panics              int32                  `protobuf:"varint,9,opt,name=panics,proto3" json:"panics,omitempty"`
+	Restored            bool                   `protobuf:"varint,10,opt,name=restored,proto3" json:"restored,omitempty"`
+	Freshness           string                 `protobuf:"bytes,11,opt,name=freshness,proto3" json:"freshness,omitempty"`
+	Age                 *durationpb.Duration   `protobuf:"bytes,12,opt,name=age,proto3" json:"age,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MetricStatus) Reset() {
+	*x = MetricStatus{}
+	mi := &file_dto_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricStatus) ProtoMessage() {}
+
+func (x *MetricStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricStatus.ProtoReflect.Descriptor instead.
+func (*MetricStatus) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MetricStatus) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *MetricStatus) GetLastUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return nil
+}
+
+func (x *MetricStatus) GetLastSuccess() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return nil
+}
+
+func (x *MetricStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *MetricStatus) GetLastErrorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return nil
+}
+
+func (x *MetricStatus) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *MetricStatus) GetRetryIn() *durationpb.Duration {
+	if x != nil {
+		return x.RetryIn
+	}
+	return nil
+}
+
+func (x *MetricStatus) GetTimeouts() int32 {
+	if x != nil {
+		return x.Timeouts
+	}
+	return 0
+}
+
+func (x *MetricStatus) GetPanics() int32 {
+	if x != nil {
+		return x.Panics
+	}
+	return 0
+}
+
+func (x *MetricStatus) GetRestored() bool {
+	if x != nil {
+		return x.Restored
+	}
+	return false
+}
+
+func (x *MetricStatus) GetFreshness() string {
+	if x != nil {
+		return x.Freshness
+	}
+	return ""
+}
+
+func (x *MetricStatus) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
 type CpuCoreInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PhysicalId    int32                  `protobuf:"varint,1,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
@@ -221,7 +355,7 @@ type CpuCoreInfo struct {
 
 func (x *CpuCoreInfo) Reset() {
 	*x = CpuCoreInfo{}
-	mi := &file_dto_proto_msgTypes[3]
+	mi := &file_dto_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +367,7 @@ func (x *CpuCoreInfo) String() string {
 func (*CpuCoreInfo) ProtoMessage() {}
 
 func (x *CpuCoreInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[3]
+	mi := &file_dto_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +380,7 @@ func (x *CpuCoreInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CpuCoreInfo.ProtoReflect.Descriptor instead.
 func (*CpuCoreInfo) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{3}
+	return file_dto_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CpuCoreInfo) GetPhysicalId() int32 {
@@ -290,7 +424,7 @@ type CpuPackage struct {
 
 func (x *CpuPackage) Reset() {
 	*x = CpuPackage{}
-	mi := &file_dto_proto_msgTypes[4]
+	mi := &file_dto_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +436,7 @@ func (x *CpuPackage) String() string {
 func (*CpuPackage) ProtoMessage() {}
 
 func (x *CpuPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[4]
+	mi := &file_dto_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +449,7 @@ func (x *CpuPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CpuPackage.ProtoReflect.Descriptor instead.
 func (*CpuPackage) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{4}
+	return file_dto_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CpuPackage) GetVendor() string {
@@ -353,29 +487,38 @@ func (x *CpuPackage) GetCores() []*CpuCoreInfo {
 	return nil
 }
 
-type CpuCoreMetrics struct {
+// Shares of CPU time by state in percent, user and nice exclude guest time
+type CpuTimes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Load          float64                `protobuf:"fixed64,1,opt,name=load,proto3" json:"load,omitempty"`
-	Frequency     float64                `protobuf:"fixed64,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	User          float64                `protobuf:"fixed64,1,opt,name=user,proto3" json:"user,omitempty"`
+	Nice          float64                `protobuf:"fixed64,2,opt,name=nice,proto3" json:"nice,omitempty"`
+	System        float64                `protobuf:"fixed64,3,opt,name=system,proto3" json:"system,omitempty"`
+	Idle          float64                `protobuf:"fixed64,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	Iowait        float64                `protobuf:"fixed64,5,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	Irq           float64                `protobuf:"fixed64,6,opt,name=irq,proto3" json:"irq,omitempty"`
+	Softirq       float64                `protobuf:"fixed64,7,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	Steal         float64                `protobuf:"fixed64,8,opt,name=steal,proto3" json:"steal,omitempty"`
+	Guest         float64                `protobuf:"fixed64,9,opt,name=guest,proto3" json:"guest,omitempty"`
+	GuestNice     float64                `protobuf:"fixed64,10,opt,name=guest_nice,json=guestNice,proto3" json:"guest_nice,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CpuCoreMetrics) Reset() {
-	*x = CpuCoreMetrics{}
-	mi := &file_dto_proto_msgTypes[5]
+func (x *CpuTimes) Reset() {
+	*x = CpuTimes{}
+	mi := &file_dto_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CpuCoreMetrics) String() string {
+func (x *CpuTimes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CpuCoreMetrics) ProtoMessage() {}
+func (*CpuTimes) ProtoMessage() {}
 
-func (x *CpuCoreMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[5]
+func (x *CpuTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,97 +529,104 @@ func (x *CpuCoreMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CpuCoreMetrics.ProtoReflect.Descriptor instead.
-func (*CpuCoreMetrics) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CpuTimes.ProtoReflect.Descriptor instead.
+func (*CpuTimes) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CpuCoreMetrics) GetLoad() float64 {
+func (x *CpuTimes) GetUser() float64 {
 	if x != nil {
-		return x.Load
+		return x.User
 	}
 	return 0
 }
 
-func (x *CpuCoreMetrics) GetFrequency() float64 {
+func (x *CpuTimes) GetNice() float64 {
 	if x != nil {
-		return x.Frequency
+		return x.Nice
 	}
 	return 0
 }
 
-type CpuMetrics struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Average       *CpuCoreMetrics        `protobuf:"bytes,1,opt,name=average,proto3" json:"average,omitempty"`
-	Cores         []*CpuCoreMetrics      `protobuf:"bytes,2,rep,name=cores,proto3" json:"cores,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CpuTimes) GetSystem() float64 {
+	if x != nil {
+		return x.System
+	}
+	return 0
 }
 
-func (x *CpuMetrics) Reset() {
-	*x = CpuMetrics{}
-	mi := &file_dto_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CpuTimes) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
 }
 
-func (x *CpuMetrics) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CpuTimes) GetIowait() float64 {
+	if x != nil {
+		return x.Iowait
+	}
+	return 0
 }
 
-func (*CpuMetrics) ProtoMessage() {}
+func (x *CpuTimes) GetIrq() float64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
 
-func (x *CpuMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[6]
+func (x *CpuTimes) GetSoftirq() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Softirq
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CpuMetrics.ProtoReflect.Descriptor instead.
-func (*CpuMetrics) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{6}
+func (x *CpuTimes) GetSteal() float64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
 }
 
-func (x *CpuMetrics) GetAverage() *CpuCoreMetrics {
+func (x *CpuTimes) GetGuest() float64 {
 	if x != nil {
-		return x.Average
+		return x.Guest
 	}
-	return nil
+	return 0
 }
 
-func (x *CpuMetrics) GetCores() []*CpuCoreMetrics {
+func (x *CpuTimes) GetGuestNice() float64 {
 	if x != nil {
-		return x.Cores
+		return x.GuestNice
 	}
-	return nil
+	return 0
 }
 
-type GetCpuInfoRequest struct {
+type CpuCoreMetrics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Load          float64                `protobuf:"fixed64,1,opt,name=load,proto3" json:"load,omitempty"`
+	Frequency     float64                `protobuf:"fixed64,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Times         *CpuTimes              `protobuf:"bytes,3,opt,name=times,proto3" json:"times,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCpuInfoRequest) Reset() {
-	*x = GetCpuInfoRequest{}
+func (x *CpuCoreMetrics) Reset() {
+	*x = CpuCoreMetrics{}
 	mi := &file_dto_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCpuInfoRequest) String() string {
+func (x *CpuCoreMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCpuInfoRequest) ProtoMessage() {}
+func (*CpuCoreMetrics) ProtoMessage() {}
 
-func (x *GetCpuInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *CpuCoreMetrics) ProtoReflect() protoreflect.Message {
 	mi := &file_dto_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -488,31 +638,60 @@ func (x *GetCpuInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCpuInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetCpuInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CpuCoreMetrics.ProtoReflect.Descriptor instead.
+func (*CpuCoreMetrics) Descriptor() ([]byte, []int) {
 	return file_dto_proto_rawDescGZIP(), []int{7}
 }
 
-type GetCpuMetricsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CpuCoreMetrics) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
 }
 
-func (x *GetCpuMetricsRequest) Reset() {
-	*x = GetCpuMetricsRequest{}
+func (x *CpuCoreMetrics) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *CpuCoreMetrics) GetTimes() *CpuTimes {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+type CpuActivity struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ContextSwitches       uint64                 `protobuf:"varint,1,opt,name=context_switches,json=contextSwitches,proto3" json:"context_switches,omitempty"`
+	ContextSwitchesPerSec uint64                 `protobuf:"varint,2,opt,name=context_switches_per_sec,json=contextSwitchesPerSec,proto3" json:"context_switches_per_sec,omitempty"`
+	Interrupts            uint64                 `protobuf:"varint,3,opt,name=interrupts,proto3" json:"interrupts,omitempty"`
+	InterruptsPerSec      uint64                 `protobuf:"varint,4,opt,name=interrupts_per_sec,json=interruptsPerSec,proto3" json:"interrupts_per_sec,omitempty"`
+	Forks                 uint64                 `protobuf:"varint,5,opt,name=forks,proto3" json:"forks,omitempty"`
+	ForksPerSec           uint64                 `protobuf:"varint,6,opt,name=forks_per_sec,json=forksPerSec,proto3" json:"forks_per_sec,omitempty"`
+	ProcsRunning          uint64                 `protobuf:"varint,7,opt,name=procs_running,json=procsRunning,proto3" json:"procs_running,omitempty"`
+	ProcsBlocked          uint64                 `protobuf:"varint,8,opt,name=procs_blocked,json=procsBlocked,proto3" json:"procs_blocked,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CpuActivity) Reset() {
+	*x = CpuActivity{}
 	mi := &file_dto_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCpuMetricsRequest) String() string {
+func (x *CpuActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCpuMetricsRequest) ProtoMessage() {}
+func (*CpuActivity) ProtoMessage() {}
 
-func (x *GetCpuMetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *CpuActivity) ProtoReflect() protoreflect.Message {
 	mi := &file_dto_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,126 +703,91 @@ func (x *GetCpuMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCpuMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetCpuMetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CpuActivity.ProtoReflect.Descriptor instead.
+func (*CpuActivity) Descriptor() ([]byte, []int) {
 	return file_dto_proto_rawDescGZIP(), []int{8}
 }
 
-type CpuPackageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cpu           *CpuPackage            `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CpuActivity) GetContextSwitches() uint64 {
+	if x != nil {
+		return x.ContextSwitches
+	}
+	return 0
 }
 
-func (x *CpuPackageResponse) Reset() {
-	*x = CpuPackageResponse{}
-	mi := &file_dto_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CpuActivity) GetContextSwitchesPerSec() uint64 {
+	if x != nil {
+		return x.ContextSwitchesPerSec
+	}
+	return 0
 }
 
-func (x *CpuPackageResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CpuActivity) GetInterrupts() uint64 {
+	if x != nil {
+		return x.Interrupts
+	}
+	return 0
 }
 
-func (*CpuPackageResponse) ProtoMessage() {}
-
-func (x *CpuPackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[9]
+func (x *CpuActivity) GetInterruptsPerSec() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InterruptsPerSec
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CpuPackageResponse.ProtoReflect.Descriptor instead.
-func (*CpuPackageResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{9}
+func (x *CpuActivity) GetForks() uint64 {
+	if x != nil {
+		return x.Forks
+	}
+	return 0
 }
 
-func (x *CpuPackageResponse) GetCpu() *CpuPackage {
+func (x *CpuActivity) GetForksPerSec() uint64 {
 	if x != nil {
-		return x.Cpu
+		return x.ForksPerSec
 	}
-	return nil
+	return 0
 }
 
-type CpuMetricsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       *CpuMetrics            `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CpuMetricsResponse) Reset() {
-	*x = CpuMetricsResponse{}
-	mi := &file_dto_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CpuMetricsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CpuMetricsResponse) ProtoMessage() {}
-
-func (x *CpuMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[10]
+func (x *CpuActivity) GetProcsRunning() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProcsRunning
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CpuMetricsResponse.ProtoReflect.Descriptor instead.
-func (*CpuMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{10}
+	return 0
 }
 
-func (x *CpuMetricsResponse) GetMetrics() *CpuMetrics {
+func (x *CpuActivity) GetProcsBlocked() uint64 {
 	if x != nil {
-		return x.Metrics
+		return x.ProcsBlocked
 	}
-	return nil
+	return 0
 }
 
-type InterfaceIO struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	BytesTotal        *IOUint64              `protobuf:"bytes,1,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
-	PacketsTotal      *IOUint64              `protobuf:"bytes,2,opt,name=packets_total,json=packetsTotal,proto3" json:"packets_total,omitempty"`
-	ErrorPacketsTotal *IOUint64              `protobuf:"bytes,3,opt,name=error_packets_total,json=errorPacketsTotal,proto3" json:"error_packets_total,omitempty"`
-	DropPacketsTotal  *IOUint64              `protobuf:"bytes,4,opt,name=drop_packets_total,json=dropPacketsTotal,proto3" json:"drop_packets_total,omitempty"`
-	BytesPerSec       *IOUint64              `protobuf:"bytes,5,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`
-	PacketsPerSec     *IOUint64              `protobuf:"bytes,6,opt,name=packets_per_sec,json=packetsPerSec,proto3" json:"packets_per_sec,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CpuMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Average       *CpuCoreMetrics        `protobuf:"bytes,1,opt,name=average,proto3" json:"average,omitempty"`
+	Cores         []*CpuCoreMetrics      `protobuf:"bytes,2,rep,name=cores,proto3" json:"cores,omitempty"`
+	Activity      *CpuActivity           `protobuf:"bytes,3,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceIO) Reset() {
-	*x = InterfaceIO{}
-	mi := &file_dto_proto_msgTypes[11]
+func (x *CpuMetrics) Reset() {
+	*x = CpuMetrics{}
+	mi := &file_dto_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceIO) String() string {
+func (x *CpuMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceIO) ProtoMessage() {}
+func (*CpuMetrics) ProtoMessage() {}
 
-func (x *InterfaceIO) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[11]
+func (x *CpuMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,75 +798,53 @@ func (x *InterfaceIO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceIO.ProtoReflect.Descriptor instead.
-func (*InterfaceIO) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *InterfaceIO) GetBytesTotal() *IOUint64 {
-	if x != nil {
-		return x.BytesTotal
-	}
-	return nil
-}
-
-func (x *InterfaceIO) GetPacketsTotal() *IOUint64 {
-	if x != nil {
-		return x.PacketsTotal
-	}
-	return nil
-}
-
-func (x *InterfaceIO) GetErrorPacketsTotal() *IOUint64 {
-	if x != nil {
-		return x.ErrorPacketsTotal
-	}
-	return nil
+// Deprecated: Use CpuMetrics.ProtoReflect.Descriptor instead.
+func (*CpuMetrics) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *InterfaceIO) GetDropPacketsTotal() *IOUint64 {
+func (x *CpuMetrics) GetAverage() *CpuCoreMetrics {
 	if x != nil {
-		return x.DropPacketsTotal
+		return x.Average
 	}
 	return nil
 }
 
-func (x *InterfaceIO) GetBytesPerSec() *IOUint64 {
+func (x *CpuMetrics) GetCores() []*CpuCoreMetrics {
 	if x != nil {
-		return x.BytesPerSec
+		return x.Cores
 	}
 	return nil
 }
 
-func (x *InterfaceIO) GetPacketsPerSec() *IOUint64 {
+func (x *CpuMetrics) GetActivity() *CpuActivity {
 	if x != nil {
-		return x.PacketsPerSec
+		return x.Activity
 	}
 	return nil
 }
 
-type InterfacesIO struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Interfaces    map[string]*InterfaceIO `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type GetCpuInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfacesIO) Reset() {
-	*x = InterfacesIO{}
-	mi := &file_dto_proto_msgTypes[12]
+func (x *GetCpuInfoRequest) Reset() {
+	*x = GetCpuInfoRequest{}
+	mi := &file_dto_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfacesIO) String() string {
+func (x *GetCpuInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfacesIO) ProtoMessage() {}
+func (*GetCpuInfoRequest) ProtoMessage() {}
 
-func (x *InterfacesIO) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[12]
+func (x *GetCpuInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,39 +855,32 @@ func (x *InterfacesIO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfacesIO.ProtoReflect.Descriptor instead.
-func (*InterfacesIO) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *InterfacesIO) GetInterfaces() map[string]*InterfaceIO {
-	if x != nil {
-		return x.Interfaces
-	}
-	return nil
+// Deprecated: Use GetCpuInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetCpuInfoRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{10}
 }
 
-type GetInterfacesIORequest struct {
+type GetCpuMetricsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInterfacesIORequest) Reset() {
-	*x = GetInterfacesIORequest{}
-	mi := &file_dto_proto_msgTypes[13]
+func (x *GetCpuMetricsRequest) Reset() {
+	*x = GetCpuMetricsRequest{}
+	mi := &file_dto_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInterfacesIORequest) String() string {
+func (x *GetCpuMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfacesIORequest) ProtoMessage() {}
+func (*GetCpuMetricsRequest) ProtoMessage() {}
 
-func (x *GetInterfacesIORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[13]
+func (x *GetCpuMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,33 +891,34 @@ func (x *GetInterfacesIORequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfacesIORequest.ProtoReflect.Descriptor instead.
-func (*GetInterfacesIORequest) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetCpuMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetCpuMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{11}
 }
 
-type InterfacesIOResponse struct {
+type CpuPackageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *InterfacesIO          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Cpu           *CpuPackage            `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfacesIOResponse) Reset() {
-	*x = InterfacesIOResponse{}
-	mi := &file_dto_proto_msgTypes[14]
+func (x *CpuPackageResponse) Reset() {
+	*x = CpuPackageResponse{}
+	mi := &file_dto_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfacesIOResponse) String() string {
+func (x *CpuPackageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfacesIOResponse) ProtoMessage() {}
+func (*CpuPackageResponse) ProtoMessage() {}
 
-func (x *InterfacesIOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[14]
+func (x *CpuPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,46 +929,48 @@ func (x *InterfacesIOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfacesIOResponse.ProtoReflect.Descriptor instead.
-func (*InterfacesIOResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CpuPackageResponse.ProtoReflect.Descriptor instead.
+func (*CpuPackageResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *InterfacesIOResponse) GetData() *InterfacesIO {
+func (x *CpuPackageResponse) GetCpu() *CpuPackage {
 	if x != nil {
-		return x.Data
+		return x.Cpu
 	}
 	return nil
 }
 
-type SystemInfo struct {
+func (x *CpuPackageResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CpuMetricsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uptime        *durationpb.Duration   `protobuf:"bytes,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	Idle          *durationpb.Duration   `protobuf:"bytes,2,opt,name=idle,proto3" json:"idle,omitempty"`
-	Load1         float64                `protobuf:"fixed64,3,opt,name=load1,proto3" json:"load1,omitempty"`
-	Load5         float64                `protobuf:"fixed64,4,opt,name=load5,proto3" json:"load5,omitempty"`
-	Load15        float64                `protobuf:"fixed64,5,opt,name=load15,proto3" json:"load15,omitempty"`
-	RunningProcs  int32                  `protobuf:"varint,6,opt,name=running_procs,json=runningProcs,proto3" json:"running_procs,omitempty"`
-	TotalProcs    int32                  `protobuf:"varint,7,opt,name=total_procs,json=totalProcs,proto3" json:"total_procs,omitempty"`
+	Metrics       *CpuMetrics            `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemInfo) Reset() {
-	*x = SystemInfo{}
-	mi := &file_dto_proto_msgTypes[15]
+func (x *CpuMetricsResponse) Reset() {
+	*x = CpuMetricsResponse{}
+	mi := &file_dto_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) String() string {
+func (x *CpuMetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInfo) ProtoMessage() {}
+func (*CpuMetricsResponse) ProtoMessage() {}
 
-func (x *SystemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[15]
+func (x *CpuMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,81 +981,54 @@ func (x *SystemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
-func (*SystemInfo) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CpuMetricsResponse.ProtoReflect.Descriptor instead.
+func (*CpuMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SystemInfo) GetUptime() *durationpb.Duration {
+func (x *CpuMetricsResponse) GetMetrics() *CpuMetrics {
 	if x != nil {
-		return x.Uptime
+		return x.Metrics
 	}
 	return nil
 }
 
-func (x *SystemInfo) GetIdle() *durationpb.Duration {
+func (x *CpuMetricsResponse) GetStatus() *MetricStatus {
 	if x != nil {
-		return x.Idle
+		return x.Status
 	}
 	return nil
 }
 
-func (x *SystemInfo) GetLoad1() float64 {
-	if x != nil {
-		return x.Load1
-	}
-	return 0
+type InterfaceIO struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BytesTotal         *IOUint64              `protobuf:"bytes,1,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	PacketsTotal       *IOUint64              `protobuf:"bytes,2,opt,name=packets_total,json=packetsTotal,proto3" json:"packets_total,omitempty"`
+	ErrorPacketsTotal  *IOUint64              `protobuf:"bytes,3,opt,name=error_packets_total,json=errorPacketsTotal,proto3" json:"error_packets_total,omitempty"`
+	DropPacketsTotal   *IOUint64              `protobuf:"bytes,4,opt,name=drop_packets_total,json=dropPacketsTotal,proto3" json:"drop_packets_total,omitempty"`
+	BytesPerSec        *IOUint64              `protobuf:"bytes,5,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`
+	PacketsPerSec      *IOUint64              `protobuf:"bytes,6,opt,name=packets_per_sec,json=packetsPerSec,proto3" json:"packets_per_sec,omitempty"`
+	Link               *InterfaceLink         `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	UtilizationPercent float64                `protobuf:"fixed64,8,opt,name=utilization_percent,json=utilizationPercent,proto3" json:"utilization_percent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SystemInfo) GetLoad5() float64 {
-	if x != nil {
-		return x.Load5
-	}
-	return 0
+func (x *InterfaceIO) Reset() {
+	*x = InterfaceIO{}
+	mi := &file_dto_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) GetLoad15() float64 {
-	if x != nil {
-		return x.Load15
-	}
-	return 0
+func (x *InterfaceIO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SystemInfo) GetRunningProcs() int32 {
-	if x != nil {
-		return x.RunningProcs
-	}
-	return 0
-}
+func (*InterfaceIO) ProtoMessage() {}
 
-func (x *SystemInfo) GetTotalProcs() int32 {
-	if x != nil {
-		return x.TotalProcs
-	}
-	return 0
-}
-
-type GetSystemInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetSystemInfoRequest) Reset() {
-	*x = GetSystemInfoRequest{}
-	mi := &file_dto_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetSystemInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetSystemInfoRequest) ProtoMessage() {}
-
-func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[16]
+func (x *InterfaceIO) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,86 +1039,98 @@ func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use InterfaceIO.ProtoReflect.Descriptor instead.
+func (*InterfaceIO) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{14}
 }
 
-type SystemInfoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InterfaceIO) GetBytesTotal() *IOUint64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return nil
 }
 
-func (x *SystemInfoResponse) Reset() {
-	*x = SystemInfoResponse{}
-	mi := &file_dto_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InterfaceIO) GetPacketsTotal() *IOUint64 {
+	if x != nil {
+		return x.PacketsTotal
+	}
+	return nil
 }
 
-func (x *SystemInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InterfaceIO) GetErrorPacketsTotal() *IOUint64 {
+	if x != nil {
+		return x.ErrorPacketsTotal
+	}
+	return nil
 }
 
-func (*SystemInfoResponse) ProtoMessage() {}
+func (x *InterfaceIO) GetDropPacketsTotal() *IOUint64 {
+	if x != nil {
+		return x.DropPacketsTotal
+	}
+	return nil
+}
 
-func (x *SystemInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[17]
+func (x *InterfaceIO) GetBytesPerSec() *IOUint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BytesPerSec
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SystemInfoResponse.ProtoReflect.Descriptor instead.
-func (*SystemInfoResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{17}
+func (x *InterfaceIO) GetPacketsPerSec() *IOUint64 {
+	if x != nil {
+		return x.PacketsPerSec
+	}
+	return nil
 }
 
-func (x *SystemInfoResponse) GetSystem() *SystemInfo {
+func (x *InterfaceIO) GetLink() *InterfaceLink {
 	if x != nil {
-		return x.System
+		return x.Link
 	}
 	return nil
 }
 
-type MemoryMetrics struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Total           uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Available       uint64                 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
-	Used            uint64                 `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
-	Free            uint64                 `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
-	SwapTotal       uint64                 `protobuf:"varint,5,opt,name=swap_total,json=swapTotal,proto3" json:"swap_total,omitempty"`
-	SwapAvailable   uint64                 `protobuf:"varint,6,opt,name=swap_available,json=swapAvailable,proto3" json:"swap_available,omitempty"`
-	SwapUsed        uint64                 `protobuf:"varint,7,opt,name=swap_used,json=swapUsed,proto3" json:"swap_used,omitempty"`
-	SwapFree        uint64                 `protobuf:"varint,8,opt,name=swap_free,json=swapFree,proto3" json:"swap_free,omitempty"`
-	UsedPercent     float64                `protobuf:"fixed64,9,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
-	SwapUsedPercent float64                `protobuf:"fixed64,10,opt,name=swap_used_percent,json=swapUsedPercent,proto3" json:"swap_used_percent,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *InterfaceIO) GetUtilizationPercent() float64 {
+	if x != nil {
+		return x.UtilizationPercent
+	}
+	return 0
 }
 
-func (x *MemoryMetrics) Reset() {
-	*x = MemoryMetrics{}
-	mi := &file_dto_proto_msgTypes[18]
+type InterfaceLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	OperState     string                 `protobuf:"bytes,2,opt,name=oper_state,json=operState,proto3" json:"oper_state,omitempty"`
+	Carrier       bool                   `protobuf:"varint,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	SpeedMbps     uint64                 `protobuf:"varint,4,opt,name=speed_mbps,json=speedMbps,proto3" json:"speed_mbps,omitempty"`
+	Duplex        string                 `protobuf:"bytes,5,opt,name=duplex,proto3" json:"duplex,omitempty"`
+	Mtu           uint64                 `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Mac           string                 `protobuf:"bytes,7,opt,name=mac,proto3" json:"mac,omitempty"`
+	Master        string                 `protobuf:"bytes,8,opt,name=master,proto3" json:"master,omitempty"`
+	Ipv4          []string               `protobuf:"bytes,9,rep,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6          []string               `protobuf:"bytes,10,rep,name=ipv6,proto3" json:"ipv6,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterfaceLink) Reset() {
+	*x = InterfaceLink{}
+	mi := &file_dto_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoryMetrics) String() string {
+func (x *InterfaceLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoryMetrics) ProtoMessage() {}
+func (*InterfaceLink) ProtoMessage() {}
 
-func (x *MemoryMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[18]
+func (x *InterfaceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,102 +1141,103 @@ func (x *MemoryMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoryMetrics.ProtoReflect.Descriptor instead.
-func (*MemoryMetrics) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use InterfaceLink.ProtoReflect.Descriptor instead.
+func (*InterfaceLink) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MemoryMetrics) GetTotal() uint64 {
+func (x *InterfaceLink) GetKind() string {
 	if x != nil {
-		return x.Total
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *MemoryMetrics) GetAvailable() uint64 {
+func (x *InterfaceLink) GetOperState() string {
 	if x != nil {
-		return x.Available
+		return x.OperState
 	}
-	return 0
+	return ""
 }
 
-func (x *MemoryMetrics) GetUsed() uint64 {
+func (x *InterfaceLink) GetCarrier() bool {
 	if x != nil {
-		return x.Used
+		return x.Carrier
 	}
-	return 0
+	return false
 }
 
-func (x *MemoryMetrics) GetFree() uint64 {
+func (x *InterfaceLink) GetSpeedMbps() uint64 {
 	if x != nil {
-		return x.Free
+		return x.SpeedMbps
 	}
 	return 0
 }
 
-func (x *MemoryMetrics) GetSwapTotal() uint64 {
+func (x *InterfaceLink) GetDuplex() string {
 	if x != nil {
-		return x.SwapTotal
+		return x.Duplex
 	}
-	return 0
+	return ""
 }
 
-func (x *MemoryMetrics) GetSwapAvailable() uint64 {
+func (x *InterfaceLink) GetMtu() uint64 {
 	if x != nil {
-		return x.SwapAvailable
+		return x.Mtu
 	}
 	return 0
 }
 
-func (x *MemoryMetrics) GetSwapUsed() uint64 {
+func (x *InterfaceLink) GetMac() string {
 	if x != nil {
-		return x.SwapUsed
+		return x.Mac
 	}
-	return 0
+	return ""
 }
 
-func (x *MemoryMetrics) GetSwapFree() uint64 {
+func (x *InterfaceLink) GetMaster() string {
 	if x != nil {
-		return x.SwapFree
+		return x.Master
 	}
-	return 0
+	return ""
 }
 
-func (x *MemoryMetrics) GetUsedPercent() float64 {
+func (x *InterfaceLink) GetIpv4() []string {
 	if x != nil {
-		return x.UsedPercent
+		return x.Ipv4
 	}
-	return 0
+	return nil
 }
 
-func (x *MemoryMetrics) GetSwapUsedPercent() float64 {
+func (x *InterfaceLink) GetIpv6() []string {
 	if x != nil {
-		return x.SwapUsedPercent
+		return x.Ipv6
 	}
-	return 0
+	return nil
 }
 
-type GetMemoryMetricsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type InterfacesIO struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Interfaces    map[string]*InterfaceIO `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoryMetricsRequest) Reset() {
-	*x = GetMemoryMetricsRequest{}
-	mi := &file_dto_proto_msgTypes[19]
+func (x *InterfacesIO) Reset() {
+	*x = InterfacesIO{}
+	mi := &file_dto_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoryMetricsRequest) String() string {
+func (x *InterfacesIO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoryMetricsRequest) ProtoMessage() {}
+func (*InterfacesIO) ProtoMessage() {}
 
-func (x *GetMemoryMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[19]
+func (x *InterfacesIO) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,33 +1248,39 @@ func (x *GetMemoryMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoryMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoryMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use InterfacesIO.ProtoReflect.Descriptor instead.
+func (*InterfacesIO) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{16}
 }
 
-type MemoryMetricsResponse struct {
+func (x *InterfacesIO) GetInterfaces() map[string]*InterfaceIO {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type GetInterfacesIORequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memory        *MemoryMetrics         `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoryMetricsResponse) Reset() {
-	*x = MemoryMetricsResponse{}
-	mi := &file_dto_proto_msgTypes[20]
+func (x *GetInterfacesIORequest) Reset() {
+	*x = GetInterfacesIORequest{}
+	mi := &file_dto_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoryMetricsResponse) String() string {
+func (x *GetInterfacesIORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoryMetricsResponse) ProtoMessage() {}
+func (*GetInterfacesIORequest) ProtoMessage() {}
 
-func (x *MemoryMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[20]
+func (x *GetInterfacesIORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,42 +1291,34 @@ func (x *MemoryMetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoryMetricsResponse.ProtoReflect.Descriptor instead.
-func (*MemoryMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *MemoryMetricsResponse) GetMemory() *MemoryMetrics {
-	if x != nil {
-		return x.Memory
-	}
-	return nil
+// Deprecated: Use GetInterfacesIORequest.ProtoReflect.Descriptor instead.
+func (*GetInterfacesIORequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{17}
 }
 
-type ThermalMetrics struct {
+type InterfacesIOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Current       float64                `protobuf:"fixed64,1,opt,name=current,proto3" json:"current,omitempty"`
-	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
-	Crit          float64                `protobuf:"fixed64,3,opt,name=crit,proto3" json:"crit,omitempty"`
+	Data          *InterfacesIO          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ThermalMetrics) Reset() {
-	*x = ThermalMetrics{}
-	mi := &file_dto_proto_msgTypes[21]
+func (x *InterfacesIOResponse) Reset() {
+	*x = InterfacesIOResponse{}
+	mi := &file_dto_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ThermalMetrics) String() string {
+func (x *InterfacesIOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThermalMetrics) ProtoMessage() {}
+func (*InterfacesIOResponse) ProtoMessage() {}
 
-func (x *ThermalMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[21]
+func (x *InterfacesIOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,54 +1329,53 @@ func (x *ThermalMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThermalMetrics.ProtoReflect.Descriptor instead.
-func (*ThermalMetrics) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use InterfacesIOResponse.ProtoReflect.Descriptor instead.
+func (*InterfacesIOResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ThermalMetrics) GetCurrent() float64 {
+func (x *InterfacesIOResponse) GetData() *InterfacesIO {
 	if x != nil {
-		return x.Current
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *ThermalMetrics) GetMax() float64 {
+func (x *InterfacesIOResponse) GetStatus() *MetricStatus {
 	if x != nil {
-		return x.Max
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-func (x *ThermalMetrics) GetCrit() float64 {
-	if x != nil {
-		return x.Crit
-	}
-	return 0
-}
-
-type ThermalMetricsMap struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Sensors       map[string]*ThermalMetrics `protobuf:"bytes,1,rep,name=sensors,proto3" json:"sensors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type SystemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uptime        *durationpb.Duration   `protobuf:"bytes,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Idle          *durationpb.Duration   `protobuf:"bytes,2,opt,name=idle,proto3" json:"idle,omitempty"`
+	Load1         float64                `protobuf:"fixed64,3,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5         float64                `protobuf:"fixed64,4,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15        float64                `protobuf:"fixed64,5,opt,name=load15,proto3" json:"load15,omitempty"`
+	RunningProcs  int32                  `protobuf:"varint,6,opt,name=running_procs,json=runningProcs,proto3" json:"running_procs,omitempty"`
+	TotalProcs    int32                  `protobuf:"varint,7,opt,name=total_procs,json=totalProcs,proto3" json:"total_procs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ThermalMetricsMap) Reset() {
-	*x = ThermalMetricsMap{}
-	mi := &file_dto_proto_msgTypes[22]
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_dto_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ThermalMetricsMap) String() string {
+func (x *SystemInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThermalMetricsMap) ProtoMessage() {}
+func (*SystemInfo) ProtoMessage() {}
 
-func (x *ThermalMetricsMap) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[22]
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,39 +1386,81 @@ func (x *ThermalMetricsMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThermalMetricsMap.ProtoReflect.Descriptor instead.
-func (*ThermalMetricsMap) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ThermalMetricsMap) GetSensors() map[string]*ThermalMetrics {
+func (x *SystemInfo) GetUptime() *durationpb.Duration {
 	if x != nil {
-		return x.Sensors
+		return x.Uptime
 	}
 	return nil
 }
 
-type GetThermalRequest struct {
+func (x *SystemInfo) GetIdle() *durationpb.Duration {
+	if x != nil {
+		return x.Idle
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetRunningProcs() int32 {
+	if x != nil {
+		return x.RunningProcs
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetTotalProcs() int32 {
+	if x != nil {
+		return x.TotalProcs
+	}
+	return 0
+}
+
+type GetSystemInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetThermalRequest) Reset() {
-	*x = GetThermalRequest{}
-	mi := &file_dto_proto_msgTypes[23]
+func (x *GetSystemInfoRequest) Reset() {
+	*x = GetSystemInfoRequest{}
+	mi := &file_dto_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetThermalRequest) String() string {
+func (x *GetSystemInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetThermalRequest) ProtoMessage() {}
+func (*GetSystemInfoRequest) ProtoMessage() {}
 
-func (x *GetThermalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[23]
+func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,33 +1471,34 @@ func (x *GetThermalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetThermalRequest.ProtoReflect.Descriptor instead.
-func (*GetThermalRequest) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{20}
 }
 
-type ThermalResponse struct {
+type SystemInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       *ThermalMetricsMap     `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ThermalResponse) Reset() {
-	*x = ThermalResponse{}
-	mi := &file_dto_proto_msgTypes[24]
+func (x *SystemInfoResponse) Reset() {
+	*x = SystemInfoResponse{}
+	mi := &file_dto_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ThermalResponse) String() string {
+func (x *SystemInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThermalResponse) ProtoMessage() {}
+func (*SystemInfoResponse) ProtoMessage() {}
 
-func (x *ThermalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[24]
+func (x *SystemInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,47 +1509,72 @@ func (x *ThermalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThermalResponse.ProtoReflect.Descriptor instead.
-func (*ThermalResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*SystemInfoResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ThermalResponse) GetMetrics() *ThermalMetricsMap {
+func (x *SystemInfoResponse) GetSystem() *SystemInfo {
 	if x != nil {
-		return x.Metrics
+		return x.System
 	}
 	return nil
 }
 
-type PartitionUsage struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Total             uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Used              uint64                 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
-	Free              uint64                 `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
-	UsedPercent       float64                `protobuf:"fixed64,4,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
-	InodesTotal       uint64                 `protobuf:"varint,5,opt,name=inodes_total,json=inodesTotal,proto3" json:"inodes_total,omitempty"`
-	InodesUsed        uint64                 `protobuf:"varint,6,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
-	InodesFree        uint64                 `protobuf:"varint,7,opt,name=inodes_free,json=inodesFree,proto3" json:"inodes_free,omitempty"`
-	InodesUsedPercent float64                `protobuf:"fixed64,8,opt,name=inodes_used_percent,json=inodesUsedPercent,proto3" json:"inodes_used_percent,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *SystemInfoResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-func (x *PartitionUsage) Reset() {
-	*x = PartitionUsage{}
-	mi := &file_dto_proto_msgTypes[25]
+type MemoryMetrics struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Total           uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Available       uint64                 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Used            uint64                 `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Free            uint64                 `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
+	SwapTotal       uint64                 `protobuf:"varint,5,opt,name=swap_total,json=swapTotal,proto3" json:"swap_total,omitempty"`
+	SwapAvailable   uint64                 `protobuf:"varint,6,opt,name=swap_available,json=swapAvailable,proto3" json:"swap_available,omitempty"`
+	SwapUsed        uint64                 `protobuf:"varint,7,opt,name=swap_used,json=swapUsed,proto3" json:"swap_used,omitempty"`
+	SwapFree        uint64                 `protobuf:"varint,8,opt,name=swap_free,json=swapFree,proto3" json:"swap_free,omitempty"`
+	UsedPercent     float64                `protobuf:"fixed64,9,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+	SwapUsedPercent float64                `protobuf:"fixed64,10,opt,name=swap_used_percent,json=swapUsedPercent,proto3" json:"swap_used_percent,omitempty"`
+	Buffers         uint64                 `protobuf:"varint,11,opt,name=buffers,proto3" json:"buffers,omitempty"`
+	Cached          uint64                 `protobuf:"varint,12,opt,name=cached,proto3" json:"cached,omitempty"`
+	SwapCached      uint64                 `protobuf:"varint,13,opt,name=swap_cached,json=swapCached,proto3" json:"swap_cached,omitempty"`
+	Shmem           uint64                 `protobuf:"varint,14,opt,name=shmem,proto3" json:"shmem,omitempty"`
+	Slab            uint64                 `protobuf:"varint,15,opt,name=slab,proto3" json:"slab,omitempty"`
+	Sreclaimable    uint64                 `protobuf:"varint,16,opt,name=sreclaimable,proto3" json:"sreclaimable,omitempty"`
+	Sunreclaim      uint64                 `protobuf:"varint,17,opt,name=sunreclaim,proto3" json:"sunreclaim,omitempty"`
+	Dirty           uint64                 `protobuf:"varint,18,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	Writeback       uint64                 `protobuf:"varint,19,opt,name=writeback,proto3" json:"writeback,omitempty"`
+	CommittedAs     uint64                 `protobuf:"varint,20,opt,name=committed_as,json=committedAs,proto3" json:"committed_as,omitempty"`
+	CommitLimit     uint64                 `protobuf:"varint,21,opt,name=commit_limit,json=commitLimit,proto3" json:"commit_limit,omitempty"`
+	HugePages       *HugePages             `protobuf:"bytes,22,opt,name=huge_pages,json=hugePages,proto3" json:"huge_pages,omitempty"`
+	Zswap           uint64                 `protobuf:"varint,23,opt,name=zswap,proto3" json:"zswap,omitempty"`
+	Zswapped        uint64                 `protobuf:"varint,24,opt,name=zswapped,proto3" json:"zswapped,omitempty"`
+	Zram            *Zram                  `protobuf:"bytes,25,opt,name=zram,proto3" json:"zram,omitempty"`
+	Activity        *MemoryActivity        `protobuf:"bytes,26,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MemoryMetrics) Reset() {
+	*x = MemoryMetrics{}
+	mi := &file_dto_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartitionUsage) String() string {
+func (x *MemoryMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionUsage) ProtoMessage() {}
+func (*MemoryMetrics) ProtoMessage() {}
 
-func (x *PartitionUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[25]
+func (x *MemoryMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,93 +1585,2776 @@ func (x *PartitionUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionUsage.ProtoReflect.Descriptor instead.
-func (*PartitionUsage) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MemoryMetrics.ProtoReflect.Descriptor instead.
+func (*MemoryMetrics) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *PartitionUsage) GetTotal() uint64 {
+func (x *MemoryMetrics) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetUsed() uint64 {
+func (x *MemoryMetrics) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetUsed() uint64 {
 	if x != nil {
 		return x.Used
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetFree() uint64 {
+func (x *MemoryMetrics) GetFree() uint64 {
 	if x != nil {
 		return x.Free
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetUsedPercent() float64 {
+func (x *MemoryMetrics) GetSwapTotal() uint64 {
+	if x != nil {
+		return x.SwapTotal
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSwapAvailable() uint64 {
+	if x != nil {
+		return x.SwapAvailable
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSwapUsed() uint64 {
+	if x != nil {
+		return x.SwapUsed
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSwapFree() uint64 {
+	if x != nil {
+		return x.SwapFree
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetUsedPercent() float64 {
 	if x != nil {
 		return x.UsedPercent
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetInodesTotal() uint64 {
+func (x *MemoryMetrics) GetSwapUsedPercent() float64 {
 	if x != nil {
-		return x.InodesTotal
+		return x.SwapUsedPercent
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetInodesUsed() uint64 {
+func (x *MemoryMetrics) GetBuffers() uint64 {
 	if x != nil {
-		return x.InodesUsed
+		return x.Buffers
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetInodesFree() uint64 {
+func (x *MemoryMetrics) GetCached() uint64 {
 	if x != nil {
-		return x.InodesFree
+		return x.Cached
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSwapCached() uint64 {
+	if x != nil {
+		return x.SwapCached
 	}
 	return 0
 }
 
-func (x *PartitionUsage) GetInodesUsedPercent() float64 {
+func (x *MemoryMetrics) GetShmem() uint64 {
+	if x != nil {
+		return x.Shmem
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSlab() uint64 {
+	if x != nil {
+		return x.Slab
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSreclaimable() uint64 {
+	if x != nil {
+		return x.Sreclaimable
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetSunreclaim() uint64 {
+	if x != nil {
+		return x.Sunreclaim
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetDirty() uint64 {
+	if x != nil {
+		return x.Dirty
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetWriteback() uint64 {
+	if x != nil {
+		return x.Writeback
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetCommittedAs() uint64 {
+	if x != nil {
+		return x.CommittedAs
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetCommitLimit() uint64 {
+	if x != nil {
+		return x.CommitLimit
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetHugePages() *HugePages {
+	if x != nil {
+		return x.HugePages
+	}
+	return nil
+}
+
+func (x *MemoryMetrics) GetZswap() uint64 {
+	if x != nil {
+		return x.Zswap
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetZswapped() uint64 {
+	if x != nil {
+		return x.Zswapped
+	}
+	return 0
+}
+
+func (x *MemoryMetrics) GetZram() *Zram {
+	if x != nil {
+		return x.Zram
+	}
+	return nil
+}
+
+func (x *MemoryMetrics) GetActivity() *MemoryActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+type HugePages struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Free          uint64                 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Reserved      uint64                 `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Surplus       uint64                 `protobuf:"varint,4,opt,name=surplus,proto3" json:"surplus,omitempty"`
+	PageSize      uint64                 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HugePages) Reset() {
+	*x = HugePages{}
+	mi := &file_dto_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HugePages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HugePages) ProtoMessage() {}
+
+func (x *HugePages) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HugePages.ProtoReflect.Descriptor instead.
+func (*HugePages) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HugePages) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HugePages) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *HugePages) GetReserved() uint64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *HugePages) GetSurplus() uint64 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
+func (x *HugePages) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Zram struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       int32                  `protobuf:"varint,1,opt,name=devices,proto3" json:"devices,omitempty"`
+	OrigData      uint64                 `protobuf:"varint,2,opt,name=orig_data,json=origData,proto3" json:"orig_data,omitempty"`
+	Compressed    uint64                 `protobuf:"varint,3,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	MemUsed       uint64                 `protobuf:"varint,4,opt,name=mem_used,json=memUsed,proto3" json:"mem_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Zram) Reset() {
+	*x = Zram{}
+	mi := &file_dto_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zram) ProtoMessage() {}
+
+func (x *Zram) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zram.ProtoReflect.Descriptor instead.
+func (*Zram) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Zram) GetDevices() int32 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *Zram) GetOrigData() uint64 {
+	if x != nil {
+		return x.OrigData
+	}
+	return 0
+}
+
+func (x *Zram) GetCompressed() uint64 {
+	if x != nil {
+		return x.Compressed
+	}
+	return 0
+}
+
+func (x *Zram) GetMemUsed() uint64 {
+	if x != nil {
+		return x.MemUsed
+	}
+	return 0
+}
+
+// Paging rates per second, page in/out in KiB
+type MemoryActivity struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageFaultsPerSec  uint64                 `protobuf:"varint,1,opt,name=page_faults_per_sec,json=pageFaultsPerSec,proto3" json:"page_faults_per_sec,omitempty"`
+	MajorFaultsPerSec uint64                 `protobuf:"varint,2,opt,name=major_faults_per_sec,json=majorFaultsPerSec,proto3" json:"major_faults_per_sec,omitempty"`
+	SwapInPerSec      uint64                 `protobuf:"varint,3,opt,name=swap_in_per_sec,json=swapInPerSec,proto3" json:"swap_in_per_sec,omitempty"`
+	SwapOutPerSec     uint64                 `protobuf:"varint,4,opt,name=swap_out_per_sec,json=swapOutPerSec,proto3" json:"swap_out_per_sec,omitempty"`
+	PageInPerSec      uint64                 `protobuf:"varint,5,opt,name=page_in_per_sec,json=pageInPerSec,proto3" json:"page_in_per_sec,omitempty"`
+	PageOutPerSec     uint64                 `protobuf:"varint,6,opt,name=page_out_per_sec,json=pageOutPerSec,proto3" json:"page_out_per_sec,omitempty"`
+	OomKills          uint64                 `protobuf:"varint,7,opt,name=oom_kills,json=oomKills,proto3" json:"oom_kills,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MemoryActivity) Reset() {
+	*x = MemoryActivity{}
+	mi := &file_dto_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryActivity) ProtoMessage() {}
+
+func (x *MemoryActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryActivity.ProtoReflect.Descriptor instead.
+func (*MemoryActivity) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MemoryActivity) GetPageFaultsPerSec() uint64 {
+	if x != nil {
+		return x.PageFaultsPerSec
+	}
+	return 0
+}
+
+func (x *MemoryActivity) GetMajorFaultsPerSec() uint64 {
+	if x != nil {
+		return x.MajorFaultsPerSec
+	}
+	return 0
+}
+
+func (x *MemoryActivity) GetSwapInPerSec() uint64 {
+	if x != nil {
+		return x.SwapInPerSec
+	}
+	return 0
+}
+
+func (x *MemoryActivity) GetSwapOutPerSec() uint64 {
+	if x != nil {
+		return x.SwapOutPerSec
+	}
+	return 0
+}
+
+func (x *MemoryActivity) GetPageInPerSec() uint64 {
+	if x != nil {
+		return x.PageInPerSec
+	}
+	return 0
+}
+
+func (x *MemoryActivity) GetPageOutPerSec() uint64 {
+	if x != nil {
+		return x.PageOutPerSec
+	}
+	return 0
+}
+
+func (x *MemoryActivity) GetOomKills() uint64 {
+	if x != nil {
+		return x.OomKills
+	}
+	return 0
+}
+
+type GetMemoryMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoryMetricsRequest) Reset() {
+	*x = GetMemoryMetricsRequest{}
+	mi := &file_dto_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoryMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoryMetricsRequest) ProtoMessage() {}
+
+func (x *GetMemoryMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoryMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoryMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{26}
+}
+
+type MemoryMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        *MemoryMetrics         `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryMetricsResponse) Reset() {
+	*x = MemoryMetricsResponse{}
+	mi := &file_dto_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryMetricsResponse) ProtoMessage() {}
+
+func (x *MemoryMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryMetricsResponse.ProtoReflect.Descriptor instead.
+func (*MemoryMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MemoryMetricsResponse) GetMemory() *MemoryMetrics {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *MemoryMetricsResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ThermalMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       float64                `protobuf:"fixed64,1,opt,name=current,proto3" json:"current,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Crit          float64                `protobuf:"fixed64,3,opt,name=crit,proto3" json:"crit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThermalMetrics) Reset() {
+	*x = ThermalMetrics{}
+	mi := &file_dto_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThermalMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThermalMetrics) ProtoMessage() {}
+
+func (x *ThermalMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThermalMetrics.ProtoReflect.Descriptor instead.
+func (*ThermalMetrics) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ThermalMetrics) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ThermalMetrics) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ThermalMetrics) GetCrit() float64 {
+	if x != nil {
+		return x.Crit
+	}
+	return 0
+}
+
+type ThermalMetricsMap struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Sensors       map[string]*ThermalMetrics `protobuf:"bytes,1,rep,name=sensors,proto3" json:"sensors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThermalMetricsMap) Reset() {
+	*x = ThermalMetricsMap{}
+	mi := &file_dto_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThermalMetricsMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThermalMetricsMap) ProtoMessage() {}
+
+func (x *ThermalMetricsMap) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThermalMetricsMap.ProtoReflect.Descriptor instead.
+func (*ThermalMetricsMap) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ThermalMetricsMap) GetSensors() map[string]*ThermalMetrics {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+type GetThermalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThermalRequest) Reset() {
+	*x = GetThermalRequest{}
+	mi := &file_dto_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThermalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThermalRequest) ProtoMessage() {}
+
+func (x *GetThermalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThermalRequest.ProtoReflect.Descriptor instead.
+func (*GetThermalRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{30}
+}
+
+type ThermalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *ThermalMetricsMap     `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThermalResponse) Reset() {
+	*x = ThermalResponse{}
+	mi := &file_dto_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThermalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThermalResponse) ProtoMessage() {}
+
+func (x *ThermalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThermalResponse.ProtoReflect.Descriptor instead.
+func (*ThermalResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ThermalResponse) GetMetrics() *ThermalMetricsMap {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *ThermalResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PartitionUsage struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Total             uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used              uint64                 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Free              uint64                 `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	UsedPercent       float64                `protobuf:"fixed64,4,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+	InodesTotal       uint64                 `protobuf:"varint,5,opt,name=inodes_total,json=inodesTotal,proto3" json:"inodes_total,omitempty"`
+	InodesUsed        uint64                 `protobuf:"varint,6,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
+	InodesFree        uint64                 `protobuf:"varint,7,opt,name=inodes_free,json=inodesFree,proto3" json:"inodes_free,omitempty"`
+	InodesUsedPercent float64                `protobuf:"fixed64,8,opt,name=inodes_used_percent,json=inodesUsedPercent,proto3" json:"inodes_used_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PartitionUsage) Reset() {
+	*x = PartitionUsage{}
+	mi := &file_dto_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartitionUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionUsage) ProtoMessage() {}
+
+func (x *PartitionUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionUsage.ProtoReflect.Descriptor instead.
+func (*PartitionUsage) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PartitionUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetInodesTotal() uint64 {
+	if x != nil {
+		return x.InodesTotal
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetInodesUsed() uint64 {
+	if x != nil {
+		return x.InodesUsed
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetInodesFree() uint64 {
+	if x != nil {
+		return x.InodesFree
+	}
+	return 0
+}
+
+func (x *PartitionUsage) GetInodesUsedPercent() float64 {
+	if x != nil {
+		return x.InodesUsedPercent
+	}
+	return 0
+}
+
+type Partition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Mount         string                 `protobuf:"bytes,2,opt,name=mount,proto3" json:"mount,omitempty"`
+	Filesystem    string                 `protobuf:"bytes,3,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	Usage         *PartitionUsage        `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Partition) Reset() {
+	*x = Partition{}
+	mi := &file_dto_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Partition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Partition) ProtoMessage() {}
+
+func (x *Partition) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Partition.ProtoReflect.Descriptor instead.
+func (*Partition) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Partition) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Partition) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *Partition) GetFilesystem() string {
+	if x != nil {
+		return x.Filesystem
+	}
+	return ""
+}
+
+func (x *Partition) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Partition) GetUsage() *PartitionUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type Partitions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partitions    []*Partition           `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Partitions) Reset() {
+	*x = Partitions{}
+	mi := &file_dto_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Partitions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Partitions) ProtoMessage() {}
+
+func (x *Partitions) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Partitions.ProtoReflect.Descriptor instead.
+func (*Partitions) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Partitions) GetPartitions() []*Partition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type DiskIO struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IopsInProgress  uint64                 `protobuf:"varint,1,opt,name=iops_in_progress,json=iopsInProgress,proto3" json:"iops_in_progress,omitempty"`
+	Ops             *IOUint64              `protobuf:"bytes,2,opt,name=ops,proto3" json:"ops,omitempty"`
+	MergedOps       *IOUint64              `protobuf:"bytes,3,opt,name=merged_ops,json=mergedOps,proto3" json:"merged_ops,omitempty"`
+	Bytes           *IOUint64              `protobuf:"bytes,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	OpsPerSec       *IOUint64              `protobuf:"bytes,5,opt,name=ops_per_sec,json=opsPerSec,proto3" json:"ops_per_sec,omitempty"`
+	MergedOpsPerSec *IOUint64              `protobuf:"bytes,6,opt,name=merged_ops_per_sec,json=mergedOpsPerSec,proto3" json:"merged_ops_per_sec,omitempty"`
+	BytesPerSec     *IOUint64              `protobuf:"bytes,7,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`
+	Time            *IODuration            `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+	IoTime          *durationpb.Duration   `protobuf:"bytes,9,opt,name=io_time,json=ioTime,proto3" json:"io_time,omitempty"`
+	WeightedIo      *durationpb.Duration   `protobuf:"bytes,10,opt,name=weighted_io,json=weightedIo,proto3" json:"weighted_io,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiskIO) Reset() {
+	*x = DiskIO{}
+	mi := &file_dto_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskIO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIO) ProtoMessage() {}
+
+func (x *DiskIO) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIO.ProtoReflect.Descriptor instead.
+func (*DiskIO) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DiskIO) GetIopsInProgress() uint64 {
+	if x != nil {
+		return x.IopsInProgress
+	}
+	return 0
+}
+
+func (x *DiskIO) GetOps() *IOUint64 {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *DiskIO) GetMergedOps() *IOUint64 {
+	if x != nil {
+		return x.MergedOps
+	}
+	return nil
+}
+
+func (x *DiskIO) GetBytes() *IOUint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *DiskIO) GetOpsPerSec() *IOUint64 {
+	if x != nil {
+		return x.OpsPerSec
+	}
+	return nil
+}
+
+func (x *DiskIO) GetMergedOpsPerSec() *IOUint64 {
+	if x != nil {
+		return x.MergedOpsPerSec
+	}
+	return nil
+}
+
+func (x *DiskIO) GetBytesPerSec() *IOUint64 {
+	if x != nil {
+		return x.BytesPerSec
+	}
+	return nil
+}
+
+func (x *DiskIO) GetTime() *IODuration {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *DiskIO) GetIoTime() *durationpb.Duration {
+	if x != nil {
+		return x.IoTime
+	}
+	return nil
+}
+
+func (x *DiskIO) GetWeightedIo() *durationpb.Duration {
+	if x != nil {
+		return x.WeightedIo
+	}
+	return nil
+}
+
+type DiskIOMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disks         map[string]*DiskIO     `protobuf:"bytes,1,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskIOMap) Reset() {
+	*x = DiskIOMap{}
+	mi := &file_dto_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskIOMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIOMap) ProtoMessage() {}
+
+func (x *DiskIOMap) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIOMap.ProtoReflect.Descriptor instead.
+func (*DiskIOMap) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiskIOMap) GetDisks() map[string]*DiskIO {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+type GetPartitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartitionsRequest) Reset() {
+	*x = GetPartitionsRequest{}
+	mi := &file_dto_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartitionsRequest) ProtoMessage() {}
+
+func (x *GetPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{37}
+}
+
+type GetDiskIORequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiskIORequest) Reset() {
+	*x = GetDiskIORequest{}
+	mi := &file_dto_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiskIORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskIORequest) ProtoMessage() {}
+
+func (x *GetDiskIORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskIORequest.ProtoReflect.Descriptor instead.
+func (*GetDiskIORequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{38}
+}
+
+type PartitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partitions    *Partitions            `protobuf:"bytes,1,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartitionsResponse) Reset() {
+	*x = PartitionsResponse{}
+	mi := &file_dto_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionsResponse) ProtoMessage() {}
+
+func (x *PartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionsResponse.ProtoReflect.Descriptor instead.
+func (*PartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PartitionsResponse) GetPartitions() *Partitions {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *PartitionsResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DiskIOMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Io            *DiskIOMap             `protobuf:"bytes,1,opt,name=io,proto3" json:"io,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskIOMapResponse) Reset() {
+	*x = DiskIOMapResponse{}
+	mi := &file_dto_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskIOMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIOMapResponse) ProtoMessage() {}
+
+func (x *DiskIOMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIOMapResponse.ProtoReflect.Descriptor instead.
+func (*DiskIOMapResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DiskIOMapResponse) GetIo() *DiskIOMap {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+func (x *DiskIOMapResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type WorkerSelfStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	LastDuration  *durationpb.Duration   `protobuf:"bytes,2,opt,name=last_duration,json=lastDuration,proto3" json:"last_duration,omitempty"`
+	AvgDuration   *durationpb.Duration   `protobuf:"bytes,3,opt,name=avg_duration,json=avgDuration,proto3" json:"avg_duration,omitempty"`
+	MaxDuration   *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	Successes     int32                  `protobuf:"varint,5,opt,name=successes,proto3" json:"successes,omitempty"`
+	Errors        int32                  `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+	Timeouts      int32                  `protobuf:"varint,7,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	Panics        int32                  `protobuf:"varint,8,opt,name=panics,proto3" json:"panics,omitempty"`
+	Skipped       int32                  `protobuf:"varint,9,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Overlaps      int32                  `protobuf:"varint,10,opt,name=overlaps,proto3" json:"overlaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerSelfStats) Reset() {
+	*x = WorkerSelfStats{}
+	mi := &file_dto_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerSelfStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerSelfStats) ProtoMessage() {}
+
+func (x *WorkerSelfStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerSelfStats.ProtoReflect.Descriptor instead.
+func (*WorkerSelfStats) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WorkerSelfStats) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorkerSelfStats) GetLastDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LastDuration
+	}
+	return nil
+}
+
+func (x *WorkerSelfStats) GetAvgDuration() *durationpb.Duration {
+	if x != nil {
+		return x.AvgDuration
+	}
+	return nil
+}
+
+func (x *WorkerSelfStats) GetMaxDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return nil
+}
+
+func (x *WorkerSelfStats) GetSuccesses() int32 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *WorkerSelfStats) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *WorkerSelfStats) GetTimeouts() int32 {
+	if x != nil {
+		return x.Timeouts
+	}
+	return 0
+}
+
+func (x *WorkerSelfStats) GetPanics() int32 {
+	if x != nil {
+		return x.Panics
+	}
+	return 0
+}
+
+func (x *WorkerSelfStats) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *WorkerSelfStats) GetOverlaps() int32 {
+	if x != nil {
+		return x.Overlaps
+	}
+	return 0
+}
+
+type RuntimeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goroutines    int32                  `protobuf:"varint,1,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	HeapAlloc     uint64                 `protobuf:"varint,2,opt,name=heap_alloc,json=heapAlloc,proto3" json:"heap_alloc,omitempty"`
+	HeapInuse     uint64                 `protobuf:"varint,3,opt,name=heap_inuse,json=heapInuse,proto3" json:"heap_inuse,omitempty"`
+	HeapSys       uint64                 `protobuf:"varint,4,opt,name=heap_sys,json=heapSys,proto3" json:"heap_sys,omitempty"`
+	HeapObjects   uint64                 `protobuf:"varint,5,opt,name=heap_objects,json=heapObjects,proto3" json:"heap_objects,omitempty"`
+	NextGc        uint64                 `protobuf:"varint,6,opt,name=next_gc,json=nextGc,proto3" json:"next_gc,omitempty"`
+	NumGc         uint32                 `protobuf:"varint,7,opt,name=num_gc,json=numGc,proto3" json:"num_gc,omitempty"`
+	GcPauseTotal  *durationpb.Duration   `protobuf:"bytes,8,opt,name=gc_pause_total,json=gcPauseTotal,proto3" json:"gc_pause_total,omitempty"`
+	GcPauseLast   *durationpb.Duration   `protobuf:"bytes,9,opt,name=gc_pause_last,json=gcPauseLast,proto3" json:"gc_pause_last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuntimeStats) Reset() {
+	*x = RuntimeStats{}
+	mi := &file_dto_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuntimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeStats) ProtoMessage() {}
+
+func (x *RuntimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeStats.ProtoReflect.Descriptor instead.
+func (*RuntimeStats) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RuntimeStats) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetHeapAlloc() uint64 {
+	if x != nil {
+		return x.HeapAlloc
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetHeapInuse() uint64 {
+	if x != nil {
+		return x.HeapInuse
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetHeapSys() uint64 {
+	if x != nil {
+		return x.HeapSys
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetHeapObjects() uint64 {
+	if x != nil {
+		return x.HeapObjects
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetNextGc() uint64 {
+	if x != nil {
+		return x.NextGc
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetNumGc() uint32 {
+	if x != nil {
+		return x.NumGc
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetGcPauseTotal() *durationpb.Duration {
+	if x != nil {
+		return x.GcPauseTotal
+	}
+	return nil
+}
+
+func (x *RuntimeStats) GetGcPauseLast() *durationpb.Duration {
+	if x != nil {
+		return x.GcPauseLast
+	}
+	return nil
+}
+
+type ProcessStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Pid             int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Threads         uint64                 `protobuf:"varint,2,opt,name=threads,proto3" json:"threads,omitempty"`
+	Rss             uint64                 `protobuf:"varint,3,opt,name=rss,proto3" json:"rss,omitempty"`
+	VirtualMemory   uint64                 `protobuf:"varint,4,opt,name=virtual_memory,json=virtualMemory,proto3" json:"virtual_memory,omitempty"`
+	OpenFds         int32                  `protobuf:"varint,5,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+	FdSize          uint32                 `protobuf:"varint,6,opt,name=fd_size,json=fdSize,proto3" json:"fd_size,omitempty"`
+	CpuUser         *durationpb.Duration   `protobuf:"bytes,7,opt,name=cpu_user,json=cpuUser,proto3" json:"cpu_user,omitempty"`
+	CpuSystem       *durationpb.Duration   `protobuf:"bytes,8,opt,name=cpu_system,json=cpuSystem,proto3" json:"cpu_system,omitempty"`
+	VoluntaryCtxt   uint64                 `protobuf:"varint,9,opt,name=voluntary_ctxt,json=voluntaryCtxt,proto3" json:"voluntary_ctxt,omitempty"`
+	InvoluntaryCtxt uint64                 `protobuf:"varint,10,opt,name=involuntary_ctxt,json=involuntaryCtxt,proto3" json:"involuntary_ctxt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProcessStats) Reset() {
+	*x = ProcessStats{}
+	mi := &file_dto_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStats) ProtoMessage() {}
+
+func (x *ProcessStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStats.ProtoReflect.Descriptor instead.
+func (*ProcessStats) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ProcessStats) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetThreads() uint64 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetRss() uint64 {
+	if x != nil {
+		return x.Rss
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetVirtualMemory() uint64 {
+	if x != nil {
+		return x.VirtualMemory
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetOpenFds() int32 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetFdSize() uint32 {
+	if x != nil {
+		return x.FdSize
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetCpuUser() *durationpb.Duration {
+	if x != nil {
+		return x.CpuUser
+	}
+	return nil
+}
+
+func (x *ProcessStats) GetCpuSystem() *durationpb.Duration {
+	if x != nil {
+		return x.CpuSystem
+	}
+	return nil
+}
+
+func (x *ProcessStats) GetVoluntaryCtxt() uint64 {
+	if x != nil {
+		return x.VoluntaryCtxt
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetInvoluntaryCtxt() uint64 {
+	if x != nil {
+		return x.InvoluntaryCtxt
+	}
+	return 0
+}
+
+type SelfMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workers       []*WorkerSelfStats     `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	Runtime       *RuntimeStats          `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Process       *ProcessStats          `protobuf:"bytes,3,opt,name=process,proto3" json:"process,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelfMetrics) Reset() {
+	*x = SelfMetrics{}
+	mi := &file_dto_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfMetrics) ProtoMessage() {}
+
+func (x *SelfMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfMetrics.ProtoReflect.Descriptor instead.
+func (*SelfMetrics) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SelfMetrics) GetWorkers() []*WorkerSelfStats {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *SelfMetrics) GetRuntime() *RuntimeStats {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *SelfMetrics) GetProcess() *ProcessStats {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+type GetSelfMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSelfMetricsRequest) Reset() {
+	*x = GetSelfMetricsRequest{}
+	mi := &file_dto_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSelfMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfMetricsRequest) ProtoMessage() {}
+
+func (x *GetSelfMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetSelfMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{45}
+}
+
+type SelfMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Self          *SelfMetrics           `protobuf:"bytes,1,opt,name=self,proto3" json:"self,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelfMetricsResponse) Reset() {
+	*x = SelfMetricsResponse{}
+	mi := &file_dto_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfMetricsResponse) ProtoMessage() {}
+
+func (x *SelfMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfMetricsResponse.ProtoReflect.Descriptor instead.
+func (*SelfMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SelfMetricsResponse) GetSelf() *SelfMetrics {
+	if x != nil {
+		return x.Self
+	}
+	return nil
+}
+
+func (x *SelfMetricsResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ProcessInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pid           int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid          int32                  `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	User          string                 `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	CpuPercent    float64                `protobuf:"fixed64,7,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	Rss           uint64                 `protobuf:"varint,8,opt,name=rss,proto3" json:"rss,omitempty"`
+	Pss           *uint64                `protobuf:"varint,9,opt,name=pss,proto3,oneof" json:"pss,omitempty"` // unset when not read
+	Threads       int32                  `protobuf:"varint,10,opt,name=threads,proto3" json:"threads,omitempty"`
+	Fds           int32                  `protobuf:"varint,11,opt,name=fds,proto3" json:"fds,omitempty"`
+	ReadBytes     uint64                 `protobuf:"varint,12,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes    uint64                 `protobuf:"varint,13,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	ReadRate      uint64                 `protobuf:"varint,14,opt,name=read_rate,json=readRate,proto3" json:"read_rate,omitempty"`
+	WriteRate     uint64                 `protobuf:"varint,15,opt,name=write_rate,json=writeRate,proto3" json:"write_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	mi := &file_dto_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProcessInfo) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetPpid() int32 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetRss() uint64 {
+	if x != nil {
+		return x.Rss
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetPss() uint64 {
+	if x != nil && x.Pss != nil {
+		return *x.Pss
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetFds() int32 {
+	if x != nil {
+		return x.Fds
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetReadRate() uint64 {
+	if x != nil {
+		return x.ReadRate
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetWriteRate() uint64 {
+	if x != nil {
+		return x.WriteRate
+	}
+	return 0
+}
+
+// sort: "cpu" (default), "mem" or "io"; limit: 0 is the default of 5
+type GetProcessesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProcessesRequest) Reset() {
+	*x = GetProcessesRequest{}
+	mi := &file_dto_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessesRequest) ProtoMessage() {}
+
+func (x *GetProcessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessesRequest.ProtoReflect.Descriptor instead.
+func (*GetProcessesRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetProcessesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetProcessesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ProcessesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Processes     []*ProcessInfo         `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessesResponse) Reset() {
+	*x = ProcessesResponse{}
+	mi := &file_dto_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessesResponse) ProtoMessage() {}
+
+func (x *ProcessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessesResponse.ProtoReflect.Descriptor instead.
+func (*ProcessesResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProcessesResponse) GetProcesses() []*ProcessInfo {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *ProcessesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProcessesResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Stall statistics of a PSI line, averages in percent
+type PressureLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avg10         float64                `protobuf:"fixed64,1,opt,name=avg10,proto3" json:"avg10,omitempty"`
+	Avg60         float64                `protobuf:"fixed64,2,opt,name=avg60,proto3" json:"avg60,omitempty"`
+	Avg300        float64                `protobuf:"fixed64,3,opt,name=avg300,proto3" json:"avg300,omitempty"`
+	Total         *durationpb.Duration   `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Stall         *durationpb.Duration   `protobuf:"bytes,5,opt,name=stall,proto3" json:"stall,omitempty"`
+	StallPercent  float64                `protobuf:"fixed64,6,opt,name=stall_percent,json=stallPercent,proto3" json:"stall_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PressureLine) Reset() {
+	*x = PressureLine{}
+	mi := &file_dto_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PressureLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PressureLine) ProtoMessage() {}
+
+func (x *PressureLine) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PressureLine.ProtoReflect.Descriptor instead.
+func (*PressureLine) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PressureLine) GetAvg10() float64 {
+	if x != nil {
+		return x.Avg10
+	}
+	return 0
+}
+
+func (x *PressureLine) GetAvg60() float64 {
+	if x != nil {
+		return x.Avg60
+	}
+	return 0
+}
+
+func (x *PressureLine) GetAvg300() float64 {
+	if x != nil {
+		return x.Avg300
+	}
+	return 0
+}
+
+func (x *PressureLine) GetTotal() *durationpb.Duration {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PressureLine) GetStall() *durationpb.Duration {
+	if x != nil {
+		return x.Stall
+	}
+	return nil
+}
+
+func (x *PressureLine) GetStallPercent() float64 {
+	if x != nil {
+		return x.StallPercent
+	}
+	return 0
+}
+
+type PressureStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Some          *PressureLine          `protobuf:"bytes,1,opt,name=some,proto3" json:"some,omitempty"`
+	Full          *PressureLine          `protobuf:"bytes,2,opt,name=full,proto3" json:"full,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PressureStats) Reset() {
+	*x = PressureStats{}
+	mi := &file_dto_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PressureStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PressureStats) ProtoMessage() {}
+
+func (x *PressureStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PressureStats.ProtoReflect.Descriptor instead.
+func (*PressureStats) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PressureStats) GetSome() *PressureLine {
+	if x != nil {
+		return x.Some
+	}
+	return nil
+}
+
+func (x *PressureStats) GetFull() *PressureLine {
+	if x != nil {
+		return x.Full
+	}
+	return nil
+}
+
+type Pressure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpu           *PressureStats         `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        *PressureStats         `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Io            *PressureStats         `protobuf:"bytes,3,opt,name=io,proto3" json:"io,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pressure) Reset() {
+	*x = Pressure{}
+	mi := &file_dto_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pressure) ProtoMessage() {}
+
+func (x *Pressure) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pressure.ProtoReflect.Descriptor instead.
+func (*Pressure) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Pressure) GetCpu() *PressureStats {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *Pressure) GetMemory() *PressureStats {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *Pressure) GetIo() *PressureStats {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+type CgroupPressure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Pressure      *Pressure              `protobuf:"bytes,4,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupPressure) Reset() {
+	*x = CgroupPressure{}
+	mi := &file_dto_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupPressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupPressure) ProtoMessage() {}
+
+func (x *CgroupPressure) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupPressure.ProtoReflect.Descriptor instead.
+func (*CgroupPressure) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CgroupPressure) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CgroupPressure) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CgroupPressure) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CgroupPressure) GetPressure() *Pressure {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+// sort: resource of the cgroup order, "cpu" (default), "mem" or "io"; limit: 0 is the default of 5
+type GetPressureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPressureRequest) Reset() {
+	*x = GetPressureRequest{}
+	mi := &file_dto_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPressureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPressureRequest) ProtoMessage() {}
+
+func (x *GetPressureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPressureRequest.ProtoReflect.Descriptor instead.
+func (*GetPressureRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetPressureRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetPressureRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// available is false when the kernel has PSI disabled
+type PressureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Host          *Pressure              `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Cgroups       []*CgroupPressure      `protobuf:"bytes,3,rep,name=cgroups,proto3" json:"cgroups,omitempty"`
+	TotalCgroups  int32                  `protobuf:"varint,4,opt,name=total_cgroups,json=totalCgroups,proto3" json:"total_cgroups,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PressureResponse) Reset() {
+	*x = PressureResponse{}
+	mi := &file_dto_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PressureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PressureResponse) ProtoMessage() {}
+
+func (x *PressureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PressureResponse.ProtoReflect.Descriptor instead.
+func (*PressureResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PressureResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PressureResponse) GetHost() *Pressure {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *PressureResponse) GetCgroups() []*CgroupPressure {
+	if x != nil {
+		return x.Cgroups
+	}
+	return nil
+}
+
+func (x *PressureResponse) GetTotalCgroups() int32 {
+	if x != nil {
+		return x.TotalCgroups
+	}
+	return 0
+}
+
+func (x *PressureResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ProtocolCounter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PerSec        float64                `protobuf:"fixed64,2,opt,name=per_sec,json=perSec,proto3" json:"per_sec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtocolCounter) Reset() {
+	*x = ProtocolCounter{}
+	mi := &file_dto_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtocolCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolCounter) ProtoMessage() {}
+
+func (x *ProtocolCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolCounter.ProtoReflect.Descriptor instead.
+func (*ProtocolCounter) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ProtocolCounter) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProtocolCounter) GetPerSec() float64 {
+	if x != nil {
+		return x.PerSec
+	}
+	return 0
+}
+
+type TCPProtocol struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CurrEstab         uint64                 `protobuf:"varint,1,opt,name=curr_estab,json=currEstab,proto3" json:"curr_estab,omitempty"`
+	ActiveOpens       *ProtocolCounter       `protobuf:"bytes,2,opt,name=active_opens,json=activeOpens,proto3" json:"active_opens,omitempty"`
+	PassiveOpens      *ProtocolCounter       `protobuf:"bytes,3,opt,name=passive_opens,json=passiveOpens,proto3" json:"passive_opens,omitempty"`
+	AttemptFails      *ProtocolCounter       `protobuf:"bytes,4,opt,name=attempt_fails,json=attemptFails,proto3" json:"attempt_fails,omitempty"`
+	EstabResets       *ProtocolCounter       `protobuf:"bytes,5,opt,name=estab_resets,json=estabResets,proto3" json:"estab_resets,omitempty"`
+	OutResets         *ProtocolCounter       `protobuf:"bytes,6,opt,name=out_resets,json=outResets,proto3" json:"out_resets,omitempty"`
+	InSegs            *ProtocolCounter       `protobuf:"bytes,7,opt,name=in_segs,json=inSegs,proto3" json:"in_segs,omitempty"`
+	OutSegs           *ProtocolCounter       `protobuf:"bytes,8,opt,name=out_segs,json=outSegs,proto3" json:"out_segs,omitempty"`
+	Retransmits       *ProtocolCounter       `protobuf:"bytes,9,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	RetransmitPercent float64                `protobuf:"fixed64,10,opt,name=retransmit_percent,json=retransmitPercent,proto3" json:"retransmit_percent,omitempty"`
+	InErrors          *ProtocolCounter       `protobuf:"bytes,11,opt,name=in_errors,json=inErrors,proto3" json:"in_errors,omitempty"`
+	InCsumErrors      *ProtocolCounter       `protobuf:"bytes,12,opt,name=in_csum_errors,json=inCsumErrors,proto3" json:"in_csum_errors,omitempty"`
+	ListenOverflows   *ProtocolCounter       `protobuf:"bytes,13,opt,name=listen_overflows,json=listenOverflows,proto3" json:"listen_overflows,omitempty"`
+	ListenDrops       *ProtocolCounter       `protobuf:"bytes,14,opt,name=listen_drops,json=listenDrops,proto3" json:"listen_drops,omitempty"`
+	Timeouts          *ProtocolCounter       `protobuf:"bytes,15,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	SynRetrans        *ProtocolCounter       `protobuf:"bytes,16,opt,name=syn_retrans,json=synRetrans,proto3" json:"syn_retrans,omitempty"`
+	FastRetrans       *ProtocolCounter       `protobuf:"bytes,17,opt,name=fast_retrans,json=fastRetrans,proto3" json:"fast_retrans,omitempty"`
+	LostRetransmit    *ProtocolCounter       `protobuf:"bytes,18,opt,name=lost_retransmit,json=lostRetransmit,proto3" json:"lost_retransmit,omitempty"`
+	AbortOnTimeout    *ProtocolCounter       `protobuf:"bytes,19,opt,name=abort_on_timeout,json=abortOnTimeout,proto3" json:"abort_on_timeout,omitempty"`
+	BacklogDrop       *ProtocolCounter       `protobuf:"bytes,20,opt,name=backlog_drop,json=backlogDrop,proto3" json:"backlog_drop,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TCPProtocol) Reset() {
+	*x = TCPProtocol{}
+	mi := &file_dto_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TCPProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCPProtocol) ProtoMessage() {}
+
+func (x *TCPProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCPProtocol.ProtoReflect.Descriptor instead.
+func (*TCPProtocol) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TCPProtocol) GetCurrEstab() uint64 {
+	if x != nil {
+		return x.CurrEstab
+	}
+	return 0
+}
+
+func (x *TCPProtocol) GetActiveOpens() *ProtocolCounter {
+	if x != nil {
+		return x.ActiveOpens
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetPassiveOpens() *ProtocolCounter {
+	if x != nil {
+		return x.PassiveOpens
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetAttemptFails() *ProtocolCounter {
+	if x != nil {
+		return x.AttemptFails
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetEstabResets() *ProtocolCounter {
+	if x != nil {
+		return x.EstabResets
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetOutResets() *ProtocolCounter {
+	if x != nil {
+		return x.OutResets
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetInSegs() *ProtocolCounter {
+	if x != nil {
+		return x.InSegs
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetOutSegs() *ProtocolCounter {
+	if x != nil {
+		return x.OutSegs
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetRetransmits() *ProtocolCounter {
+	if x != nil {
+		return x.Retransmits
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetRetransmitPercent() float64 {
+	if x != nil {
+		return x.RetransmitPercent
+	}
+	return 0
+}
+
+func (x *TCPProtocol) GetInErrors() *ProtocolCounter {
+	if x != nil {
+		return x.InErrors
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetInCsumErrors() *ProtocolCounter {
+	if x != nil {
+		return x.InCsumErrors
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetListenOverflows() *ProtocolCounter {
+	if x != nil {
+		return x.ListenOverflows
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetListenDrops() *ProtocolCounter {
+	if x != nil {
+		return x.ListenDrops
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetTimeouts() *ProtocolCounter {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetSynRetrans() *ProtocolCounter {
+	if x != nil {
+		return x.SynRetrans
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetFastRetrans() *ProtocolCounter {
+	if x != nil {
+		return x.FastRetrans
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetLostRetransmit() *ProtocolCounter {
+	if x != nil {
+		return x.LostRetransmit
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetAbortOnTimeout() *ProtocolCounter {
+	if x != nil {
+		return x.AbortOnTimeout
+	}
+	return nil
+}
+
+func (x *TCPProtocol) GetBacklogDrop() *ProtocolCounter {
+	if x != nil {
+		return x.BacklogDrop
+	}
+	return nil
+}
+
+type UDPProtocol struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InDatagrams   *ProtocolCounter       `protobuf:"bytes,1,opt,name=in_datagrams,json=inDatagrams,proto3" json:"in_datagrams,omitempty"`
+	OutDatagrams  *ProtocolCounter       `protobuf:"bytes,2,opt,name=out_datagrams,json=outDatagrams,proto3" json:"out_datagrams,omitempty"`
+	NoPorts       *ProtocolCounter       `protobuf:"bytes,3,opt,name=no_ports,json=noPorts,proto3" json:"no_ports,omitempty"`
+	InErrors      *ProtocolCounter       `protobuf:"bytes,4,opt,name=in_errors,json=inErrors,proto3" json:"in_errors,omitempty"`
+	RcvbufErrors  *ProtocolCounter       `protobuf:"bytes,5,opt,name=rcvbuf_errors,json=rcvbufErrors,proto3" json:"rcvbuf_errors,omitempty"`
+	SndbufErrors  *ProtocolCounter       `protobuf:"bytes,6,opt,name=sndbuf_errors,json=sndbufErrors,proto3" json:"sndbuf_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UDPProtocol) Reset() {
+	*x = UDPProtocol{}
+	mi := &file_dto_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UDPProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UDPProtocol) ProtoMessage() {}
+
+func (x *UDPProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UDPProtocol.ProtoReflect.Descriptor instead.
+func (*UDPProtocol) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UDPProtocol) GetInDatagrams() *ProtocolCounter {
+	if x != nil {
+		return x.InDatagrams
+	}
+	return nil
+}
+
+func (x *UDPProtocol) GetOutDatagrams() *ProtocolCounter {
+	if x != nil {
+		return x.OutDatagrams
+	}
+	return nil
+}
+
+func (x *UDPProtocol) GetNoPorts() *ProtocolCounter {
+	if x != nil {
+		return x.NoPorts
+	}
+	return nil
+}
+
+func (x *UDPProtocol) GetInErrors() *ProtocolCounter {
+	if x != nil {
+		return x.InErrors
+	}
+	return nil
+}
+
+func (x *UDPProtocol) GetRcvbufErrors() *ProtocolCounter {
+	if x != nil {
+		return x.RcvbufErrors
+	}
+	return nil
+}
+
+func (x *UDPProtocol) GetSndbufErrors() *ProtocolCounter {
 	if x != nil {
-		return x.InodesUsedPercent
+		return x.SndbufErrors
 	}
-	return 0
+	return nil
 }
 
-type Partition struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-	Mount         string                 `protobuf:"bytes,2,opt,name=mount,proto3" json:"mount,omitempty"`
-	Filesystem    string                 `protobuf:"bytes,3,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
-	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
-	Usage         *PartitionUsage        `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ICMPProtocol struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InMsgs         *ProtocolCounter       `protobuf:"bytes,1,opt,name=in_msgs,json=inMsgs,proto3" json:"in_msgs,omitempty"`
+	OutMsgs        *ProtocolCounter       `protobuf:"bytes,2,opt,name=out_msgs,json=outMsgs,proto3" json:"out_msgs,omitempty"`
+	InErrors       *ProtocolCounter       `protobuf:"bytes,3,opt,name=in_errors,json=inErrors,proto3" json:"in_errors,omitempty"`
+	OutErrors      *ProtocolCounter       `protobuf:"bytes,4,opt,name=out_errors,json=outErrors,proto3" json:"out_errors,omitempty"`
+	InDestUnreachs *ProtocolCounter       `protobuf:"bytes,5,opt,name=in_dest_unreachs,json=inDestUnreachs,proto3" json:"in_dest_unreachs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Partition) Reset() {
-	*x = Partition{}
-	mi := &file_dto_proto_msgTypes[26]
+func (x *ICMPProtocol) Reset() {
+	*x = ICMPProtocol{}
+	mi := &file_dto_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Partition) String() string {
+func (x *ICMPProtocol) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Partition) ProtoMessage() {}
+func (*ICMPProtocol) ProtoMessage() {}
 
-func (x *Partition) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[26]
+func (x *ICMPProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,68 +4365,67 @@ func (x *Partition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Partition.ProtoReflect.Descriptor instead.
-func (*Partition) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ICMPProtocol.ProtoReflect.Descriptor instead.
+func (*ICMPProtocol) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Partition) GetDevice() string {
+func (x *ICMPProtocol) GetInMsgs() *ProtocolCounter {
 	if x != nil {
-		return x.Device
+		return x.InMsgs
 	}
-	return ""
+	return nil
 }
 
-func (x *Partition) GetMount() string {
+func (x *ICMPProtocol) GetOutMsgs() *ProtocolCounter {
 	if x != nil {
-		return x.Mount
+		return x.OutMsgs
 	}
-	return ""
+	return nil
 }
 
-func (x *Partition) GetFilesystem() string {
+func (x *ICMPProtocol) GetInErrors() *ProtocolCounter {
 	if x != nil {
-		return x.Filesystem
+		return x.InErrors
 	}
-	return ""
+	return nil
 }
 
-func (x *Partition) GetOptions() []string {
+func (x *ICMPProtocol) GetOutErrors() *ProtocolCounter {
 	if x != nil {
-		return x.Options
+		return x.OutErrors
 	}
 	return nil
 }
 
-func (x *Partition) GetUsage() *PartitionUsage {
+func (x *ICMPProtocol) GetInDestUnreachs() *ProtocolCounter {
 	if x != nil {
-		return x.Usage
+		return x.InDestUnreachs
 	}
 	return nil
 }
 
-type Partitions struct {
+type GetNetProtocolsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Partitions    []*Partition           `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Partitions) Reset() {
-	*x = Partitions{}
-	mi := &file_dto_proto_msgTypes[27]
+func (x *GetNetProtocolsRequest) Reset() {
+	*x = GetNetProtocolsRequest{}
+	mi := &file_dto_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Partitions) String() string {
+func (x *GetNetProtocolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Partitions) ProtoMessage() {}
+func (*GetNetProtocolsRequest) ProtoMessage() {}
 
-func (x *Partitions) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[27]
+func (x *GetNetProtocolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,49 +4436,36 @@ func (x *Partitions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Partitions.ProtoReflect.Descriptor instead.
-func (*Partitions) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *Partitions) GetPartitions() []*Partition {
-	if x != nil {
-		return x.Partitions
-	}
-	return nil
+// Deprecated: Use GetNetProtocolsRequest.ProtoReflect.Descriptor instead.
+func (*GetNetProtocolsRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{60}
 }
 
-type DiskIO struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	IopsInProgress  uint64                 `protobuf:"varint,1,opt,name=iops_in_progress,json=iopsInProgress,proto3" json:"iops_in_progress,omitempty"`
-	Ops             *IOUint64              `protobuf:"bytes,2,opt,name=ops,proto3" json:"ops,omitempty"`
-	MergedOps       *IOUint64              `protobuf:"bytes,3,opt,name=merged_ops,json=mergedOps,proto3" json:"merged_ops,omitempty"`
-	Bytes           *IOUint64              `protobuf:"bytes,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	OpsPerSec       *IOUint64              `protobuf:"bytes,5,opt,name=ops_per_sec,json=opsPerSec,proto3" json:"ops_per_sec,omitempty"`
-	MergedOpsPerSec *IOUint64              `protobuf:"bytes,6,opt,name=merged_ops_per_sec,json=mergedOpsPerSec,proto3" json:"merged_ops_per_sec,omitempty"`
-	BytesPerSec     *IOUint64              `protobuf:"bytes,7,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`
-	Time            *IODuration            `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
-	IoTime          *durationpb.Duration   `protobuf:"bytes,9,opt,name=io_time,json=ioTime,proto3" json:"io_time,omitempty"`
-	WeightedIo      *durationpb.Duration   `protobuf:"bytes,10,opt,name=weighted_io,json=weightedIo,proto3" json:"weighted_io,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type NetProtocolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tcp           *TCPProtocol           `protobuf:"bytes,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Udp           *UDPProtocol           `protobuf:"bytes,2,opt,name=udp,proto3" json:"udp,omitempty"`
+	Icmp          *ICMPProtocol          `protobuf:"bytes,3,opt,name=icmp,proto3" json:"icmp,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskIO) Reset() {
-	*x = DiskIO{}
-	mi := &file_dto_proto_msgTypes[28]
+func (x *NetProtocolsResponse) Reset() {
+	*x = NetProtocolsResponse{}
+	mi := &file_dto_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskIO) String() string {
+func (x *NetProtocolsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskIO) ProtoMessage() {}
+func (*NetProtocolsResponse) ProtoMessage() {}
 
-func (x *DiskIO) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[28]
+func (x *NetProtocolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,103 +4476,64 @@ func (x *DiskIO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskIO.ProtoReflect.Descriptor instead.
-func (*DiskIO) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *DiskIO) GetIopsInProgress() uint64 {
-	if x != nil {
-		return x.IopsInProgress
-	}
-	return 0
-}
-
-func (x *DiskIO) GetOps() *IOUint64 {
-	if x != nil {
-		return x.Ops
-	}
-	return nil
-}
-
-func (x *DiskIO) GetMergedOps() *IOUint64 {
-	if x != nil {
-		return x.MergedOps
-	}
-	return nil
-}
-
-func (x *DiskIO) GetBytes() *IOUint64 {
-	if x != nil {
-		return x.Bytes
-	}
-	return nil
-}
-
-func (x *DiskIO) GetOpsPerSec() *IOUint64 {
-	if x != nil {
-		return x.OpsPerSec
-	}
-	return nil
-}
-
-func (x *DiskIO) GetMergedOpsPerSec() *IOUint64 {
-	if x != nil {
-		return x.MergedOpsPerSec
-	}
-	return nil
+// Deprecated: Use NetProtocolsResponse.ProtoReflect.Descriptor instead.
+func (*NetProtocolsResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DiskIO) GetBytesPerSec() *IOUint64 {
+func (x *NetProtocolsResponse) GetTcp() *TCPProtocol {
 	if x != nil {
-		return x.BytesPerSec
+		return x.Tcp
 	}
 	return nil
 }
 
-func (x *DiskIO) GetTime() *IODuration {
+func (x *NetProtocolsResponse) GetUdp() *UDPProtocol {
 	if x != nil {
-		return x.Time
+		return x.Udp
 	}
 	return nil
 }
 
-func (x *DiskIO) GetIoTime() *durationpb.Duration {
+func (x *NetProtocolsResponse) GetIcmp() *ICMPProtocol {
 	if x != nil {
-		return x.IoTime
+		return x.Icmp
 	}
 	return nil
 }
 
-func (x *DiskIO) GetWeightedIo() *durationpb.Duration {
+func (x *NetProtocolsResponse) GetStatus() *MetricStatus {
 	if x != nil {
-		return x.WeightedIo
+		return x.Status
 	}
 	return nil
 }
 
-type DiskIOMap struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Disks         map[string]*DiskIO     `protobuf:"bytes,1,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CollectorInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Units           string                 `protobuf:"bytes,3,opt,name=units,proto3" json:"units,omitempty"`
+	DefaultInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=default_interval,json=defaultInterval,proto3" json:"default_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DiskIOMap) Reset() {
-	*x = DiskIOMap{}
-	mi := &file_dto_proto_msgTypes[29]
+func (x *CollectorInfo) Reset() {
+	*x = CollectorInfo{}
+	mi := &file_dto_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskIOMap) String() string {
+func (x *CollectorInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskIOMap) ProtoMessage() {}
+func (*CollectorInfo) ProtoMessage() {}
 
-func (x *DiskIOMap) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[29]
+func (x *CollectorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,39 +4544,60 @@ func (x *DiskIOMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskIOMap.ProtoReflect.Descriptor instead.
-func (*DiskIOMap) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CollectorInfo.ProtoReflect.Descriptor instead.
+func (*CollectorInfo) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DiskIOMap) GetDisks() map[string]*DiskIO {
+func (x *CollectorInfo) GetName() string {
 	if x != nil {
-		return x.Disks
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectorInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CollectorInfo) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *CollectorInfo) GetDefaultInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultInterval
 	}
 	return nil
 }
 
-type GetPartitionsRequest struct {
+type ListCollectorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartitionsRequest) Reset() {
-	*x = GetPartitionsRequest{}
-	mi := &file_dto_proto_msgTypes[30]
+func (x *ListCollectorsRequest) Reset() {
+	*x = ListCollectorsRequest{}
+	mi := &file_dto_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartitionsRequest) String() string {
+func (x *ListCollectorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartitionsRequest) ProtoMessage() {}
+func (*ListCollectorsRequest) ProtoMessage() {}
 
-func (x *GetPartitionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[30]
+func (x *ListCollectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,32 +4608,33 @@ func (x *GetPartitionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartitionsRequest.ProtoReflect.Descriptor instead.
-func (*GetPartitionsRequest) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListCollectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectorsRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{63}
 }
 
-type GetDiskIORequest struct {
+type ListCollectorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collectors    []*CollectorInfo       `protobuf:"bytes,1,rep,name=collectors,proto3" json:"collectors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDiskIORequest) Reset() {
-	*x = GetDiskIORequest{}
-	mi := &file_dto_proto_msgTypes[31]
+func (x *ListCollectorsResponse) Reset() {
+	*x = ListCollectorsResponse{}
+	mi := &file_dto_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDiskIORequest) String() string {
+func (x *ListCollectorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiskIORequest) ProtoMessage() {}
+func (*ListCollectorsResponse) ProtoMessage() {}
 
-func (x *GetDiskIORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[31]
+func (x *ListCollectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,33 +4645,40 @@ func (x *GetDiskIORequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiskIORequest.ProtoReflect.Descriptor instead.
-func (*GetDiskIORequest) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListCollectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectorsResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{64}
 }
 
-type PartitionsResponse struct {
+func (x *ListCollectorsResponse) GetCollectors() []*CollectorInfo {
+	if x != nil {
+		return x.Collectors
+	}
+	return nil
+}
+
+type GetCollectorMetricRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Partitions    *Partitions            `protobuf:"bytes,1,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartitionsResponse) Reset() {
-	*x = PartitionsResponse{}
-	mi := &file_dto_proto_msgTypes[32]
+func (x *GetCollectorMetricRequest) Reset() {
+	*x = GetCollectorMetricRequest{}
+	mi := &file_dto_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartitionsResponse) String() string {
+func (x *GetCollectorMetricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionsResponse) ProtoMessage() {}
+func (*GetCollectorMetricRequest) ProtoMessage() {}
 
-func (x *PartitionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[32]
+func (x *GetCollectorMetricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,40 +4689,43 @@ func (x *PartitionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionsResponse.ProtoReflect.Descriptor instead.
-func (*PartitionsResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetCollectorMetricRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectorMetricRequest) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *PartitionsResponse) GetPartitions() *Partitions {
+func (x *GetCollectorMetricRequest) GetName() string {
 	if x != nil {
-		return x.Partitions
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DiskIOMapResponse struct {
+// Value of any collector encoded as JSON
+type CollectorMetricResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Io            *DiskIOMap             `protobuf:"bytes,1,opt,name=io,proto3" json:"io,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Status        *MetricStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskIOMapResponse) Reset() {
-	*x = DiskIOMapResponse{}
-	mi := &file_dto_proto_msgTypes[33]
+func (x *CollectorMetricResponse) Reset() {
+	*x = CollectorMetricResponse{}
+	mi := &file_dto_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskIOMapResponse) String() string {
+func (x *CollectorMetricResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskIOMapResponse) ProtoMessage() {}
+func (*CollectorMetricResponse) ProtoMessage() {}
 
-func (x *DiskIOMapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dto_proto_msgTypes[33]
+func (x *CollectorMetricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dto_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,14 +4736,28 @@ func (x *DiskIOMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskIOMapResponse.ProtoReflect.Descriptor instead.
-func (*DiskIOMapResponse) Descriptor() ([]byte, []int) {
-	return file_dto_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CollectorMetricResponse.ProtoReflect.Descriptor instead.
+func (*CollectorMetricResponse) Descriptor() ([]byte, []int) {
+	return file_dto_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DiskIOMapResponse) GetIo() *DiskIOMap {
+func (x *CollectorMetricResponse) GetName() string {
 	if x != nil {
-		return x.Io
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectorMetricResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CollectorMetricResponse) GetStatus() *MetricStatus {
+	if x != nil {
+		return x.Status
 	}
 	return nil
 }
@@ -1922,7 +4767,7 @@ var File_dto_proto protoreflect.FileDescriptor
 const file_dto_proto_rawDesc = "" +
 	"\n" +
 	"\tdto.proto\x12\n" +
-	"fstmon.dto\x1a\x1egoogle/protobuf/duration.proto\"D\n" +
+	"fstmon.dto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"D\n" +
 	"\bIOUint64\x12\x18\n" +
 	"\asummary\x18\x01 \x01(\x04R\asummary\x12\x0e\n" +
 	"\x02rx\x18\x02 \x01(\x04R\x02rx\x12\x0e\n" +
@@ -1935,7 +4780,23 @@ const file_dto_proto_rawDesc = "" +
 	"IODuration\x123\n" +
 	"\asummary\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\asummary\x12)\n" +
 	"\x02rx\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x02rx\x12)\n" +
-	"\x02tx\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x02tx\"~\n" +
+	"\x02tx\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x02tx\"\x87\x04\n" +
+	"\fMetricStatus\x12\x14\n" +
+	"\x05stale\x18\x01 \x01(\bR\x05stale\x12;\n" +
+	"\vlast_update\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUpdate\x12=\n" +
+	"\flast_success\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastSuccess\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tR\tlastError\x12B\n" +
+	"\x0flast_error_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastErrorTime\x121\n" +
+	"\x14consecutive_failures\x18\x06 \x01(\x05R\x13consecutiveFailures\x124\n" +
+	"\bretry_in\x18\a \x01(\v2\x19.google.protobuf.DurationR\aretryIn\x12\x1a\n" +
+	"\btimeouts\x18\b \x01(\x05R\btimeouts\x12\x16\n" +
+	"\x06panics\x18\t \x01(\x05R\x06panics\x12\x1a\n" +
+	"\brestored\x18\n" +
+	" \x01(\bR\brestored\x12\x1c\n" +
+	"\tfreshness\x18\v \x01(\tR\tfreshness\x12+\n" +
+	"\x03age\x18\f \x01(\v2\x19.google.protobuf.DurationR\x03age\"~\n" +
 	"\vCpuCoreInfo\x12\x1f\n" +
 	"\vphysical_id\x18\x01 \x01(\x05R\n" +
 	"physicalId\x12\x17\n" +
@@ -1949,20 +4810,48 @@ const file_dto_proto_rawDesc = "" +
 	"model_name\x18\x02 \x01(\tR\tmodelName\x12\x1c\n" +
 	"\tmicrocode\x18\x03 \x01(\tR\tmicrocode\x12\x14\n" +
 	"\x05flags\x18\x04 \x03(\tR\x05flags\x12-\n" +
-	"\x05cores\x18\x05 \x03(\v2\x17.fstmon.dto.CpuCoreInfoR\x05cores\"B\n" +
+	"\x05cores\x18\x05 \x03(\v2\x17.fstmon.dto.CpuCoreInfoR\x05cores\"\xed\x01\n" +
+	"\bCpuTimes\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\x01R\x04user\x12\x12\n" +
+	"\x04nice\x18\x02 \x01(\x01R\x04nice\x12\x16\n" +
+	"\x06system\x18\x03 \x01(\x01R\x06system\x12\x12\n" +
+	"\x04idle\x18\x04 \x01(\x01R\x04idle\x12\x16\n" +
+	"\x06iowait\x18\x05 \x01(\x01R\x06iowait\x12\x10\n" +
+	"\x03irq\x18\x06 \x01(\x01R\x03irq\x12\x18\n" +
+	"\asoftirq\x18\a \x01(\x01R\asoftirq\x12\x14\n" +
+	"\x05steal\x18\b \x01(\x01R\x05steal\x12\x14\n" +
+	"\x05guest\x18\t \x01(\x01R\x05guest\x12\x1d\n" +
+	"\n" +
+	"guest_nice\x18\n" +
+	" \x01(\x01R\tguestNice\"n\n" +
 	"\x0eCpuCoreMetrics\x12\x12\n" +
 	"\x04load\x18\x01 \x01(\x01R\x04load\x12\x1c\n" +
-	"\tfrequency\x18\x02 \x01(\x01R\tfrequency\"t\n" +
+	"\tfrequency\x18\x02 \x01(\x01R\tfrequency\x12*\n" +
+	"\x05times\x18\x03 \x01(\v2\x14.fstmon.dto.CpuTimesR\x05times\"\xc3\x02\n" +
+	"\vCpuActivity\x12)\n" +
+	"\x10context_switches\x18\x01 \x01(\x04R\x0fcontextSwitches\x127\n" +
+	"\x18context_switches_per_sec\x18\x02 \x01(\x04R\x15contextSwitchesPerSec\x12\x1e\n" +
+	"\n" +
+	"interrupts\x18\x03 \x01(\x04R\n" +
+	"interrupts\x12,\n" +
+	"\x12interrupts_per_sec\x18\x04 \x01(\x04R\x10interruptsPerSec\x12\x14\n" +
+	"\x05forks\x18\x05 \x01(\x04R\x05forks\x12\"\n" +
+	"\rforks_per_sec\x18\x06 \x01(\x04R\vforksPerSec\x12#\n" +
+	"\rprocs_running\x18\a \x01(\x04R\fprocsRunning\x12#\n" +
+	"\rprocs_blocked\x18\b \x01(\x04R\fprocsBlocked\"\xa9\x01\n" +
 	"\n" +
 	"CpuMetrics\x124\n" +
 	"\aaverage\x18\x01 \x01(\v2\x1a.fstmon.dto.CpuCoreMetricsR\aaverage\x120\n" +
-	"\x05cores\x18\x02 \x03(\v2\x1a.fstmon.dto.CpuCoreMetricsR\x05cores\"\x13\n" +
+	"\x05cores\x18\x02 \x03(\v2\x1a.fstmon.dto.CpuCoreMetricsR\x05cores\x123\n" +
+	"\bactivity\x18\x03 \x01(\v2\x17.fstmon.dto.CpuActivityR\bactivity\"\x13\n" +
 	"\x11GetCpuInfoRequest\"\x16\n" +
-	"\x14GetCpuMetricsRequest\">\n" +
+	"\x14GetCpuMetricsRequest\"p\n" +
 	"\x12CpuPackageResponse\x12(\n" +
-	"\x03cpu\x18\x01 \x01(\v2\x16.fstmon.dto.CpuPackageR\x03cpu\"F\n" +
+	"\x03cpu\x18\x01 \x01(\v2\x16.fstmon.dto.CpuPackageR\x03cpu\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"x\n" +
 	"\x12CpuMetricsResponse\x120\n" +
-	"\ametrics\x18\x01 \x01(\v2\x16.fstmon.dto.CpuMetricsR\ametrics\"\x81\x03\n" +
+	"\ametrics\x18\x01 \x01(\v2\x16.fstmon.dto.CpuMetricsR\ametrics\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\xe1\x03\n" +
 	"\vInterfaceIO\x125\n" +
 	"\vbytes_total\x18\x01 \x01(\v2\x14.fstmon.dto.IOUint64R\n" +
 	"bytesTotal\x129\n" +
@@ -1970,7 +4859,23 @@ const file_dto_proto_rawDesc = "" +
 	"\x13error_packets_total\x18\x03 \x01(\v2\x14.fstmon.dto.IOUint64R\x11errorPacketsTotal\x12B\n" +
 	"\x12drop_packets_total\x18\x04 \x01(\v2\x14.fstmon.dto.IOUint64R\x10dropPacketsTotal\x128\n" +
 	"\rbytes_per_sec\x18\x05 \x01(\v2\x14.fstmon.dto.IOUint64R\vbytesPerSec\x12<\n" +
-	"\x0fpackets_per_sec\x18\x06 \x01(\v2\x14.fstmon.dto.IOUint64R\rpacketsPerSec\"\xb0\x01\n" +
+	"\x0fpackets_per_sec\x18\x06 \x01(\v2\x14.fstmon.dto.IOUint64R\rpacketsPerSec\x12-\n" +
+	"\x04link\x18\a \x01(\v2\x19.fstmon.dto.InterfaceLinkR\x04link\x12/\n" +
+	"\x13utilization_percent\x18\b \x01(\x01R\x12utilizationPercent\"\xf7\x01\n" +
+	"\rInterfaceLink\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"oper_state\x18\x02 \x01(\tR\toperState\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\bR\acarrier\x12\x1d\n" +
+	"\n" +
+	"speed_mbps\x18\x04 \x01(\x04R\tspeedMbps\x12\x16\n" +
+	"\x06duplex\x18\x05 \x01(\tR\x06duplex\x12\x10\n" +
+	"\x03mtu\x18\x06 \x01(\x04R\x03mtu\x12\x10\n" +
+	"\x03mac\x18\a \x01(\tR\x03mac\x12\x16\n" +
+	"\x06master\x18\b \x01(\tR\x06master\x12\x12\n" +
+	"\x04ipv4\x18\t \x03(\tR\x04ipv4\x12\x12\n" +
+	"\x04ipv6\x18\n" +
+	" \x03(\tR\x04ipv6\"\xb0\x01\n" +
 	"\fInterfacesIO\x12H\n" +
 	"\n" +
 	"interfaces\x18\x01 \x03(\v2(.fstmon.dto.InterfacesIO.InterfacesEntryR\n" +
@@ -1978,9 +4883,10 @@ const file_dto_proto_rawDesc = "" +
 	"\x0fInterfacesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
 	"\x05value\x18\x02 \x01(\v2\x17.fstmon.dto.InterfaceIOR\x05value:\x028\x01\"\x18\n" +
-	"\x16GetInterfacesIORequest\"D\n" +
+	"\x16GetInterfacesIORequest\"v\n" +
 	"\x14InterfacesIOResponse\x12,\n" +
-	"\x04data\x18\x01 \x01(\v2\x18.fstmon.dto.InterfacesIOR\x04data\"\xf8\x01\n" +
+	"\x04data\x18\x01 \x01(\v2\x18.fstmon.dto.InterfacesIOR\x04data\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\xf8\x01\n" +
 	"\n" +
 	"SystemInfo\x121\n" +
 	"\x06uptime\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06uptime\x12-\n" +
@@ -1991,9 +4897,10 @@ const file_dto_proto_rawDesc = "" +
 	"\rrunning_procs\x18\x06 \x01(\x05R\frunningProcs\x12\x1f\n" +
 	"\vtotal_procs\x18\a \x01(\x05R\n" +
 	"totalProcs\"\x16\n" +
-	"\x14GetSystemInfoRequest\"D\n" +
+	"\x14GetSystemInfoRequest\"v\n" +
 	"\x12SystemInfoResponse\x12.\n" +
-	"\x06system\x18\x01 \x01(\v2\x16.fstmon.dto.SystemInfoR\x06system\"\xba\x02\n" +
+	"\x06system\x18\x01 \x01(\v2\x16.fstmon.dto.SystemInfoR\x06system\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\xbb\x06\n" +
 	"\rMemoryMetrics\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x1c\n" +
 	"\tavailable\x18\x02 \x01(\x04R\tavailable\x12\x12\n" +
@@ -2006,10 +4913,52 @@ const file_dto_proto_rawDesc = "" +
 	"\tswap_free\x18\b \x01(\x04R\bswapFree\x12!\n" +
 	"\fused_percent\x18\t \x01(\x01R\vusedPercent\x12*\n" +
 	"\x11swap_used_percent\x18\n" +
-	" \x01(\x01R\x0fswapUsedPercent\"\x19\n" +
-	"\x17GetMemoryMetricsRequest\"J\n" +
+	" \x01(\x01R\x0fswapUsedPercent\x12\x18\n" +
+	"\abuffers\x18\v \x01(\x04R\abuffers\x12\x16\n" +
+	"\x06cached\x18\f \x01(\x04R\x06cached\x12\x1f\n" +
+	"\vswap_cached\x18\r \x01(\x04R\n" +
+	"swapCached\x12\x14\n" +
+	"\x05shmem\x18\x0e \x01(\x04R\x05shmem\x12\x12\n" +
+	"\x04slab\x18\x0f \x01(\x04R\x04slab\x12\"\n" +
+	"\fsreclaimable\x18\x10 \x01(\x04R\fsreclaimable\x12\x1e\n" +
+	"\n" +
+	"sunreclaim\x18\x11 \x01(\x04R\n" +
+	"sunreclaim\x12\x14\n" +
+	"\x05dirty\x18\x12 \x01(\x04R\x05dirty\x12\x1c\n" +
+	"\twriteback\x18\x13 \x01(\x04R\twriteback\x12!\n" +
+	"\fcommitted_as\x18\x14 \x01(\x04R\vcommittedAs\x12!\n" +
+	"\fcommit_limit\x18\x15 \x01(\x04R\vcommitLimit\x124\n" +
+	"\n" +
+	"huge_pages\x18\x16 \x01(\v2\x15.fstmon.dto.HugePagesR\thugePages\x12\x14\n" +
+	"\x05zswap\x18\x17 \x01(\x04R\x05zswap\x12\x1a\n" +
+	"\bzswapped\x18\x18 \x01(\x04R\bzswapped\x12$\n" +
+	"\x04zram\x18\x19 \x01(\v2\x10.fstmon.dto.ZramR\x04zram\x126\n" +
+	"\bactivity\x18\x1a \x01(\v2\x1a.fstmon.dto.MemoryActivityR\bactivity\"\x88\x01\n" +
+	"\tHugePages\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x12\n" +
+	"\x04free\x18\x02 \x01(\x04R\x04free\x12\x1a\n" +
+	"\breserved\x18\x03 \x01(\x04R\breserved\x12\x18\n" +
+	"\asurplus\x18\x04 \x01(\x04R\asurplus\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x04R\bpageSize\"x\n" +
+	"\x04Zram\x12\x18\n" +
+	"\adevices\x18\x01 \x01(\x05R\adevices\x12\x1b\n" +
+	"\torig_data\x18\x02 \x01(\x04R\borigData\x12\x1e\n" +
+	"\n" +
+	"compressed\x18\x03 \x01(\x04R\n" +
+	"compressed\x12\x19\n" +
+	"\bmem_used\x18\x04 \x01(\x04R\amemUsed\"\xad\x02\n" +
+	"\x0eMemoryActivity\x12-\n" +
+	"\x13page_faults_per_sec\x18\x01 \x01(\x04R\x10pageFaultsPerSec\x12/\n" +
+	"\x14major_faults_per_sec\x18\x02 \x01(\x04R\x11majorFaultsPerSec\x12%\n" +
+	"\x0fswap_in_per_sec\x18\x03 \x01(\x04R\fswapInPerSec\x12'\n" +
+	"\x10swap_out_per_sec\x18\x04 \x01(\x04R\rswapOutPerSec\x12%\n" +
+	"\x0fpage_in_per_sec\x18\x05 \x01(\x04R\fpageInPerSec\x12'\n" +
+	"\x10page_out_per_sec\x18\x06 \x01(\x04R\rpageOutPerSec\x12\x1b\n" +
+	"\toom_kills\x18\a \x01(\x04R\boomKills\"\x19\n" +
+	"\x17GetMemoryMetricsRequest\"|\n" +
 	"\x15MemoryMetricsResponse\x121\n" +
-	"\x06memory\x18\x01 \x01(\v2\x19.fstmon.dto.MemoryMetricsR\x06memory\"P\n" +
+	"\x06memory\x18\x01 \x01(\v2\x19.fstmon.dto.MemoryMetricsR\x06memory\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"P\n" +
 	"\x0eThermalMetrics\x12\x18\n" +
 	"\acurrent\x18\x01 \x01(\x01R\acurrent\x12\x10\n" +
 	"\x03max\x18\x02 \x01(\x01R\x03max\x12\x12\n" +
@@ -2019,9 +4968,10 @@ const file_dto_proto_rawDesc = "" +
 	"\fSensorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
 	"\x05value\x18\x02 \x01(\v2\x1a.fstmon.dto.ThermalMetricsR\x05value:\x028\x01\"\x13\n" +
-	"\x11GetThermalRequest\"J\n" +
+	"\x11GetThermalRequest\"|\n" +
 	"\x0fThermalResponse\x127\n" +
-	"\ametrics\x18\x01 \x01(\v2\x1d.fstmon.dto.ThermalMetricsMapR\ametrics\"\x86\x02\n" +
+	"\ametrics\x18\x01 \x01(\v2\x1d.fstmon.dto.ThermalMetricsMapR\ametrics\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\x86\x02\n" +
 	"\x0ePartitionUsage\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x12\n" +
 	"\x04used\x18\x02 \x01(\x04R\x04used\x12\x12\n" +
@@ -2067,13 +5017,183 @@ const file_dto_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
 	"\x05value\x18\x02 \x01(\v2\x12.fstmon.dto.DiskIOR\x05value:\x028\x01\"\x16\n" +
 	"\x14GetPartitionsRequest\"\x12\n" +
-	"\x10GetDiskIORequest\"L\n" +
+	"\x10GetDiskIORequest\"~\n" +
 	"\x12PartitionsResponse\x126\n" +
 	"\n" +
 	"partitions\x18\x01 \x01(\v2\x16.fstmon.dto.PartitionsR\n" +
-	"partitions\":\n" +
+	"partitions\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"l\n" +
 	"\x11DiskIOMapResponse\x12%\n" +
-	"\x02io\x18\x01 \x01(\v2\x15.fstmon.dto.DiskIOMapR\x02ioB>Z<github.com/eterline/fstmon/internal/interface/grpc/flugel/commonb\x06proto3"
+	"\x02io\x18\x01 \x01(\v2\x15.fstmon.dto.DiskIOMapR\x02io\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\xff\x02\n" +
+	"\x0fWorkerSelfStats\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\rlast_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\flastDuration\x12<\n" +
+	"\favg_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vavgDuration\x12<\n" +
+	"\fmax_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vmaxDuration\x12\x1c\n" +
+	"\tsuccesses\x18\x05 \x01(\x05R\tsuccesses\x12\x16\n" +
+	"\x06errors\x18\x06 \x01(\x05R\x06errors\x12\x1a\n" +
+	"\btimeouts\x18\a \x01(\x05R\btimeouts\x12\x16\n" +
+	"\x06panics\x18\b \x01(\x05R\x06panics\x12\x18\n" +
+	"\askipped\x18\t \x01(\x05R\askipped\x12\x1a\n" +
+	"\boverlaps\x18\n" +
+	" \x01(\x05R\boverlaps\"\xda\x02\n" +
+	"\fRuntimeStats\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x01 \x01(\x05R\n" +
+	"goroutines\x12\x1d\n" +
+	"\n" +
+	"heap_alloc\x18\x02 \x01(\x04R\theapAlloc\x12\x1d\n" +
+	"\n" +
+	"heap_inuse\x18\x03 \x01(\x04R\theapInuse\x12\x19\n" +
+	"\bheap_sys\x18\x04 \x01(\x04R\aheapSys\x12!\n" +
+	"\fheap_objects\x18\x05 \x01(\x04R\vheapObjects\x12\x17\n" +
+	"\anext_gc\x18\x06 \x01(\x04R\x06nextGc\x12\x15\n" +
+	"\x06num_gc\x18\a \x01(\rR\x05numGc\x12?\n" +
+	"\x0egc_pause_total\x18\b \x01(\v2\x19.google.protobuf.DurationR\fgcPauseTotal\x12=\n" +
+	"\rgc_pause_last\x18\t \x01(\v2\x19.google.protobuf.DurationR\vgcPauseLast\"\xe9\x02\n" +
+	"\fProcessStats\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x18\n" +
+	"\athreads\x18\x02 \x01(\x04R\athreads\x12\x10\n" +
+	"\x03rss\x18\x03 \x01(\x04R\x03rss\x12%\n" +
+	"\x0evirtual_memory\x18\x04 \x01(\x04R\rvirtualMemory\x12\x19\n" +
+	"\bopen_fds\x18\x05 \x01(\x05R\aopenFds\x12\x17\n" +
+	"\afd_size\x18\x06 \x01(\rR\x06fdSize\x124\n" +
+	"\bcpu_user\x18\a \x01(\v2\x19.google.protobuf.DurationR\acpuUser\x128\n" +
+	"\n" +
+	"cpu_system\x18\b \x01(\v2\x19.google.protobuf.DurationR\tcpuSystem\x12%\n" +
+	"\x0evoluntary_ctxt\x18\t \x01(\x04R\rvoluntaryCtxt\x12)\n" +
+	"\x10involuntary_ctxt\x18\n" +
+	" \x01(\x04R\x0finvoluntaryCtxt\"\xac\x01\n" +
+	"\vSelfMetrics\x125\n" +
+	"\aworkers\x18\x01 \x03(\v2\x1b.fstmon.dto.WorkerSelfStatsR\aworkers\x122\n" +
+	"\aruntime\x18\x02 \x01(\v2\x18.fstmon.dto.RuntimeStatsR\aruntime\x122\n" +
+	"\aprocess\x18\x03 \x01(\v2\x18.fstmon.dto.ProcessStatsR\aprocess\"\x17\n" +
+	"\x15GetSelfMetricsRequest\"t\n" +
+	"\x13SelfMetricsResponse\x12+\n" +
+	"\x04self\x18\x01 \x01(\v2\x17.fstmon.dto.SelfMetricsR\x04self\x120\n" +
+	"\x06status\x18\x02 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\x85\x03\n" +
+	"\vProcessInfo\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
+	"\x04ppid\x18\x02 \x01(\x05R\x04ppid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x12\n" +
+	"\x04user\x18\x06 \x01(\tR\x04user\x12\x1f\n" +
+	"\vcpu_percent\x18\a \x01(\x01R\n" +
+	"cpuPercent\x12\x10\n" +
+	"\x03rss\x18\b \x01(\x04R\x03rss\x12\x15\n" +
+	"\x03pss\x18\t \x01(\x04H\x00R\x03pss\x88\x01\x01\x12\x18\n" +
+	"\athreads\x18\n" +
+	" \x01(\x05R\athreads\x12\x10\n" +
+	"\x03fds\x18\v \x01(\x05R\x03fds\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\f \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\r \x01(\x04R\n" +
+	"writeBytes\x12\x1b\n" +
+	"\tread_rate\x18\x0e \x01(\x04R\breadRate\x12\x1d\n" +
+	"\n" +
+	"write_rate\x18\x0f \x01(\x04R\twriteRateB\x06\n" +
+	"\x04_pss\"?\n" +
+	"\x13GetProcessesRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x92\x01\n" +
+	"\x11ProcessesResponse\x125\n" +
+	"\tprocesses\x18\x01 \x03(\v2\x17.fstmon.dto.ProcessInfoR\tprocesses\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x120\n" +
+	"\x06status\x18\x03 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\xd9\x01\n" +
+	"\fPressureLine\x12\x14\n" +
+	"\x05avg10\x18\x01 \x01(\x01R\x05avg10\x12\x14\n" +
+	"\x05avg60\x18\x02 \x01(\x01R\x05avg60\x12\x16\n" +
+	"\x06avg300\x18\x03 \x01(\x01R\x06avg300\x12/\n" +
+	"\x05total\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x05total\x12/\n" +
+	"\x05stall\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x05stall\x12#\n" +
+	"\rstall_percent\x18\x06 \x01(\x01R\fstallPercent\"k\n" +
+	"\rPressureStats\x12,\n" +
+	"\x04some\x18\x01 \x01(\v2\x18.fstmon.dto.PressureLineR\x04some\x12,\n" +
+	"\x04full\x18\x02 \x01(\v2\x18.fstmon.dto.PressureLineR\x04full\"\x95\x01\n" +
+	"\bPressure\x12+\n" +
+	"\x03cpu\x18\x01 \x01(\v2\x19.fstmon.dto.PressureStatsR\x03cpu\x121\n" +
+	"\x06memory\x18\x02 \x01(\v2\x19.fstmon.dto.PressureStatsR\x06memory\x12)\n" +
+	"\x02io\x18\x03 \x01(\v2\x19.fstmon.dto.PressureStatsR\x02io\"~\n" +
+	"\x0eCgroupPressure\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
+	"\bpressure\x18\x04 \x01(\v2\x14.fstmon.dto.PressureR\bpressure\">\n" +
+	"\x12GetPressureRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xe7\x01\n" +
+	"\x10PressureResponse\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12(\n" +
+	"\x04host\x18\x02 \x01(\v2\x14.fstmon.dto.PressureR\x04host\x124\n" +
+	"\acgroups\x18\x03 \x03(\v2\x1a.fstmon.dto.CgroupPressureR\acgroups\x12#\n" +
+	"\rtotal_cgroups\x18\x04 \x01(\x05R\ftotalCgroups\x120\n" +
+	"\x06status\x18\x05 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"@\n" +
+	"\x0fProtocolCounter\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x17\n" +
+	"\aper_sec\x18\x02 \x01(\x01R\x06perSec\"\xd1\t\n" +
+	"\vTCPProtocol\x12\x1d\n" +
+	"\n" +
+	"curr_estab\x18\x01 \x01(\x04R\tcurrEstab\x12>\n" +
+	"\factive_opens\x18\x02 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vactiveOpens\x12@\n" +
+	"\rpassive_opens\x18\x03 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\fpassiveOpens\x12@\n" +
+	"\rattempt_fails\x18\x04 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\fattemptFails\x12>\n" +
+	"\festab_resets\x18\x05 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vestabResets\x12:\n" +
+	"\n" +
+	"out_resets\x18\x06 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\toutResets\x124\n" +
+	"\ain_segs\x18\a \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\x06inSegs\x126\n" +
+	"\bout_segs\x18\b \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\aoutSegs\x12=\n" +
+	"\vretransmits\x18\t \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vretransmits\x12-\n" +
+	"\x12retransmit_percent\x18\n" +
+	" \x01(\x01R\x11retransmitPercent\x128\n" +
+	"\tin_errors\x18\v \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\binErrors\x12A\n" +
+	"\x0ein_csum_errors\x18\f \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\finCsumErrors\x12F\n" +
+	"\x10listen_overflows\x18\r \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\x0flistenOverflows\x12>\n" +
+	"\flisten_drops\x18\x0e \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vlistenDrops\x127\n" +
+	"\btimeouts\x18\x0f \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\btimeouts\x12<\n" +
+	"\vsyn_retrans\x18\x10 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\n" +
+	"synRetrans\x12>\n" +
+	"\ffast_retrans\x18\x11 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vfastRetrans\x12D\n" +
+	"\x0flost_retransmit\x18\x12 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\x0elostRetransmit\x12E\n" +
+	"\x10abort_on_timeout\x18\x13 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\x0eabortOnTimeout\x12>\n" +
+	"\fbacklog_drop\x18\x14 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vbacklogDrop\"\x85\x03\n" +
+	"\vUDPProtocol\x12>\n" +
+	"\fin_datagrams\x18\x01 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\vinDatagrams\x12@\n" +
+	"\rout_datagrams\x18\x02 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\foutDatagrams\x126\n" +
+	"\bno_ports\x18\x03 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\anoPorts\x128\n" +
+	"\tin_errors\x18\x04 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\binErrors\x12@\n" +
+	"\rrcvbuf_errors\x18\x05 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\frcvbufErrors\x12@\n" +
+	"\rsndbuf_errors\x18\x06 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\fsndbufErrors\"\xb9\x02\n" +
+	"\fICMPProtocol\x124\n" +
+	"\ain_msgs\x18\x01 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\x06inMsgs\x126\n" +
+	"\bout_msgs\x18\x02 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\aoutMsgs\x128\n" +
+	"\tin_errors\x18\x03 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\binErrors\x12:\n" +
+	"\n" +
+	"out_errors\x18\x04 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\toutErrors\x12E\n" +
+	"\x10in_dest_unreachs\x18\x05 \x01(\v2\x1b.fstmon.dto.ProtocolCounterR\x0einDestUnreachs\"\x18\n" +
+	"\x16GetNetProtocolsRequest\"\xcc\x01\n" +
+	"\x14NetProtocolsResponse\x12)\n" +
+	"\x03tcp\x18\x01 \x01(\v2\x17.fstmon.dto.TCPProtocolR\x03tcp\x12)\n" +
+	"\x03udp\x18\x02 \x01(\v2\x17.fstmon.dto.UDPProtocolR\x03udp\x12,\n" +
+	"\x04icmp\x18\x03 \x01(\v2\x18.fstmon.dto.ICMPProtocolR\x04icmp\x120\n" +
+	"\x06status\x18\x04 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06status\"\xa1\x01\n" +
+	"\rCollectorInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05units\x18\x03 \x01(\tR\x05units\x12D\n" +
+	"\x10default_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0fdefaultInterval\"\x17\n" +
+	"\x15ListCollectorsRequest\"S\n" +
+	"\x16ListCollectorsResponse\x129\n" +
+	"\n" +
+	"collectors\x18\x01 \x03(\v2\x19.fstmon.dto.CollectorInfoR\n" +
+	"collectors\"/\n" +
+	"\x19GetCollectorMetricRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"u\n" +
+	"\x17CollectorMetricResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x120\n" +
+	"\x06status\x18\x03 \x01(\v2\x18.fstmon.dto.MetricStatusR\x06statusBBZ@github.com/eterline/fstmon/internal/interface/grpc/flugel/commonb\x06proto3"
 
 var (
 	file_dto_proto_rawDescOnce sync.Once
@@ -2087,92 +5207,206 @@ func file_dto_proto_rawDescGZIP() []byte {
 	return file_dto_proto_rawDescData
 }
 
-var file_dto_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_dto_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_dto_proto_goTypes = []any{
-	(*IOUint64)(nil),                // 0: fstmon.dto.IOUint64
-	(*IOFloat64)(nil),               // 1: fstmon.dto.IOFloat64
-	(*IODuration)(nil),              // 2: fstmon.dto.IODuration
-	(*CpuCoreInfo)(nil),             // 3: fstmon.dto.CpuCoreInfo
-	(*CpuPackage)(nil),              // 4: fstmon.dto.CpuPackage
-	(*CpuCoreMetrics)(nil),          // 5: fstmon.dto.CpuCoreMetrics
-	(*CpuMetrics)(nil),              // 6: fstmon.dto.CpuMetrics
-	(*GetCpuInfoRequest)(nil),       // 7: fstmon.dto.GetCpuInfoRequest
-	(*GetCpuMetricsRequest)(nil),    // 8: fstmon.dto.GetCpuMetricsRequest
-	(*CpuPackageResponse)(nil),      // 9: fstmon.dto.CpuPackageResponse
-	(*CpuMetricsResponse)(nil),      // 10: fstmon.dto.CpuMetricsResponse
-	(*InterfaceIO)(nil),             // 11: fstmon.dto.InterfaceIO
-	(*InterfacesIO)(nil),            // 12: fstmon.dto.InterfacesIO
-	(*GetInterfacesIORequest)(nil),  // 13: fstmon.dto.GetInterfacesIORequest
-	(*InterfacesIOResponse)(nil),    // 14: fstmon.dto.InterfacesIOResponse
-	(*SystemInfo)(nil),              // 15: fstmon.dto.SystemInfo
-	(*GetSystemInfoRequest)(nil),    // 16: fstmon.dto.GetSystemInfoRequest
-	(*SystemInfoResponse)(nil),      // 17: fstmon.dto.SystemInfoResponse
-	(*MemoryMetrics)(nil),           // 18: fstmon.dto.MemoryMetrics
-	(*GetMemoryMetricsRequest)(nil), // 19: fstmon.dto.GetMemoryMetricsRequest
-	(*MemoryMetricsResponse)(nil),   // 20: fstmon.dto.MemoryMetricsResponse
-	(*ThermalMetrics)(nil),          // 21: fstmon.dto.ThermalMetrics
-	(*ThermalMetricsMap)(nil),       // 22: fstmon.dto.ThermalMetricsMap
-	(*GetThermalRequest)(nil),       // 23: fstmon.dto.GetThermalRequest
-	(*ThermalResponse)(nil),         // 24: fstmon.dto.ThermalResponse
-	(*PartitionUsage)(nil),          // 25: fstmon.dto.PartitionUsage
-	(*Partition)(nil),               // 26: fstmon.dto.Partition
-	(*Partitions)(nil),              // 27: fstmon.dto.Partitions
-	(*DiskIO)(nil),                  // 28: fstmon.dto.DiskIO
-	(*DiskIOMap)(nil),               // 29: fstmon.dto.DiskIOMap
-	(*GetPartitionsRequest)(nil),    // 30: fstmon.dto.GetPartitionsRequest
-	(*GetDiskIORequest)(nil),        // 31: fstmon.dto.GetDiskIORequest
-	(*PartitionsResponse)(nil),      // 32: fstmon.dto.PartitionsResponse
-	(*DiskIOMapResponse)(nil),       // 33: fstmon.dto.DiskIOMapResponse
-	nil,                             // 34: fstmon.dto.InterfacesIO.InterfacesEntry
-	nil,                             // 35: fstmon.dto.ThermalMetricsMap.SensorsEntry
-	nil,                             // 36: fstmon.dto.DiskIOMap.DisksEntry
-	(*durationpb.Duration)(nil),     // 37: google.protobuf.Duration
+	(*IOUint64)(nil),                  // 0: fstmon.dto.IOUint64
+	(*IOFloat64)(nil),                 // 1: fstmon.dto.IOFloat64
+	(*IODuration)(nil),                // 2: fstmon.dto.IODuration
+	(*MetricStatus)(nil),              // 3: fstmon.dto.MetricStatus
+	(*CpuCoreInfo)(nil),               // 4: fstmon.dto.CpuCoreInfo
+	(*CpuPackage)(nil),                // 5: fstmon.dto.CpuPackage
+	(*CpuTimes)(nil),                  // 6: fstmon.dto.CpuTimes
+	(*CpuCoreMetrics)(nil),            // 7: fstmon.dto.CpuCoreMetrics
+	(*CpuActivity)(nil),               // 8: fstmon.dto.CpuActivity
+	(*CpuMetrics)(nil),                // 9: fstmon.dto.CpuMetrics
+	(*GetCpuInfoRequest)(nil),         // 10: fstmon.dto.GetCpuInfoRequest
+	(*GetCpuMetricsRequest)(nil),      // 11: fstmon.dto.GetCpuMetricsRequest
+	(*CpuPackageResponse)(nil),        // 12: fstmon.dto.CpuPackageResponse
+	(*CpuMetricsResponse)(nil),        // 13: fstmon.dto.CpuMetricsResponse
+	(*InterfaceIO)(nil),               // 14: fstmon.dto.InterfaceIO
+	(*InterfaceLink)(nil),             // 15: fstmon.dto.InterfaceLink
+	(*InterfacesIO)(nil),              // 16: fstmon.dto.InterfacesIO
+	(*GetInterfacesIORequest)(nil),    // 17: fstmon.dto.GetInterfacesIORequest
+	(*InterfacesIOResponse)(nil),      // 18: fstmon.dto.InterfacesIOResponse
+	(*SystemInfo)(nil),                // 19: fstmon.dto.SystemInfo
+	(*GetSystemInfoRequest)(nil),      // 20: fstmon.dto.GetSystemInfoRequest
+	(*SystemInfoResponse)(nil),        // 21: fstmon.dto.SystemInfoResponse
+	(*MemoryMetrics)(nil),             // 22: fstmon.dto.MemoryMetrics
+	(*HugePages)(nil),                 // 23: fstmon.dto.HugePages
+	(*Zram)(nil),                      // 24: fstmon.dto.Zram
+	(*MemoryActivity)(nil),            // 25: fstmon.dto.MemoryActivity
+	(*GetMemoryMetricsRequest)(nil),   // 26: fstmon.dto.GetMemoryMetricsRequest
+	(*MemoryMetricsResponse)(nil),     // 27: fstmon.dto.MemoryMetricsResponse
+	(*ThermalMetrics)(nil),            // 28: fstmon.dto.ThermalMetrics
+	(*ThermalMetricsMap)(nil),         // 29: fstmon.dto.ThermalMetricsMap
+	(*GetThermalRequest)(nil),         // 30: fstmon.dto.GetThermalRequest
+	(*ThermalResponse)(nil),           // 31: fstmon.dto.ThermalResponse
+	(*PartitionUsage)(nil),            // 32: fstmon.dto.PartitionUsage
+	(*Partition)(nil),                 // 33: fstmon.dto.Partition
+	(*Partitions)(nil),                // 34: fstmon.dto.Partitions
+	(*DiskIO)(nil),                    // 35: fstmon.dto.DiskIO
+	(*DiskIOMap)(nil),                 // 36: fstmon.dto.DiskIOMap
+	(*GetPartitionsRequest)(nil),      // 37: fstmon.dto.GetPartitionsRequest
+	(*GetDiskIORequest)(nil),          // 38: fstmon.dto.GetDiskIORequest
+	(*PartitionsResponse)(nil),        // 39: fstmon.dto.PartitionsResponse
+	(*DiskIOMapResponse)(nil),         // 40: fstmon.dto.DiskIOMapResponse
+	(*WorkerSelfStats)(nil),           // 41: fstmon.dto.WorkerSelfStats
+	(*RuntimeStats)(nil),              // 42: fstmon.dto.RuntimeStats
+	(*ProcessStats)(nil),              // 43: fstmon.dto.ProcessStats
+	(*SelfMetrics)(nil),               // 44: fstmon.dto.SelfMetrics
+	(*GetSelfMetricsRequest)(nil),     // 45: fstmon.dto.GetSelfMetricsRequest
+	(*SelfMetricsResponse)(nil),       // 46: fstmon.dto.SelfMetricsResponse
+	(*ProcessInfo)(nil),               // 47: fstmon.dto.ProcessInfo
+	(*GetProcessesRequest)(nil),       // 48: fstmon.dto.GetProcessesRequest
+	(*ProcessesResponse)(nil),         // 49: fstmon.dto.ProcessesResponse
+	(*PressureLine)(nil),              // 50: fstmon.dto.PressureLine
+	(*PressureStats)(nil),             // 51: fstmon.dto.PressureStats
+	(*Pressure)(nil),                  // 52: fstmon.dto.Pressure
+	(*CgroupPressure)(nil),            // 53: fstmon.dto.CgroupPressure
+	(*GetPressureRequest)(nil),        // 54: fstmon.dto.GetPressureRequest
+	(*PressureResponse)(nil),          // 55: fstmon.dto.PressureResponse
+	(*ProtocolCounter)(nil),           // 56: fstmon.dto.ProtocolCounter
+	(*TCPProtocol)(nil),               // 57: fstmon.dto.TCPProtocol
+	(*UDPProtocol)(nil),               // 58: fstmon.dto.UDPProtocol
+	(*ICMPProtocol)(nil),              // 59: fstmon.dto.ICMPProtocol
+	(*GetNetProtocolsRequest)(nil),    // 60: fstmon.dto.GetNetProtocolsRequest
+	(*NetProtocolsResponse)(nil),      // 61: fstmon.dto.NetProtocolsResponse
+	(*CollectorInfo)(nil),             // 62: fstmon.dto.CollectorInfo
+	(*ListCollectorsRequest)(nil),     // 63: fstmon.dto.ListCollectorsRequest
+	(*ListCollectorsResponse)(nil),    // 64: fstmon.dto.ListCollectorsResponse
+	(*GetCollectorMetricRequest)(nil), // 65: fstmon.dto.GetCollectorMetricRequest
+	(*CollectorMetricResponse)(nil),   // 66: fstmon.dto.CollectorMetricResponse
+	nil,                               // 67: fstmon.dto.InterfacesIO.InterfacesEntry
+	nil,                               // 68: fstmon.dto.ThermalMetricsMap.SensorsEntry
+	nil,                               // 69: fstmon.dto.DiskIOMap.DisksEntry
+	(*durationpb.Duration)(nil),       // 70: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 71: google.protobuf.Timestamp
 }
 var file_dto_proto_depIdxs = []int32{
-	37, // 0: fstmon.dto.IODuration.summary:type_name -> google.protobuf.Duration
-	37, // 1: fstmon.dto.IODuration.rx:type_name -> google.protobuf.Duration
-	37, // 2: fstmon.dto.IODuration.tx:type_name -> google.protobuf.Duration
-	3,  // 3: fstmon.dto.CpuPackage.cores:type_name -> fstmon.dto.CpuCoreInfo
-	5,  // 4: fstmon.dto.CpuMetrics.average:type_name -> fstmon.dto.CpuCoreMetrics
-	5,  // 5: fstmon.dto.CpuMetrics.cores:type_name -> fstmon.dto.CpuCoreMetrics
-	4,  // 6: fstmon.dto.CpuPackageResponse.cpu:type_name -> fstmon.dto.CpuPackage
-	6,  // 7: fstmon.dto.CpuMetricsResponse.metrics:type_name -> fstmon.dto.CpuMetrics
-	0,  // 8: fstmon.dto.InterfaceIO.bytes_total:type_name -> fstmon.dto.IOUint64
-	0,  // 9: fstmon.dto.InterfaceIO.packets_total:type_name -> fstmon.dto.IOUint64
-	0,  // 10: fstmon.dto.InterfaceIO.error_packets_total:type_name -> fstmon.dto.IOUint64
-	0,  // 11: fstmon.dto.InterfaceIO.drop_packets_total:type_name -> fstmon.dto.IOUint64
-	0,  // 12: fstmon.dto.InterfaceIO.bytes_per_sec:type_name -> fstmon.dto.IOUint64
-	0,  // 13: fstmon.dto.InterfaceIO.packets_per_sec:type_name -> fstmon.dto.IOUint64
-	34, // 14: fstmon.dto.InterfacesIO.interfaces:type_name -> fstmon.dto.InterfacesIO.InterfacesEntry
-	12, // 15: fstmon.dto.InterfacesIOResponse.data:type_name -> fstmon.dto.InterfacesIO
-	37, // 16: fstmon.dto.SystemInfo.uptime:type_name -> google.protobuf.Duration
-	37, // 17: fstmon.dto.SystemInfo.idle:type_name -> google.protobuf.Duration
-	15, // 18: fstmon.dto.SystemInfoResponse.system:type_name -> fstmon.dto.SystemInfo
-	18, // 19: fstmon.dto.MemoryMetricsResponse.memory:type_name -> fstmon.dto.MemoryMetrics
-	35, // 20: fstmon.dto.ThermalMetricsMap.sensors:type_name -> fstmon.dto.ThermalMetricsMap.SensorsEntry
-	22, // 21: fstmon.dto.ThermalResponse.metrics:type_name -> fstmon.dto.ThermalMetricsMap
-	25, // 22: fstmon.dto.Partition.usage:type_name -> fstmon.dto.PartitionUsage
-	26, // 23: fstmon.dto.Partitions.partitions:type_name -> fstmon.dto.Partition
-	0,  // 24: fstmon.dto.DiskIO.ops:type_name -> fstmon.dto.IOUint64
-	0,  // 25: fstmon.dto.DiskIO.merged_ops:type_name -> fstmon.dto.IOUint64
-	0,  // 26: fstmon.dto.DiskIO.bytes:type_name -> fstmon.dto.IOUint64
-	0,  // 27: fstmon.dto.DiskIO.ops_per_sec:type_name -> fstmon.dto.IOUint64
-	0,  // 28: fstmon.dto.DiskIO.merged_ops_per_sec:type_name -> fstmon.dto.IOUint64
-	0,  // 29: fstmon.dto.DiskIO.bytes_per_sec:type_name -> fstmon.dto.IOUint64
-	2,  // 30: fstmon.dto.DiskIO.time:type_name -> fstmon.dto.IODuration
-	37, // 31: fstmon.dto.DiskIO.io_time:type_name -> google.protobuf.Duration
-	37, // 32: fstmon.dto.DiskIO.weighted_io:type_name -> google.protobuf.Duration
-	36, // 33: fstmon.dto.DiskIOMap.disks:type_name -> fstmon.dto.DiskIOMap.DisksEntry
-	27, // 34: fstmon.dto.PartitionsResponse.partitions:type_name -> fstmon.dto.Partitions
-	29, // 35: fstmon.dto.DiskIOMapResponse.io:type_name -> fstmon.dto.DiskIOMap
-	11, // 36: fstmon.dto.InterfacesIO.InterfacesEntry.value:type_name -> fstmon.dto.InterfaceIO
-	21, // 37: fstmon.dto.ThermalMetricsMap.SensorsEntry.value:type_name -> fstmon.dto.ThermalMetrics
-	28, // 38: fstmon.dto.DiskIOMap.DisksEntry.value:type_name -> fstmon.dto.DiskIO
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	70,  // 0: fstmon.dto.IODuration.summary:type_name -> google.protobuf.Duration
+	70,  // 1: fstmon.dto.IODuration.rx:type_name -> google.protobuf.Duration
+	70,  // 2: fstmon.dto.IODuration.tx:type_name -> google.protobuf.Duration
+	71,  // 3: fstmon.dto.MetricStatus.last_update:type_name -> google.protobuf.Timestamp
+	71,  // 4: fstmon.dto.MetricStatus.last_success:type_name -> google.protobuf.Timestamp
+	71,  // 5: fstmon.dto.MetricStatus.last_error_time:type_name -> google.protobuf.Timestamp
+	70,  // 6: fstmon.dto.MetricStatus.retry_in:type_name -> google.protobuf.Duration
+	70,  // 7: fstmon.dto.MetricStatus.age:type_name -> google.protobuf.Duration
+	4,   // 8: fstmon.dto.CpuPackage.cores:type_name -> fstmon.dto.CpuCoreInfo
+	6,   // 9: fstmon.dto.CpuCoreMetrics.times:type_name -> fstmon.dto.CpuTimes
+	7,   // 10: fstmon.dto.CpuMetrics.average:type_name -> fstmon.dto.CpuCoreMetrics
+	7,   // 11: fstmon.dto.CpuMetrics.cores:type_name -> fstmon.dto.CpuCoreMetrics
+	8,   // 12: fstmon.dto.CpuMetrics.activity:type_name -> fstmon.dto.CpuActivity
+	5,   // 13: fstmon.dto.CpuPackageResponse.cpu:type_name -> fstmon.dto.CpuPackage
+	3,   // 14: fstmon.dto.CpuPackageResponse.status:type_name -> fstmon.dto.MetricStatus
+	9,   // 15: fstmon.dto.CpuMetricsResponse.metrics:type_name -> fstmon.dto.CpuMetrics
+	3,   // 16: fstmon.dto.CpuMetricsResponse.status:type_name -> fstmon.dto.MetricStatus
+	0,   // 17: fstmon.dto.InterfaceIO.bytes_total:type_name -> fstmon.dto.IOUint64
+	0,   // 18: fstmon.dto.InterfaceIO.packets_total:type_name -> fstmon.dto.IOUint64
+	0,   // 19: fstmon.dto.InterfaceIO.error_packets_total:type_name -> fstmon.dto.IOUint64
+	0,   // 20: fstmon.dto.InterfaceIO.drop_packets_total:type_name -> fstmon.dto.IOUint64
+	0,   // 21: fstmon.dto.InterfaceIO.bytes_per_sec:type_name -> fstmon.dto.IOUint64
+	0,   // 22: fstmon.dto.InterfaceIO.packets_per_sec:type_name -> fstmon.dto.IOUint64
+	15,  // 23: fstmon.dto.InterfaceIO.link:type_name -> fstmon.dto.InterfaceLink
+	67,  // 24: fstmon.dto.InterfacesIO.interfaces:type_name -> fstmon.dto.InterfacesIO.InterfacesEntry
+	16,  // 25: fstmon.dto.InterfacesIOResponse.data:type_name -> fstmon.dto.InterfacesIO
+	3,   // 26: fstmon.dto.InterfacesIOResponse.status:type_name -> fstmon.dto.MetricStatus
+	70,  // 27: fstmon.dto.SystemInfo.uptime:type_name -> google.protobuf.Duration
+	70,  // 28: fstmon.dto.SystemInfo.idle:type_name -> google.protobuf.Duration
+	19,  // 29: fstmon.dto.SystemInfoResponse.system:type_name -> fstmon.dto.SystemInfo
+	3,   // 30: fstmon.dto.SystemInfoResponse.status:type_name -> fstmon.dto.MetricStatus
+	23,  // 31: fstmon.dto.MemoryMetrics.huge_pages:type_name -> fstmon.dto.HugePages
+	24,  // 32: fstmon.dto.MemoryMetrics.zram:type_name -> fstmon.dto.Zram
+	25,  // 33: fstmon.dto.MemoryMetrics.activity:type_name -> fstmon.dto.MemoryActivity
+	22,  // 34: fstmon.dto.MemoryMetricsResponse.memory:type_name -> fstmon.dto.MemoryMetrics
+	3,   // 35: fstmon.dto.MemoryMetricsResponse.status:type_name -> fstmon.dto.MetricStatus
+	68,  // 36: fstmon.dto.ThermalMetricsMap.sensors:type_name -> fstmon.dto.ThermalMetricsMap.SensorsEntry
+	29,  // 37: fstmon.dto.ThermalResponse.metrics:type_name -> fstmon.dto.ThermalMetricsMap
+	3,   // 38: fstmon.dto.ThermalResponse.status:type_name -> fstmon.dto.MetricStatus
+	32,  // 39: fstmon.dto.Partition.usage:type_name -> fstmon.dto.PartitionUsage
+	33,  // 40: fstmon.dto.Partitions.partitions:type_name -> fstmon.dto.Partition
+	0,   // 41: fstmon.dto.DiskIO.ops:type_name -> fstmon.dto.IOUint64
+	0,   // 42: fstmon.dto.DiskIO.merged_ops:type_name -> fstmon.dto.IOUint64
+	0,   // 43: fstmon.dto.DiskIO.bytes:type_name -> fstmon.dto.IOUint64
+	0,   // 44: fstmon.dto.DiskIO.ops_per_sec:type_name -> fstmon.dto.IOUint64
+	0,   // 45: fstmon.dto.DiskIO.merged_ops_per_sec:type_name -> fstmon.dto.IOUint64
+	0,   // 46: fstmon.dto.DiskIO.bytes_per_sec:type_name -> fstmon.dto.IOUint64
+	2,   // 47: fstmon.dto.DiskIO.time:type_name -> fstmon.dto.IODuration
+	70,  // 48: fstmon.dto.DiskIO.io_time:type_name -> google.protobuf.Duration
+	70,  // 49: fstmon.dto.DiskIO.weighted_io:type_name -> google.protobuf.Duration
+	69,  // 50: fstmon.dto.DiskIOMap.disks:type_name -> fstmon.dto.DiskIOMap.DisksEntry
+	34,  // 51: fstmon.dto.PartitionsResponse.partitions:type_name -> fstmon.dto.Partitions
+	3,   // 52: fstmon.dto.PartitionsResponse.status:type_name -> fstmon.dto.MetricStatus
+	36,  // 53: fstmon.dto.DiskIOMapResponse.io:type_name -> fstmon.dto.DiskIOMap
+	3,   // 54: fstmon.dto.DiskIOMapResponse.status:type_name -> fstmon.dto.MetricStatus
+	70,  // 55: fstmon.dto.WorkerSelfStats.last_duration:type_name -> google.protobuf.Duration
+	70,  // 56: fstmon.dto.WorkerSelfStats.avg_duration:type_name -> google.protobuf.Duration
+	70,  // 57: fstmon.dto.WorkerSelfStats.max_duration:type_name -> google.protobuf.Duration
+	70,  // 58: fstmon.dto.RuntimeStats.gc_pause_total:type_name -> google.protobuf.Duration
+	70,  // 59: fstmon.dto.RuntimeStats.gc_pause_last:type_name -> google.protobuf.Duration
+	70,  // 60: fstmon.dto.ProcessStats.cpu_user:type_name -> google.protobuf.Duration
+	70,  // 61: fstmon.dto.ProcessStats.cpu_system:type_name -> google.protobuf.Duration
+	41,  // 62: fstmon.dto.SelfMetrics.workers:type_name -> fstmon.dto.WorkerSelfStats
+	42,  // 63: fstmon.dto.SelfMetrics.runtime:type_name -> fstmon.dto.RuntimeStats
+	43,  // 64: fstmon.dto.SelfMetrics.process:type_name -> fstmon.dto.ProcessStats
+	44,  // 65: fstmon.dto.SelfMetricsResponse.self:type_name -> fstmon.dto.SelfMetrics
+	3,   // 66: fstmon.dto.SelfMetricsResponse.status:type_name -> fstmon.dto.MetricStatus
+	47,  // 67: fstmon.dto.ProcessesResponse.processes:type_name -> fstmon.dto.ProcessInfo
+	3,   // 68: fstmon.dto.ProcessesResponse.status:type_name -> fstmon.dto.MetricStatus
+	70,  // 69: fstmon.dto.PressureLine.total:type_name -> google.protobuf.Duration
+	70,  // 70: fstmon.dto.PressureLine.stall:type_name -> google.protobuf.Duration
+	50,  // 71: fstmon.dto.PressureStats.some:type_name -> fstmon.dto.PressureLine
+	50,  // 72: fstmon.dto.PressureStats.full:type_name -> fstmon.dto.PressureLine
+	51,  // 73: fstmon.dto.Pressure.cpu:type_name -> fstmon.dto.PressureStats
+	51,  // 74: fstmon.dto.Pressure.memory:type_name -> fstmon.dto.PressureStats
+	51,  // 75: fstmon.dto.Pressure.io:type_name -> fstmon.dto.PressureStats
+	52,  // 76: fstmon.dto.CgroupPressure.pressure:type_name -> fstmon.dto.Pressure
+	52,  // 77: fstmon.dto.PressureResponse.host:type_name -> fstmon.dto.Pressure
+	53,  // 78: fstmon.dto.PressureResponse.cgroups:type_name -> fstmon.dto.CgroupPressure
+	3,   // 79: fstmon.dto.PressureResponse.status:type_name -> fstmon.dto.MetricStatus
+	56,  // 80: fstmon.dto.TCPProtocol.active_opens:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 81: fstmon.dto.TCPProtocol.passive_opens:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 82: fstmon.dto.TCPProtocol.attempt_fails:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 83: fstmon.dto.TCPProtocol.estab_resets:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 84: fstmon.dto.TCPProtocol.out_resets:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 85: fstmon.dto.TCPProtocol.in_segs:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 86: fstmon.dto.TCPProtocol.out_segs:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 87: fstmon.dto.TCPProtocol.retransmits:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 88: fstmon.dto.TCPProtocol.in_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 89: fstmon.dto.TCPProtocol.in_csum_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 90: fstmon.dto.TCPProtocol.listen_overflows:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 91: fstmon.dto.TCPProtocol.listen_drops:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 92: fstmon.dto.TCPProtocol.timeouts:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 93: fstmon.dto.TCPProtocol.syn_retrans:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 94: fstmon.dto.TCPProtocol.fast_retrans:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 95: fstmon.dto.TCPProtocol.lost_retransmit:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 96: fstmon.dto.TCPProtocol.abort_on_timeout:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 97: fstmon.dto.TCPProtocol.backlog_drop:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 98: fstmon.dto.UDPProtocol.in_datagrams:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 99: fstmon.dto.UDPProtocol.out_datagrams:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 100: fstmon.dto.UDPProtocol.no_ports:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 101: fstmon.dto.UDPProtocol.in_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 102: fstmon.dto.UDPProtocol.rcvbuf_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 103: fstmon.dto.UDPProtocol.sndbuf_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 104: fstmon.dto.ICMPProtocol.in_msgs:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 105: fstmon.dto.ICMPProtocol.out_msgs:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 106: fstmon.dto.ICMPProtocol.in_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 107: fstmon.dto.ICMPProtocol.out_errors:type_name -> fstmon.dto.ProtocolCounter
+	56,  // 108: fstmon.dto.ICMPProtocol.in_dest_unreachs:type_name -> fstmon.dto.ProtocolCounter
+	57,  // 109: fstmon.dto.NetProtocolsResponse.tcp:type_name -> fstmon.dto.TCPProtocol
+	58,  // 110: fstmon.dto.NetProtocolsResponse.udp:type_name -> fstmon.dto.UDPProtocol
+	59,  // 111: fstmon.dto.NetProtocolsResponse.icmp:type_name -> fstmon.dto.ICMPProtocol
+	3,   // 112: fstmon.dto.NetProtocolsResponse.status:type_name -> fstmon.dto.MetricStatus
+	70,  // 113: fstmon.dto.CollectorInfo.default_interval:type_name -> google.protobuf.Duration
+	62,  // 114: fstmon.dto.ListCollectorsResponse.collectors:type_name -> fstmon.dto.CollectorInfo
+	3,   // 115: fstmon.dto.CollectorMetricResponse.status:type_name -> fstmon.dto.MetricStatus
+	14,  // 116: fstmon.dto.InterfacesIO.InterfacesEntry.value:type_name -> fstmon.dto.InterfaceIO
+	28,  // 117: fstmon.dto.ThermalMetricsMap.SensorsEntry.value:type_name -> fstmon.dto.ThermalMetrics
+	35,  // 118: fstmon.dto.DiskIOMap.DisksEntry.value:type_name -> fstmon.dto.DiskIO
+	119, // [119:119] is the sub-list for method output_type
+	119, // [119:119] is the sub-list for method input_type
+	119, // [119:119] is the sub-list for extension type_name
+	119, // [119:119] is the sub-list for extension extendee
+	0,   // [0:119] is the sub-list for field type_name
 }
 
 func init() { file_dto_proto_init() }
@@ -2180,13 +5414,14 @@ func file_dto_proto_init() {
 	if File_dto_proto != nil {
 		return
 	}
+	file_dto_proto_msgTypes[47].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dto_proto_rawDesc), len(file_dto_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
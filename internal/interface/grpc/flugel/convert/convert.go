@@ -4,11 +4,41 @@
 package convert
 
 import (
+	"time"
+
 	"github.com/eterline/fstmon/internal/domain"
 	"github.com/eterline/fstmon/internal/interface/grpc/flugel/common"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ============================ Metric status ============================
+
+func timeToMessage(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// MetricStatusToMessage – converts metric scrape health to its message.
+func MetricStatusToMessage(a domain.ActualMetric) *common.MetricStatus {
+	return &common.MetricStatus{
+		Stale:               a.Stale,
+		LastUpdate:          timeToMessage(a.LastUpdate),
+		LastSuccess:         timeToMessage(a.Status.LastSuccess),
+		LastError:           a.Status.LastError,
+		LastErrorTime:       timeToMessage(a.Status.LastErrorTime),
+		ConsecutiveFailures: int32(a.Status.ConsecutiveFailures),
+		RetryIn:             durationpb.New(a.RetryIn),
+		Timeouts:            int32(a.Status.Timeouts),
+		Panics:              int32(a.Status.Panics),
+		Restored:            a.Restored,
+		Freshness:           a.Freshness.String(),
+		Age:                 durationpb.New(a.Age),
+	}
+}
+
 // ============================ CPU structures ============================
 
 func cpuPackageDomainToDTO(d *domain.CpuPackage) *common.CpuPackage {
@@ -45,6 +75,18 @@ func cpuCoreMetricsDomainToDTO(d domain.CpuCoreMetrics) *common.CpuCoreMetrics {
 	return &common.CpuCoreMetrics{
 		Load:      d.Load,
 		Frequency: d.Frequency,
+		Times: &common.CpuTimes{
+			User:      d.Times.User,
+			Nice:      d.Times.Nice,
+			System:    d.Times.System,
+			Idle:      d.Times.Idle,
+			Iowait:    d.Times.Iowait,
+			Irq:       d.Times.IRQ,
+			Softirq:   d.Times.SoftIRQ,
+			Steal:     d.Times.Steal,
+			Guest:     d.Times.Guest,
+			GuestNice: d.Times.GuestNice,
+		},
 	}
 }
 
@@ -61,6 +103,16 @@ func cpuMetricsDomainToDTO(d *domain.CpuMetrics) *common.CpuMetrics {
 	return &common.CpuMetrics{
 		Average: cpuCoreMetricsDomainToDTO(d.Average),
 		Cores:   cores,
+		Activity: &common.CpuActivity{
+			ContextSwitches:       d.Activity.ContextSwitches,
+			ContextSwitchesPerSec: d.Activity.ContextSwitchesPerSec,
+			Interrupts:            d.Activity.Interrupts,
+			InterruptsPerSec:      d.Activity.InterruptsPerSec,
+			Forks:                 d.Activity.Forks,
+			ForksPerSec:           d.Activity.ForksPerSec,
+			ProcsRunning:          d.Activity.ProcsRunning,
+			ProcsBlocked:          d.Activity.ProcsBlocked,
+		},
 	}
 }
 
@@ -80,6 +132,19 @@ func interfaceIODomainToDTO(i domain.InterfaceIO) *common.InterfaceIO {
 		DropPacketsTotal:  toIOUint64(i.DropPacketsTotal),
 		BytesPerSec:       toIOUint64(i.BytesPerSec),
 		PacketsPerSec:     toIOUint64(i.PacketsPerSec),
+		Link: &common.InterfaceLink{
+			Kind:      string(i.Link.Kind),
+			OperState: i.Link.OperState,
+			Carrier:   i.Link.Carrier,
+			SpeedMbps: i.Link.SpeedMbps,
+			Duplex:    i.Link.Duplex,
+			Mtu:       i.Link.MTU,
+			Mac:       i.Link.MAC,
+			Master:    i.Link.Master,
+			Ipv4:      i.Link.IPv4,
+			Ipv6:      i.Link.IPv6,
+		},
+		UtilizationPercent: i.UtilizationPercent,
 	}
 }
 
@@ -138,6 +203,41 @@ func MemoryMetricsToResponse(m *domain.MemoryMetrics) *common.MemoryMetricsRespo
 			SwapFree:        m.SwapFree,
 			UsedPercent:     m.UsedPercent,
 			SwapUsedPercent: m.SwapUsedPercent,
+			Buffers:         m.Buffers,
+			Cached:          m.Cached,
+			SwapCached:      m.SwapCached,
+			Shmem:           m.Shmem,
+			Slab:            m.Slab,
+			Sreclaimable:    m.SReclaimable,
+			Sunreclaim:      m.SUnreclaim,
+			Dirty:           m.Dirty,
+			Writeback:       m.Writeback,
+			CommittedAs:     m.CommittedAS,
+			CommitLimit:     m.CommitLimit,
+			HugePages: &common.HugePages{
+				Total:    m.HugePages.Total,
+				Free:     m.HugePages.Free,
+				Reserved: m.HugePages.Reserved,
+				Surplus:  m.HugePages.Surplus,
+				PageSize: m.HugePages.PageSize,
+			},
+			Zswap:    m.Zswap,
+			Zswapped: m.Zswapped,
+			Zram: &common.Zram{
+				Devices:    int32(m.Zram.Devices),
+				OrigData:   m.Zram.OrigData,
+				Compressed: m.Zram.Compressed,
+				MemUsed:    m.Zram.MemUsed,
+			},
+			Activity: &common.MemoryActivity{
+				PageFaultsPerSec:  m.Activity.PageFaultsPerSec,
+				MajorFaultsPerSec: m.Activity.MajorFaultsPerSec,
+				SwapInPerSec:      m.Activity.SwapInPerSec,
+				SwapOutPerSec:     m.Activity.SwapOutPerSec,
+				PageInPerSec:      m.Activity.PageInPerSec,
+				PageOutPerSec:     m.Activity.PageOutPerSec,
+				OomKills:          m.Activity.OOMKills,
+			},
 		},
 	}
 }
@@ -262,3 +362,211 @@ func diskIOMapToMessage(m domain.DiskIOMap) *common.DiskIOMap {
 		Disks: disks,
 	}
 }
+
+// ============================ Self monitoring structures ============================
+
+func selfMetricsToDTO(d *domain.SelfMetrics) *common.SelfMetrics {
+	workers := make([]*common.WorkerSelfStats, len(d.Workers))
+	for i, w := range d.Workers {
+		workers[i] = &common.WorkerSelfStats{
+			Key:          w.Key,
+			LastDuration: durationpb.New(w.LastDuration),
+			AvgDuration:  durationpb.New(w.AvgDuration),
+			MaxDuration:  durationpb.New(w.MaxDuration),
+			Successes:    int32(w.Successes),
+			Errors:       int32(w.Errors),
+			Timeouts:     int32(w.Timeouts),
+			Panics:       int32(w.Panics),
+			Skipped:      int32(w.Skipped),
+			Overlaps:     int32(w.Overlaps),
+		}
+	}
+
+	return &common.SelfMetrics{
+		Workers: workers,
+		Runtime: &common.RuntimeStats{
+			Goroutines:   int32(d.Runtime.Goroutines),
+			HeapAlloc:    d.Runtime.HeapAlloc,
+			HeapInuse:    d.Runtime.HeapInuse,
+			HeapSys:      d.Runtime.HeapSys,
+			HeapObjects:  d.Runtime.HeapObjects,
+			NextGc:       d.Runtime.NextGC,
+			NumGc:        d.Runtime.NumGC,
+			GcPauseTotal: durationpb.New(d.Runtime.GCPauseTotal),
+			GcPauseLast:  durationpb.New(d.Runtime.GCPauseLast),
+		},
+		Process: &common.ProcessStats{
+			Pid:             d.Process.Pid,
+			Threads:         d.Process.Threads,
+			Rss:             d.Process.RSS,
+			VirtualMemory:   d.Process.VirtualMemory,
+			OpenFds:         int32(d.Process.OpenFDs),
+			FdSize:          d.Process.FDSize,
+			CpuUser:         durationpb.New(d.Process.CPUUser),
+			CpuSystem:       durationpb.New(d.Process.CPUSystem),
+			VoluntaryCtxt:   d.Process.VoluntaryCtxt,
+			InvoluntaryCtxt: d.Process.InvoluntaryCtxt,
+		},
+	}
+}
+
+func SelfMetricsToResponse(d *domain.SelfMetrics) *common.SelfMetricsResponse {
+	return &common.SelfMetricsResponse{
+		Self: selfMetricsToDTO(d),
+	}
+}
+
+// ============================ Process structures ============================
+
+// ProcessesToResponse – converts the top processes, total is the number of host processes.
+func ProcessesToResponse(top domain.ProcessList, total int) *common.ProcessesResponse {
+	res := &common.ProcessesResponse{
+		Processes: make([]*common.ProcessInfo, len(top)),
+		Total:     int32(total),
+	}
+
+	for i, p := range top {
+		res.Processes[i] = &common.ProcessInfo{
+			Pid:        int32(p.PID),
+			Ppid:       int32(p.PPID),
+			Name:       p.Name,
+			Command:    p.Command,
+			State:      p.State,
+			User:       p.User,
+			CpuPercent: p.CPUPercent,
+			Rss:        p.RSS,
+			Pss:        p.PSS,
+			Threads:    int32(p.Threads),
+			Fds:        int32(p.FDs),
+			ReadBytes:  p.ReadBytes,
+			WriteBytes: p.WriteBytes,
+			ReadRate:   p.ReadRate,
+			WriteRate:  p.WriteRate,
+		}
+	}
+
+	return res
+}
+
+// ============================ Pressure structures ============================
+
+func pressureLineToMessage(l domain.PressureLine) *common.PressureLine {
+	return &common.PressureLine{
+		Avg10:        l.Avg10,
+		Avg60:        l.Avg60,
+		Avg300:       l.Avg300,
+		Total:        durationpb.New(l.Total),
+		Stall:        durationpb.New(l.Stall),
+		StallPercent: l.StallPercent,
+	}
+}
+
+func pressureToMessage(p domain.Pressure) *common.Pressure {
+	stats := func(s domain.PressureStats) *common.PressureStats {
+		return &common.PressureStats{
+			Some: pressureLineToMessage(s.Some),
+			Full: pressureLineToMessage(s.Full),
+		}
+	}
+
+	return &common.Pressure{
+		Cpu:    stats(p.CPU),
+		Memory: stats(p.Memory),
+		Io:     stats(p.IO),
+	}
+}
+
+// PressureToResponse – converts the host PSI and the top cgroups.
+func PressureToResponse(m domain.PressureMetrics, top []domain.CgroupPressure) *common.PressureResponse {
+	res := &common.PressureResponse{
+		Available:    m.Available,
+		Host:         pressureToMessage(m.Host),
+		Cgroups:      make([]*common.CgroupPressure, len(top)),
+		TotalCgroups: int32(len(m.Cgroups)),
+	}
+
+	for i, c := range top {
+		res.Cgroups[i] = &common.CgroupPressure{
+			Path:     c.Path,
+			Kind:     string(c.Kind),
+			Name:     c.Name,
+			Pressure: pressureToMessage(c.Pressure),
+		}
+	}
+
+	return res
+}
+
+// ============================ Network protocols structures ============================
+
+func protocolCounterToMessage(c domain.ProtocolCounter) *common.ProtocolCounter {
+	return &common.ProtocolCounter{
+		Total:  c.Total,
+		PerSec: c.PerSec,
+	}
+}
+
+// NetProtocolsToResponse – converts the protocol counters to the gRPC response.
+func NetProtocolsToResponse(m domain.NetProtocolMetrics) *common.NetProtocolsResponse {
+	c := protocolCounterToMessage
+
+	return &common.NetProtocolsResponse{
+		Tcp: &common.TCPProtocol{
+			CurrEstab:         m.TCP.CurrEstab,
+			ActiveOpens:       c(m.TCP.ActiveOpens),
+			PassiveOpens:      c(m.TCP.PassiveOpens),
+			AttemptFails:      c(m.TCP.AttemptFails),
+			EstabResets:       c(m.TCP.EstabResets),
+			OutResets:         c(m.TCP.OutResets),
+			InSegs:            c(m.TCP.InSegs),
+			OutSegs:           c(m.TCP.OutSegs),
+			Retransmits:       c(m.TCP.Retransmits),
+			RetransmitPercent: m.TCP.RetransmitPercent,
+			InErrors:          c(m.TCP.InErrors),
+			InCsumErrors:      c(m.TCP.InCsumErrors),
+			ListenOverflows:   c(m.TCP.ListenOverflows),
+			ListenDrops:       c(m.TCP.ListenDrops),
+			Timeouts:          c(m.TCP.Timeouts),
+			SynRetrans:        c(m.TCP.SynRetrans),
+			FastRetrans:       c(m.TCP.FastRetrans),
+			LostRetransmit:    c(m.TCP.LostRetransmit),
+			AbortOnTimeout:    c(m.TCP.AbortOnTimeout),
+			BacklogDrop:       c(m.TCP.BacklogDrop),
+		},
+		Udp: &common.UDPProtocol{
+			InDatagrams:  c(m.UDP.InDatagrams),
+			OutDatagrams: c(m.UDP.OutDatagrams),
+			NoPorts:      c(m.UDP.NoPorts),
+			InErrors:     c(m.UDP.InErrors),
+			RcvbufErrors: c(m.UDP.RcvbufErrors),
+			SndbufErrors: c(m.UDP.SndbufErrors),
+		},
+		Icmp: &common.ICMPProtocol{
+			InMsgs:         c(m.ICMP.InMsgs),
+			OutMsgs:        c(m.ICMP.OutMsgs),
+			InErrors:       c(m.ICMP.InErrors),
+			OutErrors:      c(m.ICMP.OutErrors),
+			InDestUnreachs: c(m.ICMP.InDestUnreachs),
+		},
+	}
+}
+
+// ============================ Collectors structures ============================
+
+// CollectorsToResponse – converts collector metadata to the list response.
+func CollectorsToResponse(cs []domain.CollectorInfo) *common.ListCollectorsResponse {
+	res := &common.ListCollectorsResponse{
+		Collectors: make([]*common.CollectorInfo, len(cs)),
+	}
+
+	for i, c := range cs {
+		res.Collectors[i] = &common.CollectorInfo{
+			Name:            c.Name,
+			Description:     c.Description,
+			Units:           c.Units,
+			DefaultInterval: durationpb.New(c.DefaultInterval),
+		}
+	}
+
+	return res
+}
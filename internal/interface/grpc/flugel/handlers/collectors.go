@@ -0,0 +1,58 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/interface/grpc/flugel/common"
+	"github.com/eterline/fstmon/internal/interface/grpc/flugel/convert"
+)
+
+type CollectorLister interface {
+	Infos() []domain.CollectorInfo
+}
+
+type collectorHandlers struct {
+	common.UnimplementedCollectorServiceServer
+	log        *slog.Logger
+	store      ActualStateStore
+	collectors CollectorLister
+}
+
+func NewCollectorHandlers(l *slog.Logger, s ActualStateStore, cl CollectorLister) *collectorHandlers {
+	return &collectorHandlers{
+		log:        l,
+		store:      s,
+		collectors: cl,
+	}
+}
+
+func (ch *collectorHandlers) ListCollectors(context.Context, *common.ListCollectorsRequest) (*common.ListCollectorsResponse, error) {
+	return convert.CollectorsToResponse(ch.collectors.Infos()), nil
+}
+
+// GetCollectorMetric – returns the value of any collector encoded as JSON.
+func (ch *collectorHandlers) GetCollectorMetric(ctx context.Context, r *common.GetCollectorMetricRequest) (*common.CollectorMetricResponse, error) {
+	data, actual, err := GetMetric(ctx, ch.store, domain.NewKey[any](r.GetName()))
+	if err != nil {
+		ch.log.Error("failed get collector metric", "error", err, "metric_key", r.GetName())
+		return nil, err
+	}
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		ch.log.Error("failed encode collector metric", "error", err, "metric_key", r.GetName())
+		return nil, err
+	}
+
+	return &common.CollectorMetricResponse{
+		Name:   r.GetName(),
+		Value:  value,
+		Status: convert.MetricStatusToMessage(actual),
+	}, nil
+}
@@ -7,41 +7,60 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
+	"github.com/eterline/fstmon/internal/domain"
 	"github.com/eterline/fstmon/internal/infra/log"
 	pb "github.com/eterline/fstmon/internal/interface/grpc/flugel/common"
 	"google.golang.org/grpc"
 )
 
 type ActualStateStore interface {
-	ActualMetric(key string) (value any, scheduleExists bool, stateExists bool, retryIn time.Duration)
+	ActualMetric(ctx context.Context, key string) (actual domain.ActualMetric, scheduleExists bool, stateExists bool)
 }
 
-func GetMetric[T any](ass ActualStateStore, key string) (T, error) {
+// ExpiryPolicy – optional ActualStateStore policy of expired values.
+type ExpiryPolicy interface {
+	ServeExpired() bool
+}
+
+/*
+GetMetric – fetches the metric value of type T with its scrape health.
+
+	The returned value may be stale when the latest scrapes failed or
+	it is older than the stale threshold, this is reported through
+	actual.Stale and actual.Freshness. Expired values fail unless the
+	store serves them.
+*/
+func GetMetric[T any](ctx context.Context, ass ActualStateStore, k domain.Key[T]) (T, domain.ActualMetric, error) {
 	var zero T
+	key := k.Name()
 
-	value, scheduleExists, stateExists, _ := ass.ActualMetric(key)
+	actual, scheduleExists, stateExists := ass.ActualMetric(ctx, key)
 	if !scheduleExists {
-		return zero, fmt.Errorf("worker not exists under key: '%s'", key)
+		return zero, actual, fmt.Errorf("worker not exists under key: '%s'", key)
 	}
 
 	if !stateExists {
-		return zero, fmt.Errorf("metric not exists yet: '%s'", key)
+		return zero, actual, fmt.Errorf("metric not exists yet: '%s'", key)
+	}
+
+	if p, ok := ass.(ExpiryPolicy); actual.Freshness == domain.FreshnessExpired && (!ok || !p.ServeExpired()) {
+		return zero, actual, fmt.Errorf("metric value expired: '%s'", key)
 	}
 
-	casted, ok := value.(T)
+	casted, ok := actual.Value.(T)
 	if !ok {
-		return zero, errors.New("store type mismatch")
+		return zero, actual, errors.New("store type mismatch")
 	}
 
-	return casted, nil
+	return casted, actual, nil
 }
 
 // TODO: make another app instance for grpc agent
-func RegisterToGrpcServer(ctx context.Context, s *grpc.Server, a ActualStateStore) {
+func RegisterToGrpcServer(ctx context.Context, s *grpc.Server, a ActualStateStore, cl CollectorLister) {
 	log := log.MustLoggerFromContext(ctx)
 
 	log.Info("init grpc server handlers")
 	pb.RegisterMachineInfoServiceServer(s, NewMachineInfohandlers(log, a))
+	pb.RegisterCollectorServiceServer(s, NewCollectorHandlers(log, a, cl))
 }
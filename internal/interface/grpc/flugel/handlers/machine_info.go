@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/eterline/fstmon/internal/domain"
@@ -28,99 +29,183 @@ func NewMachineInfohandlers(l *slog.Logger, s ActualStateStore) *machineInfohand
 // ==========================
 
 func (cs *machineInfohandlers) GetCpuInfo(ctx context.Context, r *common.GetCpuInfoRequest) (*common.CpuPackageResponse, error) {
-	data, err := GetMetric[domain.CpuPackage](cs.store, "cpu")
+	data, actual, err := GetMetric(ctx, cs.store, domain.KeyCpu)
 	if err != nil {
 		cs.log.Error("failed get cpu info", "error", err)
 		return nil, err
 	}
 
 	res := convert.CpuPackageToResponse(&data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
 func (cs *machineInfohandlers) GetCpuMetrics(ctx context.Context, r *common.GetCpuMetricsRequest) (*common.CpuMetricsResponse, error) {
-	data, err := GetMetric[domain.CpuMetrics](cs.store, "cpu_usage")
+	data, actual, err := GetMetric(ctx, cs.store, domain.KeyCpuUsage)
 	if err != nil {
 		cs.log.Error("failed get cpu metrics", "error", err)
 		return nil, err
 	}
 
 	res := convert.CpuMetricsToResponse(&data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
 // ==========================
 
-func (nh *machineInfohandlers) GetMemoryMetrics(context.Context, *common.GetMemoryMetricsRequest) (*common.MemoryMetricsResponse, error) {
-	data, err := GetMetric[domain.MemoryMetrics](nh.store, "memory")
+func (nh *machineInfohandlers) GetMemoryMetrics(ctx context.Context, _ *common.GetMemoryMetricsRequest) (*common.MemoryMetricsResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyMemory)
 	if err != nil {
 		nh.log.Error("failed get memory metrics", "error", err)
 		return nil, err
 	}
 
 	res := convert.MemoryMetricsToResponse(&data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
 // ==========================
 
-func (nh *machineInfohandlers) GetInterfacesIO(context.Context, *common.GetInterfacesIORequest) (*common.InterfacesIOResponse, error) {
-	data, err := GetMetric[domain.InterfacesIOMap](nh.store, "net_io")
+func (nh *machineInfohandlers) GetInterfacesIO(ctx context.Context, _ *common.GetInterfacesIORequest) (*common.InterfacesIOResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyNetIO)
 	if err != nil {
 		nh.log.Error("failed get interfaces io", "error", err)
 		return nil, err
 	}
 
 	res := convert.InterfacesIOMapToResponse(data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
 // ==========================
 
-func (nh *machineInfohandlers) GetPartitions(context.Context, *common.GetPartitionsRequest) (*common.PartitionsResponse, error) {
-	data, err := GetMetric[domain.Partitions](nh.store, "partitions")
+func (nh *machineInfohandlers) GetPartitions(ctx context.Context, _ *common.GetPartitionsRequest) (*common.PartitionsResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyPartitions)
 	if err != nil {
 		nh.log.Error("failed get partitions", "error", err)
 		return nil, err
 	}
 
 	res := convert.PartitionsToMessage(data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
-func (nh *machineInfohandlers) GetDiskIO(context.Context, *common.GetDiskIORequest) (*common.DiskIOMapResponse, error) {
-	data, err := GetMetric[domain.DiskIOMap](nh.store, "disk_io")
+func (nh *machineInfohandlers) GetDiskIO(ctx context.Context, _ *common.GetDiskIORequest) (*common.DiskIOMapResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyDiskIO)
 	if err != nil {
 		nh.log.Error("failed get disk io", "error", err)
 		return nil, err
 	}
 
 	res := convert.DiskIOMapResponseToMessage(data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
 // ==========================
 
-func (nh *machineInfohandlers) GetSystemInfo(context.Context, *common.GetSystemInfoRequest) (*common.SystemInfoResponse, error) {
-	data, err := GetMetric[domain.SystemInfo](nh.store, "system")
+func (nh *machineInfohandlers) GetSystemInfo(ctx context.Context, _ *common.GetSystemInfoRequest) (*common.SystemInfoResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeySystem)
 	if err != nil {
 		nh.log.Error("failed get system info", "error", err)
 		return nil, err
 	}
 
 	res := convert.SystemInfoToResponse(&data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
 
 // ==========================
 
-func (nh *machineInfohandlers) GetThermal(context.Context, *common.GetThermalRequest) (*common.ThermalResponse, error) {
-	data, err := GetMetric[domain.ThermalMetricsMap](nh.store, "thermal")
+func (nh *machineInfohandlers) GetThermal(ctx context.Context, _ *common.GetThermalRequest) (*common.ThermalResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyThermal)
 	if err != nil {
 		nh.log.Error("failed get thernal metrics", "error", err)
 		return nil, err
 	}
 
 	res := convert.ThermalMetricsMapToResponse(data)
+	res.Status = convert.MetricStatusToMessage(actual)
+	return res, nil
+}
+
+// ==========================
+
+func (nh *machineInfohandlers) GetSelfMetrics(ctx context.Context, _ *common.GetSelfMetricsRequest) (*common.SelfMetricsResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeySelf)
+	if err != nil {
+		nh.log.Error("failed get self metrics", "error", err)
+		return nil, err
+	}
+
+	res := convert.SelfMetricsToResponse(&data)
+	res.Status = convert.MetricStatusToMessage(actual)
+	return res, nil
+}
+
+func (nh *machineInfohandlers) GetProcesses(ctx context.Context, r *common.GetProcessesRequest) (*common.ProcessesResponse, error) {
+	by, ok := domain.ParseTopSort(r.GetSort())
+	if !ok {
+		return nil, fmt.Errorf("invalid processes sort: '%s'", r.GetSort())
+	}
+
+	limit := int(r.GetLimit())
+	switch {
+	case limit == 0:
+		limit = domain.TopDefaultLimit
+	case limit < 0 || limit > domain.TopMaxLimit:
+		return nil, fmt.Errorf("invalid processes limit: %d, expected 1..%d", limit, domain.TopMaxLimit)
+	}
+
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyProcesses)
+	if err != nil {
+		nh.log.Error("failed get processes", "error", err)
+		return nil, err
+	}
+
+	res := convert.ProcessesToResponse(data.Top(by, limit), len(data))
+	res.Status = convert.MetricStatusToMessage(actual)
+	return res, nil
+}
+
+func (nh *machineInfohandlers) GetPressure(ctx context.Context, r *common.GetPressureRequest) (*common.PressureResponse, error) {
+	by, ok := domain.ParseTopSort(r.GetSort())
+	if !ok {
+		return nil, fmt.Errorf("invalid pressure sort: '%s'", r.GetSort())
+	}
+
+	limit := int(r.GetLimit())
+	switch {
+	case limit == 0:
+		limit = domain.TopDefaultLimit
+	case limit < 0 || limit > domain.TopMaxLimit:
+		return nil, fmt.Errorf("invalid pressure limit: %d, expected 1..%d", limit, domain.TopMaxLimit)
+	}
+
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyPressure)
+	if err != nil {
+		nh.log.Error("failed get pressure", "error", err)
+		return nil, err
+	}
+
+	res := convert.PressureToResponse(data, data.TopCgroups(by, limit))
+	res.Status = convert.MetricStatusToMessage(actual)
+	return res, nil
+}
+
+func (nh *machineInfohandlers) GetNetProtocols(ctx context.Context, _ *common.GetNetProtocolsRequest) (*common.NetProtocolsResponse, error) {
+	data, actual, err := GetMetric(ctx, nh.store, domain.KeyProtocols)
+	if err != nil {
+		nh.log.Error("failed get network protocols", "error", err)
+		return nil, err
+	}
+
+	res := convert.NetProtocolsToResponse(data)
+	res.Status = convert.MetricStatusToMessage(actual)
 	return res, nil
 }
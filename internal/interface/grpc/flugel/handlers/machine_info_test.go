@@ -0,0 +1,61 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package handlers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/interface/grpc/flugel/common"
+)
+
+// fakeStore – serves fresh values by metric key.
+type fakeStore map[string]any
+
+func (fs fakeStore) ActualMetric(_ context.Context, key string) (domain.ActualMetric, bool, bool) {
+	v, ok := fs[key]
+	return domain.ActualMetric{Value: v, Freshness: domain.FreshnessFresh}, ok, ok
+}
+
+func Test_GetProcesses(t *testing.T) {
+	procs := make(domain.ProcessList, 10)
+	for i := range procs {
+		procs[i] = domain.ProcessInfo{PID: i + 1, CPUPercent: float64(i), RSS: uint64(10 - i)}
+	}
+	nh := NewMachineInfohandlers(slog.New(slog.NewTextHandler(io.Discard, nil)), fakeStore{domain.KeyProcesses.Name(): procs})
+
+	tests := []struct {
+		name  string
+		sort  string
+		limit int32
+		ok    bool
+		count int
+		first int32
+	}{
+		{"defaults", "", 0, true, domain.TopDefaultLimit, 10},
+		{"mem", "mem", 3, true, 3, 1},
+		{"max limit", "io", domain.TopMaxLimit, true, 10, 1},
+		{"bad sort", "disk", 0, false, 0, 0},
+		{"negative limit", "cpu", -1, false, 0, 0},
+		{"limit over max", "cpu", domain.TopMaxLimit + 1, false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := nh.GetProcesses(context.Background(), &common.GetProcessesRequest{Sort: tt.sort, Limit: tt.limit})
+			if (err == nil) != tt.ok {
+				t.Fatalf("GetProcesses() error = %v, want ok %v", err, tt.ok)
+			}
+			if !tt.ok {
+				return
+			}
+			if len(res.GetProcesses()) != tt.count || res.GetProcesses()[0].GetPid() != tt.first || res.GetTotal() != int32(len(procs)) {
+				t.Errorf("processes = %v, want %d from pid %d", res.GetProcesses(), tt.count, tt.first)
+			}
+		})
+	}
+}
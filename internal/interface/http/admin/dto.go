@@ -0,0 +1,124 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httpadmin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+const (
+	minWorkerInterval = time.Second
+	maxWorkerInterval = 24 * time.Hour
+)
+
+// DTOWorker – runtime state of a metric worker.
+type DTOWorker struct {
+	Key      string `json:"key"`      // "cpu_usage"
+	Mode     string `json:"mode"`     // "periodic" | "lazy"
+	Interval string `json:"interval"` // "10s"
+	Timeout  string `json:"timeout"`  // "7.5s"
+	Paused   bool   `json:"paused"`   // planned scrapes are suspended
+	Running  bool   `json:"running"`  // worker call is in flight
+
+	LastRun             string `json:"last_run,omitempty"`        // RFC3339 start time of the last scrape
+	LastDuration        string `json:"last_duration,omitempty"`   // "12ms"
+	LastSuccess         string `json:"last_success,omitempty"`    // RFC3339 time of the last successful scrape
+	LastError           string `json:"last_error,omitempty"`      // "failed scrape disk I/O: ..."
+	LastErrorTime       string `json:"last_error_time,omitempty"` // RFC3339 time of the last scrape error
+	ConsecutiveFailures int    `json:"consecutive_failures"`      // failed scrapes since the last success
+	Timeouts            int    `json:"timeouts"`                  // scrapes aborted by the deadline
+	Panics              int    `json:"panics"`                    // worker panics recovered
+	Successes           int    `json:"successes"`                 // successful scrapes
+	Errors              int    `json:"errors"`                    // failed scrapes
+	Skipped             int    `json:"skipped"`                   // planned ticks skipped by a paused or idle worker
+	Overlaps            int    `json:"overlaps"`                  // ticks rejected while the previous call still ran
+	AvgDuration         string `json:"avg_duration,omitempty"`    // "9ms"
+	MaxDuration         string `json:"max_duration,omitempty"`    // "31ms"
+	Backoff             string `json:"backoff,omitempty"`         // "40s"
+	NextScrape          string `json:"next_scrape,omitempty"`     // RFC3339 time of the planned scrape
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatDuration(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return d.String()
+}
+
+func Domain2DTOWorker(w domain.WorkerInfo) DTOWorker {
+	return DTOWorker{
+		Key:      w.Key,
+		Mode:     w.Mode,
+		Interval: w.Interval.String(),
+		Timeout:  w.Timeout.String(),
+		Paused:   w.Paused,
+		Running:  w.Running,
+
+		LastRun:             formatTime(w.Status.LastRun),
+		LastDuration:        formatDuration(w.Status.LastDuration),
+		LastSuccess:         formatTime(w.Status.LastSuccess),
+		LastError:           w.Status.LastError,
+		LastErrorTime:       formatTime(w.Status.LastErrorTime),
+		ConsecutiveFailures: w.Status.ConsecutiveFailures,
+		Timeouts:            w.Status.Timeouts,
+		Panics:              w.Status.Panics,
+		Successes:           w.Status.Successes,
+		Errors:              w.Status.Errors,
+		Skipped:             w.Status.Skipped,
+		Overlaps:            w.Status.Overlaps,
+		AvgDuration:         formatDuration(w.Status.AvgDuration()),
+		MaxDuration:         formatDuration(w.Status.MaxDuration),
+		Backoff:             formatDuration(w.Status.Backoff),
+		NextScrape:          formatTime(w.Status.NextScrape),
+	}
+}
+
+func Domain2DTOWorkers(ws []domain.WorkerInfo) []DTOWorker {
+	dtos := make([]DTOWorker, len(ws))
+	for i, w := range ws {
+		dtos[i] = Domain2DTOWorker(w)
+	}
+	return dtos
+}
+
+// DTOScrapeResult – fresh metric value of a triggered scrape.
+type DTOScrapeResult struct {
+	Worker     DTOWorker `json:"worker"`
+	LastUpdate string    `json:"last_update,omitempty"` // RFC3339 time of the value
+	Value      any       `json:"value"`
+}
+
+// ============================ Requests ============================
+
+// DTOIntervalRequest – new worker interval, e.g. {"interval": "30s"}.
+type DTOIntervalRequest struct {
+	Interval string `json:"interval"`
+}
+
+func (r DTOIntervalRequest) Duration() (time.Duration, error) {
+	return time.ParseDuration(r.Interval)
+}
+
+func (r DTOIntervalRequest) Validate() error {
+	d, err := r.Duration()
+	if err != nil {
+		return err
+	}
+
+	if d < minWorkerInterval || d > maxWorkerInterval {
+		return fmt.Errorf("interval must be in range %s..%s", minWorkerInterval, maxWorkerInterval)
+	}
+
+	return nil
+}
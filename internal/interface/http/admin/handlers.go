@@ -0,0 +1,197 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httpadmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+	"github.com/eterline/fstmon/internal/interface/http/api"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/go-chi/chi/v5"
+)
+
+type WorkerController interface {
+	Workers() []domain.WorkerInfo
+	Worker(key string) (domain.WorkerInfo, error)
+	PauseWorker(key string) (domain.WorkerInfo, error)
+	ResumeWorker(key string) (domain.WorkerInfo, error)
+	SetWorkerInterval(key string, interval time.Duration) (domain.WorkerInfo, error)
+	TriggerScrape(ctx context.Context, key string) (domain.ActualMetric, error)
+}
+
+type AdminHandlerGroup struct {
+	workers WorkerController
+}
+
+func New(wc WorkerController) *AdminHandlerGroup {
+	return &AdminHandlerGroup{
+		workers: wc,
+	}
+}
+
+/*
+Routes – mounts worker control handlers.
+
+	GET  /workers                 – list of workers
+	GET  /workers/{key}           – single worker
+	POST /workers/{key}/pause     – suspend planned scrapes
+	POST /workers/{key}/resume    – resume planned scrapes
+	PUT  /workers/{key}/interval  – change interval: {"interval": "30s"}
+	POST /workers/{key}/scrape    – immediate scrape, waits for the fresh value
+*/
+func (ahg *AdminHandlerGroup) Routes(r chi.Router) {
+	r.Get("/workers", ahg.HandleWorkers)
+	r.Route("/workers/{key}", func(r chi.Router) {
+		r.Get("/", ahg.HandleWorker)
+		r.Post("/pause", ahg.HandlePause)
+		r.Post("/resume", ahg.HandleResume)
+		r.Put("/interval", ahg.HandleInterval)
+		r.Post("/scrape", ahg.HandleScrape)
+	})
+}
+
+// writeControlError – maps control errors to HTTP responses.
+func writeControlError(w http.ResponseWriter, r *http.Request, key string, err error) {
+	code := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, monitor.ErrWorkerNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, monitor.ErrInvalidInterval):
+		code = http.StatusBadRequest
+	case errors.Is(err, monitor.ErrPoolerNotRunning):
+		code = http.StatusServiceUnavailable
+	case errors.Is(err, monitor.ErrScrapeBusy):
+		code = http.StatusConflict // retryable, the running scrape is not a failure
+	}
+
+	if err := api.NewResponse().
+		SetCode(code).
+		SetMessage("worker control failed").
+		AddError(err).
+		Write(w); err != nil {
+		log.MustLoggerFromContext(r.Context()).Error("response error", "error", err, "worker_key", key)
+	}
+}
+
+func writeWorker(w http.ResponseWriter, r *http.Request, info domain.WorkerInfo) {
+	err := api.NewResponse().WrapData(Domain2DTOWorker(info)).Write(w)
+	if err != nil {
+		log.MustLoggerFromContext(r.Context()).Error("response error", "error", err)
+	}
+}
+
+func (ahg *AdminHandlerGroup) HandleWorkers(w http.ResponseWriter, r *http.Request) {
+	err := api.NewResponse().WrapData(Domain2DTOWorkers(ahg.workers.Workers())).Write(w)
+	if err != nil {
+		log.MustLoggerFromContext(r.Context()).Error("response error", "error", err)
+	}
+}
+
+func (ahg *AdminHandlerGroup) HandleWorker(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "key")
+
+	info, err := ahg.workers.Worker(key)
+	if err != nil {
+		writeControlError(w, r, key, err)
+		return
+	}
+
+	writeWorker(w, r, info)
+}
+
+func (ahg *AdminHandlerGroup) HandlePause(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "key")
+
+	info, err := ahg.workers.PauseWorker(key)
+	if err != nil {
+		writeControlError(w, r, key, err)
+		return
+	}
+
+	log.MustLoggerFromContext(r.Context()).Warn("metric worker paused", "worker_key", key)
+	writeWorker(w, r, info)
+}
+
+func (ahg *AdminHandlerGroup) HandleResume(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "key")
+
+	info, err := ahg.workers.ResumeWorker(key)
+	if err != nil {
+		writeControlError(w, r, key, err)
+		return
+	}
+
+	log.MustLoggerFromContext(r.Context()).Warn("metric worker resumed", "worker_key", key)
+	writeWorker(w, r, info)
+}
+
+func (ahg *AdminHandlerGroup) HandleInterval(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "key")
+	log := log.MustLoggerFromContext(r.Context())
+
+	req, err := api.ExtractJSON[DTOIntervalRequest](r)
+	if err != nil {
+		if err := api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid request").
+			AddError(err).
+			Write(w); err != nil {
+			log.Error("response error", "error", err)
+		}
+		return
+	}
+
+	interval, _ := req.Duration() // validated by ExtractJSON
+
+	info, err := ahg.workers.SetWorkerInterval(key, interval)
+	if err != nil {
+		writeControlError(w, r, key, err)
+		return
+	}
+
+	log.Warn("metric worker interval changed", "worker_key", key, "interval", interval)
+	writeWorker(w, r, info)
+}
+
+func (ahg *AdminHandlerGroup) HandleScrape(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "key")
+	log := log.MustLoggerFromContext(r.Context())
+
+	actual, scrapeErr := ahg.workers.TriggerScrape(r.Context(), key)
+	if errors.Is(scrapeErr, monitor.ErrWorkerNotFound) ||
+		errors.Is(scrapeErr, monitor.ErrPoolerNotRunning) ||
+		errors.Is(scrapeErr, monitor.ErrScrapeBusy) ||
+		scrapeErr != nil && r.Context().Err() != nil {
+		writeControlError(w, r, key, scrapeErr)
+		return
+	}
+
+	info, err := ahg.workers.Worker(key)
+	if err != nil {
+		writeControlError(w, r, key, err)
+		return
+	}
+
+	res := api.NewResponse().WrapData(DTOScrapeResult{
+		Worker:     Domain2DTOWorker(info),
+		LastUpdate: formatTime(actual.LastUpdate),
+		Value:      actual.Value,
+	})
+
+	if scrapeErr != nil {
+		res.SetCode(http.StatusInternalServerError).
+			SetMessage("scrape failed").
+			AddError(scrapeErr)
+	}
+
+	if err := res.Write(w); err != nil {
+		log.Error("response error", "error", err)
+	}
+}
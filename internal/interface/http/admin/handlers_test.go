@@ -0,0 +1,75 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httpadmin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+	"github.com/eterline/fstmon/internal/services/monitor"
+	"github.com/go-chi/chi/v5"
+)
+
+// fakeWorkers – controller whose scrapes fail with a fixed error.
+type fakeWorkers struct {
+	scrapeErr error
+}
+
+func (fw fakeWorkers) Workers() []domain.WorkerInfo { return nil }
+
+func (fw fakeWorkers) Worker(key string) (domain.WorkerInfo, error) {
+	return domain.WorkerInfo{Key: key}, nil
+}
+
+func (fw fakeWorkers) PauseWorker(key string) (domain.WorkerInfo, error)  { return fw.Worker(key) }
+func (fw fakeWorkers) ResumeWorker(key string) (domain.WorkerInfo, error) { return fw.Worker(key) }
+
+func (fw fakeWorkers) SetWorkerInterval(key string, _ time.Duration) (domain.WorkerInfo, error) {
+	return fw.Worker(key)
+}
+
+func (fw fakeWorkers) TriggerScrape(context.Context, string) (domain.ActualMetric, error) {
+	return domain.ActualMetric{}, fw.scrapeErr
+}
+
+func Test_HandleScrape(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"fresh value", nil, http.StatusOK},
+		{"busy", monitor.ErrScrapeBusy, http.StatusConflict},
+		{"unknown worker", monitor.ErrWorkerNotFound, http.StatusNotFound},
+		{"pooler stopped", monitor.ErrPoolerNotRunning, http.StatusServiceUnavailable},
+		{"collector failure", errors.New("failed scrape thermal metrics"), http.StatusInternalServerError},
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			New(fakeWorkers{scrapeErr: tt.err}).Routes(r)
+
+			req := httptest.NewRequest(http.MethodPost, "/workers/thermal/scrape", nil)
+			req = req.WithContext(log.WrapLoggerToContext(req.Context(), logger))
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.code {
+				t.Errorf("code = %d, want %d: %s", w.Code, tt.code, w.Body)
+			}
+		})
+	}
+}
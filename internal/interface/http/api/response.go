@@ -14,6 +14,7 @@ type ResponseWrapQuery interface {
 	SetMessage(msg string) ResponseWrapQuery
 
 	WrapData(data any) ResponseWrapQuery
+	WrapMeta(meta any) ResponseWrapQuery
 	AddError(err ...error) ResponseWrapQuery
 	AddStringError(err ...string) ResponseWrapQuery
 
@@ -32,6 +33,7 @@ type ResponseHttpWrapper struct {
 	Message string   `json:"message,omitempty"` // Optional descriptive message
 	Errors  []string `json:"errors,omitempty"`  // Array of error messages
 	Data    any      `json:"data,omitempty"`    // Optional payload of type T
+	Meta    any      `json:"meta,omitempty"`    // Optional payload metadata
 }
 
 // initErrs – ensures Errors slice is initialized with at least startLen capacity.
@@ -59,6 +61,12 @@ func (r *ResponseHttpWrapper) WrapData(data any) ResponseWrapQuery {
 	return r
 }
 
+// WrapMeta – sets the payload metadata and returns the wrapper for chaining.
+func (r *ResponseHttpWrapper) WrapMeta(meta any) ResponseWrapQuery {
+	r.Meta = meta
+	return r
+}
+
 // AddError – adds one or more error values to the Errors slice.
 func (r *ResponseHttpWrapper) AddError(err ...error) ResponseWrapQuery {
 	r.initErrs(len(err))
@@ -0,0 +1,31 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httpcollectors
+
+import "github.com/eterline/fstmon/internal/domain"
+
+// DTOCollector – metadata of an enabled collector.
+type DTOCollector struct {
+	Name            string `json:"name"`                  // "disk_io"
+	Description     string `json:"description,omitempty"` // "Block devices I/O counters and rates"
+	Units           string `json:"units,omitempty"`       // "bytes"
+	DefaultInterval string `json:"default_interval"`      // "10s"
+}
+
+func Domain2DTOCollector(c domain.CollectorInfo) DTOCollector {
+	return DTOCollector{
+		Name:            c.Name,
+		Description:     c.Description,
+		Units:           c.Units,
+		DefaultInterval: c.DefaultInterval.String(),
+	}
+}
+
+func Domain2DTOCollectors(cs []domain.CollectorInfo) []DTOCollector {
+	dto := make([]DTOCollector, len(cs))
+	for i, c := range cs {
+		dto[i] = Domain2DTOCollector(c)
+	}
+	return dto
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httpcollectors
+
+import (
+	"net/http"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+	"github.com/eterline/fstmon/internal/interface/http/api"
+	httphomepage "github.com/eterline/fstmon/internal/interface/http/homepage"
+	"github.com/go-chi/chi/v5"
+)
+
+type CollectorLister interface {
+	Infos() []domain.CollectorInfo
+}
+
+type CollectorsHandlerGroup struct {
+	collectors  CollectorLister
+	actualStore httphomepage.ActualStateStore
+}
+
+func New(cl CollectorLister, ass httphomepage.ActualStateStore) *CollectorsHandlerGroup {
+	return &CollectorsHandlerGroup{
+		collectors:  cl,
+		actualStore: ass,
+	}
+}
+
+/*
+Routes – mounts generic collector handlers.
+
+	GET /collectors         – list of enabled collectors
+	GET /collectors/{name}  – raw value of the collector
+*/
+func (chg *CollectorsHandlerGroup) Routes(r chi.Router) {
+	r.Get("/collectors", chg.HandleCollectors)
+	r.Get("/collectors/{name}", chg.HandleCollector)
+}
+
+func (chg *CollectorsHandlerGroup) HandleCollectors(w http.ResponseWriter, r *http.Request) {
+	dto := Domain2DTOCollectors(chg.collectors.Infos())
+
+	if err := api.OkDataResponse(dto).Write(w); err != nil {
+		log.MustLoggerFromContext(r.Context()).Error("response error", "error", err)
+	}
+}
+
+// HandleCollector – serves the collector value as is, without homepage formatting.
+func (chg *CollectorsHandlerGroup) HandleCollector(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	name := chi.URLParam(r, "name")
+	meta := make(httphomepage.DTOMeta, 1)
+
+	value, ok := httphomepage.GetMetric(r.Context(), chg.actualStore, w, domain.NewKey[any](name), meta)
+	if !ok {
+		return
+	}
+
+	err := api.NewResponse().WrapData(value).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httphistory
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+// maxHistoryStep – widest allowed downsampling step.
+const maxHistoryStep = 24 * time.Hour
+
+// DTOSample – metric value saved at the timestamp.
+type DTOSample struct {
+	Timestamp string `json:"timestamp"` // RFC3339 time of the sample
+	Value     any    `json:"value"`
+}
+
+// DTOHistory – samples of a metric key ordered from the oldest one.
+type DTOHistory struct {
+	Key     string      `json:"key"`            // "cpu_usage"
+	Step    string      `json:"step,omitempty"` // "1m"
+	Count   int         `json:"count"`          // number of samples
+	Samples []DTOSample `json:"samples"`
+}
+
+func Domain2DTOHistory(key string, step time.Duration, ss []domain.Sample) DTOHistory {
+	dto := DTOHistory{
+		Key:     key,
+		Count:   len(ss),
+		Samples: make([]DTOSample, len(ss)),
+	}
+
+	if step > 0 {
+		dto.Step = step.String()
+	}
+
+	for i, s := range ss {
+		dto.Samples[i] = DTOSample{
+			Timestamp: s.Timestamp.Format(time.RFC3339Nano),
+			Value:     s.Value,
+		}
+	}
+
+	return dto
+}
+
+// DTOSeriesPoint – aggregate of the series over the bucket starting at Timestamp.
+type DTOSeriesPoint struct {
+	Timestamp string  `json:"timestamp"` // RFC3339 start of the bucket
+	Min       float64 `json:"min"`
+	Max       float64 `json:"max"`
+	Avg       float64 `json:"avg"`
+	Last      float64 `json:"last"`
+	Count     int     `json:"count"` // aggregated samples
+}
+
+// DTOSeries – points of a numeric series of the metric key.
+type DTOSeries struct {
+	Key        string           `json:"key"`        // "net_io"
+	Series     string           `json:"series"`     // "eth0.bytes_per_sec.rx"
+	Resolution string           `json:"resolution"` // "raw" | "1m0s" | "5m0s" | "1h0m0s"
+	Count      int              `json:"count"`      // number of points
+	Points     []DTOSeriesPoint `json:"points"`
+}
+
+func Domain2DTOSeries(key, name string, resolution time.Duration, ps []domain.SeriesPoint) DTOSeries {
+	dto := DTOSeries{
+		Key:        key,
+		Series:     name,
+		Resolution: "raw",
+		Count:      len(ps),
+		Points:     make([]DTOSeriesPoint, len(ps)),
+	}
+
+	if resolution > 0 {
+		dto.Resolution = resolution.String()
+	}
+
+	for i, p := range ps {
+		dto.Points[i] = DTOSeriesPoint{
+			Timestamp: p.Timestamp.Format(time.RFC3339),
+			Min:       p.Min,
+			Max:       p.Max,
+			Avg:       p.Avg,
+			Last:      p.Last,
+			Count:     p.Count,
+		}
+	}
+
+	return dto
+}
+
+// ============================ Requests ============================
+
+// historyQuery – parsed ?since=&until=&step= query.
+type historyQuery struct {
+	Since time.Time
+	Until time.Time
+	Step  time.Duration
+}
+
+/*
+parseTimeParam – parses a time bound of the history query.
+
+	Accepts RFC3339 time, unix seconds or a duration back from now,
+	e.g. "10m". Empty value returns zero time.
+*/
+func parseTimeParam(name, v string, now time.Time) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+
+	if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(sec, 0), nil
+	}
+
+	if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid '%s': expected RFC3339 time, unix seconds or duration ago", name)
+}
+
+func parseHistoryQuery(q url.Values, now time.Time) (historyQuery, error) {
+	var (
+		hq  historyQuery
+		err error
+	)
+
+	if hq.Since, err = parseTimeParam("since", q.Get("since"), now); err != nil {
+		return hq, err
+	}
+
+	if hq.Until, err = parseTimeParam("until", q.Get("until"), now); err != nil {
+		return hq, err
+	}
+
+	if !hq.Until.IsZero() && hq.Since.After(hq.Until) {
+		return hq, fmt.Errorf("'since' is after 'until'")
+	}
+
+	if v := q.Get("step"); v != "" {
+		hq.Step, err = time.ParseDuration(v)
+		if err != nil || hq.Step <= 0 || hq.Step > maxHistoryStep {
+			return hq, fmt.Errorf("invalid 'step': expected duration up to %s", maxHistoryStep)
+		}
+	}
+
+	return hq, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httphistory
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+	"github.com/eterline/fstmon/internal/interface/http/api"
+	"github.com/go-chi/chi/v5"
+)
+
+type HistoryReader interface {
+	Range(key string, since, until time.Time, step time.Duration) (samples []domain.Sample, ok bool)
+}
+
+type SeriesReader interface {
+	Series(key string) ([]string, bool)
+	Query(key, name string, since, until time.Time, step time.Duration) (points []domain.SeriesPoint, resolution time.Duration, ok bool)
+}
+
+type HistoryHandlerGroup struct {
+	history HistoryReader
+	series  SeriesReader
+}
+
+func New(hr HistoryReader, sr SeriesReader) *HistoryHandlerGroup {
+	return &HistoryHandlerGroup{
+		history: hr,
+		series:  sr,
+	}
+}
+
+/*
+Routes – mounts metric history handlers.
+
+	GET /history/{key}?since=&until=&step=                – raw samples of the key
+	GET /history/{key}/series                             – numeric series names of the key
+	GET /history/{key}/series/{name}?since=&until=&step=  – series points, resolution picked by the range
+*/
+func (hhg *HistoryHandlerGroup) Routes(r chi.Router) {
+	r.Get("/history/{key}", hhg.HandleHistory)
+	r.Get("/history/{key}/series", hhg.HandleSeriesList)
+	r.Get("/history/{key}/series/{name}", hhg.HandleSeries)
+}
+
+func writeQueryError(w http.ResponseWriter, err error) {
+	api.NewResponse().
+		SetCode(http.StatusBadRequest).
+		SetMessage("invalid history query").
+		AddError(err).
+		Write(w)
+}
+
+func writeNotFound(w http.ResponseWriter, msg string) {
+	api.NewResponse().
+		SetCode(http.StatusNotFound).
+		SetMessage("history not exists").
+		AddStringError(msg).
+		Write(w)
+}
+
+func (hhg *HistoryHandlerGroup) HandleHistory(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	key := chi.URLParam(r, "key")
+
+	q, err := parseHistoryQuery(r.URL.Query(), time.Now())
+	if err != nil {
+		writeQueryError(w, err)
+		return
+	}
+
+	samples, ok := hhg.history.Range(key, q.Since, q.Until, q.Step)
+	if !ok {
+		writeNotFound(w, "metric history not found")
+		log.Error("invalid metric history request", "metric_key", key)
+		return
+	}
+
+	dto := Domain2DTOHistory(key, q.Step, samples)
+
+	if err := api.OkDataResponse(dto).Write(w); err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HistoryHandlerGroup) HandleSeriesList(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	key := chi.URLParam(r, "key")
+
+	names, ok := hhg.series.Series(key)
+	if !ok {
+		writeNotFound(w, "metric has no numeric series")
+		return
+	}
+
+	if err := api.OkDataResponse(names).Write(w); err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HistoryHandlerGroup) HandleSeries(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	key, name := chi.URLParam(r, "key"), chi.URLParam(r, "name")
+
+	q, err := parseHistoryQuery(r.URL.Query(), time.Now())
+	if err != nil {
+		writeQueryError(w, err)
+		return
+	}
+
+	points, resolution, ok := hhg.series.Query(key, name, q.Since, q.Until, q.Step)
+	if !ok {
+		writeNotFound(w, "metric series not found")
+		log.Error("invalid metric series request", "metric_key", key, "series", name)
+		return
+	}
+
+	dto := Domain2DTOSeries(key, name, resolution, points)
+
+	if err := api.OkDataResponse(dto).Write(w); err != nil {
+		log.Error("response error", "error", err)
+	}
+}
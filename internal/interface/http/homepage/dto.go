@@ -5,20 +5,107 @@ package httphomepage
 
 import (
 	"fmt"
+	"net"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/eterline/fstmon/internal/domain"
 	"github.com/eterline/fstmon/internal/utils/sizes"
 	"github.com/eterline/fstmon/internal/utils/usecase"
 )
 
+// ============================ Metric status dto ============================
+
+// DTOMetricStatus – scrape health of a served metric.
+type DTOMetricStatus struct {
+	Stale               bool   `json:"stale"`                     // value is not fresh or kept from an earlier scrape
+	Freshness           string `json:"freshness"`                 // "fresh", "stale" or "expired"
+	AgeSec              int64  `json:"age_sec"`                   // seconds since the value update
+	Restored            bool   `json:"restored,omitempty"`        // value loaded from the snapshot
+	LastUpdate          string `json:"last_update"`               // RFC3339 time of the served value
+	LastSuccess         string `json:"last_success,omitempty"`    // RFC3339 time of the last successful scrape
+	LastError           string `json:"last_error,omitempty"`      // "failed scrape disk I/O: ..."
+	LastErrorTime       string `json:"last_error_time,omitempty"` // RFC3339 time of the last scrape error
+	ConsecutiveFailures int    `json:"consecutive_failures"`      // "3"
+	Timeouts            int    `json:"timeouts"`                  // scrapes aborted by the deadline
+	Panics              int    `json:"panics"`                    // worker panics recovered
+}
+
+// DTOMeta – metric keys to the scrape health of their values.
+type DTOMeta map[string]DTOMetricStatus
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func Domain2DTOMetricStatus(a domain.ActualMetric) DTOMetricStatus {
+	return DTOMetricStatus{
+		Stale:               a.Stale,
+		Freshness:           a.Freshness.String(),
+		AgeSec:              int64(a.Age.Seconds()),
+		Restored:            a.Restored,
+		LastUpdate:          formatTime(a.LastUpdate),
+		LastSuccess:         formatTime(a.Status.LastSuccess),
+		LastError:           a.Status.LastError,
+		LastErrorTime:       formatTime(a.Status.LastErrorTime),
+		ConsecutiveFailures: a.Status.ConsecutiveFailures,
+		Timeouts:            a.Status.Timeouts,
+		Panics:              a.Status.Panics,
+	}
+}
+
 // ============================ CPU dto ============================
 
+// DTOCpuTimes – shares of CPU time by state.
+type DTOCpuTimes struct {
+	User      string `json:"user"`       // "12.5%"
+	Nice      string `json:"nice"`       // "0.0%"
+	System    string `json:"system"`     // "3.1%"
+	Idle      string `json:"idle"`       // "80.2%"
+	Iowait    string `json:"iowait"`     // "1.4%"
+	IRQ       string `json:"irq"`        // "0.1%"
+	SoftIRQ   string `json:"softirq"`    // "0.4%"
+	Steal     string `json:"steal"`      // "2.3%"
+	Guest     string `json:"guest"`      // "0.0%"
+	GuestNice string `json:"guest_nice"` // "0.0%"
+}
+
+func cpuTimes2DTO(t domain.CpuTimes) DTOCpuTimes {
+	pct := func(v float64) string { return fmt.Sprintf("%.1f%%", v) }
+
+	return DTOCpuTimes{
+		User:      pct(t.User),
+		Nice:      pct(t.Nice),
+		System:    pct(t.System),
+		Idle:      pct(t.Idle),
+		Iowait:    pct(t.Iowait),
+		IRQ:       pct(t.IRQ),
+		SoftIRQ:   pct(t.SoftIRQ),
+		Steal:     pct(t.Steal),
+		Guest:     pct(t.Guest),
+		GuestNice: pct(t.GuestNice),
+	}
+}
+
 // DTOCpuCore – simplified per-core dynamic metrics.
 type DTOCpuCore struct {
-	Load      string `json:"load"`      // "12.5%"
-	Frequency string `json:"frequency"` // "3200MHz"
+	Load      string      `json:"load"`      // "12.5%"
+	Frequency string      `json:"frequency"` // "3200MHz"
+	Times     DTOCpuTimes `json:"times"`     // time breakdown by state
+}
+
+// DTOCpuActivity – kernel activity rates.
+type DTOCpuActivity struct {
+	ContextSwitches string `json:"context_switches"` // "12.4K/s"
+	Interrupts      string `json:"interrupts"`       // "8.1K/s"
+	Forks           string `json:"forks"`            // "15/s"
+	ProcsRunning    uint64 `json:"procs_running"`    // "3"
+	ProcsBlocked    uint64 `json:"procs_blocked"`    // "0"
 }
 
 // DTOCpu – aggregated CPU info for homepage.
@@ -28,9 +115,11 @@ type DTOCpu struct {
 	CoreCount   int    `json:"core_count"`   // "6"
 	ThreadCount int    `json:"thread_count"` // "12"
 
-	Load      string       `json:"load"`      // "15.4%"
-	Frequency string       `json:"frequency"` // "3250MHz"`
-	Cores     []DTOCpuCore `json:"cores"`     // per-core metrics
+	Load      string         `json:"load"`      // "15.4%"
+	Frequency string         `json:"frequency"` // "3250MHz"`
+	Times     DTOCpuTimes    `json:"times"`     // time breakdown across all cores
+	Activity  DTOCpuActivity `json:"activity"`  // kernel activity
+	Cores     []DTOCpuCore   `json:"cores"`     // per-core metrics
 }
 
 func Domain2DTOCpu(pkg domain.CpuPackage, m domain.CpuMetrics) *DTOCpu {
@@ -38,6 +127,11 @@ func Domain2DTOCpu(pkg domain.CpuPackage, m domain.CpuMetrics) *DTOCpu {
 		return &DTOCpu{}
 	}
 
+	rate := func(v uint64) string {
+		fv, unit := sizes.DetermMetricBase(v)
+		return fmt.Sprintf("%.1f%s/s", fv, unit)
+	}
+
 	dto := DTOCpu{
 		Vendor:      pkg.Vendor,
 		Model:       pkg.ModelName,
@@ -45,13 +139,22 @@ func Domain2DTOCpu(pkg domain.CpuPackage, m domain.CpuMetrics) *DTOCpu {
 		ThreadCount: pkg.Cores[0].Siblings,
 		Load:        fmt.Sprintf("%.1f%%", m.Average.Load),
 		Frequency:   fmt.Sprintf("%.1fMhz", m.Average.Frequency),
-		Cores:       make([]DTOCpuCore, len(m.Cores)),
+		Times:       cpuTimes2DTO(m.Average.Times),
+		Activity: DTOCpuActivity{
+			ContextSwitches: rate(m.Activity.ContextSwitchesPerSec),
+			Interrupts:      rate(m.Activity.InterruptsPerSec),
+			Forks:           rate(m.Activity.ForksPerSec),
+			ProcsRunning:    m.Activity.ProcsRunning,
+			ProcsBlocked:    m.Activity.ProcsBlocked,
+		},
+		Cores: make([]DTOCpuCore, len(m.Cores)),
 	}
 
 	for i, core := range m.Cores {
 		dto.Cores[i] = DTOCpuCore{
 			Load:      fmt.Sprintf("%.1f%%", core.Load),
 			Frequency: fmt.Sprintf("%.1fMhz", core.Frequency),
+			Times:     cpuTimes2DTO(core.Times),
 		}
 	}
 
@@ -69,29 +172,125 @@ type DTOMemoryT struct {
 	UsedTotalFree string `json:"used_total_free"` // "8.22GB/15.62GB/7.32GB"
 }
 
+// DTOMemoryDetails – kernel memory accounting.
+type DTOMemoryDetails struct {
+	Buffers      string `json:"buffers"`      // "120.5MB"
+	Cached       string `json:"cached"`       // "4.21GB"
+	SwapCached   string `json:"swap_cached"`  // "10.2MB"
+	Shmem        string `json:"shmem"`        // "300.1MB"
+	Slab         string `json:"slab"`         // "512.0MB"
+	SReclaimable string `json:"sreclaimable"` // "400.2MB"
+	SUnreclaim   string `json:"sunreclaim"`   // "111.8MB"
+	Dirty        string `json:"dirty"`        // "1.0MB"
+	Writeback    string `json:"writeback"`    // "0B"
+	Committed    string `json:"committed"`    // "19.07GB/11.44GB" committed and commit limit
+}
+
+// DTOHugePages – huge pages pool.
+type DTOHugePages struct {
+	Total    uint64 `json:"total"`     // "16"
+	Free     uint64 `json:"free"`      // "8"
+	Reserved uint64 `json:"reserved"`  // "2"
+	Surplus  uint64 `json:"surplus"`   // "0"
+	PageSize string `json:"page_size"` // "2.0MB"
+	Size     string `json:"size"`      // "32.0MB" size of the pool
+}
+
+// DTOCompressed – compressed swap in RAM.
+type DTOCompressed struct {
+	Stored string `json:"stored"` // "195.3MB" uncompressed data held
+	Used   string `json:"used"`   // "48.8MB" memory used to hold it
+	Ratio  string `json:"ratio"`  // "4.00x"
+}
+
+// DTOMemoryActivity – paging rates.
+type DTOMemoryActivity struct {
+	PageFaults  string `json:"page_faults"`  // "12.4K/s"
+	MajorFaults string `json:"major_faults"` // "3.0/s"
+	SwapIn      string `json:"swap_in"`      // "0.0/s" pages
+	SwapOut     string `json:"swap_out"`     // "15.0/s" pages
+	PageIn      string `json:"page_in"`      // "1.2MB/s"
+	PageOut     string `json:"page_out"`     // "3.4MB/s"
+	OOMKills    uint64 `json:"oom_kills"`    // since boot
+}
+
 type DTOMemory struct {
-	RAM  DTOMemoryT `json:"ram"`
-	Swap DTOMemoryT `json:"swap"`
+	RAM       DTOMemoryT        `json:"ram"`
+	Swap      DTOMemoryT        `json:"swap"`
+	Details   DTOMemoryDetails  `json:"details"`
+	HugePages DTOHugePages      `json:"huge_pages"`
+	Zswap     DTOCompressed     `json:"zswap"`
+	Zram      DTOCompressed     `json:"zram"`
+	Activity  DTOMemoryActivity `json:"activity"`
+}
+
+func compressed2DTO(stored, used uint64) DTOCompressed {
+	dto := DTOCompressed{
+		Stored: NewQBBSBuilder(0).Add(stored).Build(),
+		Used:   NewQBBSBuilder(0).Add(used).Build(),
+	}
+	if used > 0 {
+		dto.Ratio = fmt.Sprintf("%.2fx", float64(stored)/float64(used))
+	}
+	return dto
 }
 
 func Domain2DTOMemory(v domain.MemoryMetrics) *DTOMemory {
+	size := func(b uint64) string { return NewQBBSBuilder(0).Add(b).Build() }
+	rate := func(v uint64) string {
+		fv, unit := sizes.DetermMetricBase(v)
+		return fmt.Sprintf("%.1f%s/s", fv, unit)
+	}
+	act := v.Activity
+
 	return &DTOMemory{
 		RAM: DTOMemoryT{
-			Total:         NewQBBSBuilder(0).Add(sizes.KB.In(v.Total)).Build(),
-			Used:          NewQBBSBuilder(0).Add(sizes.KB.In(v.Used)).Build(),
-			Free:          NewQBBSBuilder(0).Add(sizes.KB.In(v.Free)).Build(),
+			Total:         size(v.Total),
+			Used:          size(v.Used),
+			Free:          size(v.Free),
 			UsedPercent:   fmt.Sprintf("%.1f%%", usecase.PercentOf(v.Total, v.Used)),
-			UsedTotal:     NewQBBSBuilder('/').Add(sizes.KB.In(v.Used)).Add(sizes.KB.In(v.Total)).Build(),
-			UsedTotalFree: NewQBBSBuilder('/').Add(sizes.KB.In(v.Used)).Add(sizes.KB.In(v.Total)).Add(sizes.KB.In(v.Free)).Build(),
+			UsedTotal:     NewQBBSBuilder('/').Add(v.Used).Add(v.Total).Build(),
+			UsedTotalFree: NewQBBSBuilder('/').Add(v.Used).Add(v.Total).Add(v.Free).Build(),
 		},
 		Swap: DTOMemoryT{
-			Total:         NewQBBSBuilder(0).Add(v.SwapTotal).Build(),
-			Used:          NewQBBSBuilder(0).Add(v.SwapUsed).Build(),
-			Free:          NewQBBSBuilder(0).Add(v.SwapFree).Build(),
+			Total:         size(v.SwapTotal),
+			Used:          size(v.SwapUsed),
+			Free:          size(v.SwapFree),
 			UsedPercent:   fmt.Sprintf("%.1f%%", usecase.PercentOf(v.SwapTotal, v.SwapUsed)),
 			UsedTotal:     NewQBBSBuilder('/').Add(v.SwapUsed).Add(v.SwapTotal).Build(),
 			UsedTotalFree: NewQBBSBuilder('/').Add(v.SwapUsed).Add(v.SwapTotal).Add(v.SwapFree).Build(),
 		},
+		Details: DTOMemoryDetails{
+			Buffers:      size(v.Buffers),
+			Cached:       size(v.Cached),
+			SwapCached:   size(v.SwapCached),
+			Shmem:        size(v.Shmem),
+			Slab:         size(v.Slab),
+			SReclaimable: size(v.SReclaimable),
+			SUnreclaim:   size(v.SUnreclaim),
+			Dirty:        size(v.Dirty),
+			Writeback:    size(v.Writeback),
+			Committed:    NewQBBSBuilder('/').Add(v.CommittedAS).Add(v.CommitLimit).Build(),
+		},
+		HugePages: DTOHugePages{
+			Total:    v.HugePages.Total,
+			Free:     v.HugePages.Free,
+			Reserved: v.HugePages.Reserved,
+			Surplus:  v.HugePages.Surplus,
+			PageSize: size(v.HugePages.PageSize),
+			Size:     size(v.HugePages.Total * v.HugePages.PageSize),
+		},
+		Zswap: compressed2DTO(v.Zswapped, v.Zswap),
+		Zram:  compressed2DTO(v.Zram.OrigData, v.Zram.MemUsed),
+		Activity: DTOMemoryActivity{
+			PageFaults:  rate(act.PageFaultsPerSec),
+			MajorFaults: rate(act.MajorFaultsPerSec),
+			SwapIn:      rate(act.SwapInPerSec),
+			SwapOut:     rate(act.SwapOutPerSec),
+			PageIn:      NewQBBSBuilder(0).AddMul(act.PageInPerSec, 1024).Build() + "/s",
+			PageOut:     NewQBBSBuilder(0).AddMul(act.PageOutPerSec, 1024).Build() + "/s",
+			OOMKills:    act.OOMKills,
+		},
 	}
 }
 
@@ -134,6 +333,46 @@ type DTONetworkInterfaceIO struct {
 
 	Errors uint64 `json:"errors"` // "0"
 	Drops  uint64 `json:"drops"`  // "0"
+
+	Summary        string   `json:"summary"`         // "eth0 1Gb/s up 12% utilized"
+	Kind           string   `json:"kind"`            // "physical", "bridge", "veth", ...
+	State          string   `json:"state"`           // "up"
+	Carrier        bool     `json:"carrier"`         // "true"
+	Speed          string   `json:"speed"`           // "1Gb/s", empty when unknown
+	Duplex         string   `json:"duplex"`          // "full"
+	MTU            uint64   `json:"mtu"`             // "1500"
+	MAC            string   `json:"mac"`             // "52:54:00:12:34:56"
+	Master         string   `json:"master"`          // "br0"
+	Addresses      []string `json:"addresses"`       // "192.168.1.10/24", "fe80::1/64"
+	Utilization    string   `json:"utilization"`     // "12.0%"
+	UtilizationRaw float64  `json:"utilization_raw"` // "12.0"
+}
+
+// linkSpeed – formats the link speed in Mb/s, e.g. "100Mb/s", "2.5Gb/s".
+func linkSpeed(mbps uint64) string {
+	switch {
+	case mbps == 0:
+		return ""
+	case mbps < 1000:
+		return fmt.Sprintf("%dMb/s", mbps)
+	default:
+		return strconv.FormatFloat(float64(mbps)/1000, 'f', -1, 64) + "Gb/s"
+	}
+}
+
+// linkSummary – one line description of the interface link.
+func linkSummary(name string, link domain.InterfaceLink, utilization float64) string {
+	parts := []string{name}
+	if speed := linkSpeed(link.SpeedMbps); speed != "" {
+		parts = append(parts, speed)
+	}
+	if link.OperState != "" {
+		parts = append(parts, link.OperState)
+	}
+	if link.SpeedMbps > 0 {
+		parts = append(parts, fmt.Sprintf("%.0f%% utilized", utilization))
+	}
+	return strings.Join(parts, " ")
 }
 
 type DTONetworkIO struct {
@@ -185,6 +424,19 @@ func Domain2DTONetworkInterfaceIO(v domain.InterfacesIOMap) *DTONetworkIO {
 
 			Errors: io.ErrPacketsTotal.Summary,
 			Drops:  io.DropPacketsTotal.Summary,
+
+			Summary:        linkSummary(name, io.Link, io.UtilizationPercent),
+			Kind:           string(io.Link.Kind),
+			State:          io.Link.OperState,
+			Carrier:        io.Link.Carrier,
+			Speed:          linkSpeed(io.Link.SpeedMbps),
+			Duplex:         io.Link.Duplex,
+			MTU:            io.Link.MTU,
+			MAC:            io.Link.MAC,
+			Master:         io.Link.Master,
+			Addresses:      slices.Concat(io.Link.IPv4, io.Link.IPv6),
+			Utilization:    fmt.Sprintf("%.1f%%", io.UtilizationPercent),
+			UtilizationRaw: io.UtilizationPercent,
 		}
 
 		dto.Interfaces[name] = iface
@@ -357,3 +609,700 @@ func Domain2DTODiskIOs(d domain.DiskIOMap) *DTODiskIOs {
 
 	return &s
 }
+
+// ============================ Self monitoring dto ============================
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// DTOWorkerSelf – scrape cost of a metric worker, durations in milliseconds.
+type DTOWorkerSelf struct {
+	Key            string  `json:"key"`              // "disk_io"
+	LastDurationMs float64 `json:"last_duration_ms"` // "1.42"
+	AvgDurationMs  float64 `json:"avg_duration_ms"`  // "1.17"
+	MaxDurationMs  float64 `json:"max_duration_ms"`  // "12.8"
+	Successes      int     `json:"successes"`        // successful scrapes
+	Errors         int     `json:"errors"`           // failed scrapes
+	Timeouts       int     `json:"timeouts"`         // scrapes aborted by the deadline
+	Panics         int     `json:"panics"`           // worker panics recovered
+	Skipped        int     `json:"skipped"`          // planned ticks skipped by a paused or idle worker
+	Overlaps       int     `json:"overlaps"`         // ticks rejected while the previous call still ran
+}
+
+// DTORuntimeSelf – Go runtime state of the agent, sizes in bytes.
+type DTORuntimeSelf struct {
+	Goroutines     int     `json:"goroutines"`
+	HeapAlloc      uint64  `json:"heap_alloc"`
+	HeapInuse      uint64  `json:"heap_inuse"`
+	HeapSys        uint64  `json:"heap_sys"`
+	HeapObjects    uint64  `json:"heap_objects"`
+	NextGC         uint64  `json:"next_gc"`
+	NumGC          uint32  `json:"num_gc"`
+	GCPauseTotalMs float64 `json:"gc_pause_total_ms"`
+	GCPauseLastMs  float64 `json:"gc_pause_last_ms"`
+}
+
+// DTOProcessSelf – OS level state of the agent process, sizes in bytes.
+type DTOProcessSelf struct {
+	Pid             int32   `json:"pid"`
+	Threads         uint64  `json:"threads"`
+	RSS             uint64  `json:"rss"`
+	VirtualMemory   uint64  `json:"virtual_memory"`
+	OpenFDs         int     `json:"open_fds"`
+	FDSize          uint32  `json:"fd_size"`
+	CPUUserSec      float64 `json:"cpu_user_sec"`
+	CPUSystemSec    float64 `json:"cpu_system_sec"`
+	VoluntaryCtxt   uint64  `json:"voluntary_ctxt"`
+	InvoluntaryCtxt uint64  `json:"involuntary_ctxt"`
+}
+
+// DTOSelf – self monitoring snapshot of the agent.
+type DTOSelf struct {
+	Workers []DTOWorkerSelf `json:"workers"`
+	Runtime DTORuntimeSelf  `json:"runtime"`
+	Process DTOProcessSelf  `json:"process"`
+}
+
+func Domain2DTOSelf(v domain.SelfMetrics) *DTOSelf {
+	dto := DTOSelf{
+		Workers: make([]DTOWorkerSelf, len(v.Workers)),
+		Runtime: DTORuntimeSelf{
+			Goroutines:     v.Runtime.Goroutines,
+			HeapAlloc:      v.Runtime.HeapAlloc,
+			HeapInuse:      v.Runtime.HeapInuse,
+			HeapSys:        v.Runtime.HeapSys,
+			HeapObjects:    v.Runtime.HeapObjects,
+			NextGC:         v.Runtime.NextGC,
+			NumGC:          v.Runtime.NumGC,
+			GCPauseTotalMs: durationMs(v.Runtime.GCPauseTotal),
+			GCPauseLastMs:  durationMs(v.Runtime.GCPauseLast),
+		},
+		Process: DTOProcessSelf{
+			Pid:             v.Process.Pid,
+			Threads:         v.Process.Threads,
+			RSS:             v.Process.RSS,
+			VirtualMemory:   v.Process.VirtualMemory,
+			OpenFDs:         v.Process.OpenFDs,
+			FDSize:          v.Process.FDSize,
+			CPUUserSec:      v.Process.CPUUser.Seconds(),
+			CPUSystemSec:    v.Process.CPUSystem.Seconds(),
+			VoluntaryCtxt:   v.Process.VoluntaryCtxt,
+			InvoluntaryCtxt: v.Process.InvoluntaryCtxt,
+		},
+	}
+
+	for i, w := range v.Workers {
+		dto.Workers[i] = DTOWorkerSelf{
+			Key:            w.Key,
+			LastDurationMs: durationMs(w.LastDuration),
+			AvgDurationMs:  durationMs(w.AvgDuration),
+			MaxDurationMs:  durationMs(w.MaxDuration),
+			Successes:      w.Successes,
+			Errors:         w.Errors,
+			Timeouts:       w.Timeouts,
+			Panics:         w.Panics,
+			Skipped:        w.Skipped,
+			Overlaps:       w.Overlaps,
+		}
+	}
+
+	return &dto
+}
+
+// ============================ Processes dto ============================
+
+// DTOProcess – resource usage of a host process, raw sizes in bytes.
+type DTOProcess struct {
+	PID     int    `json:"pid"`
+	PPID    int    `json:"ppid"`
+	Name    string `json:"name"`    // "postgres"
+	Command string `json:"command"` // "/usr/lib/postgresql/16/bin/postgres -D /var/lib/postgresql"
+	State   string `json:"state"`   // "S"
+	User    string `json:"user"`    // "postgres"
+
+	Cpu    string  `json:"cpu"`     // "12.5%"
+	CpuRaw float64 `json:"cpu_raw"` // "12.5"
+	Memory string  `json:"memory"`  // "120.3MB"
+	RSS    uint64  `json:"rss"`
+	PSS    *uint64 `json:"pss,omitempty"` // absent when not read
+
+	Threads int `json:"threads"`
+	FDs     int `json:"fds"`
+
+	IO      IO[uint64] `json:"io"`       // storage bytes read/written
+	IOSpeed IO[uint64] `json:"io_speed"` // "1.2MB/s"
+}
+
+// DTOProcesses – top processes in the requested order.
+type DTOProcesses struct {
+	Sort      string       `json:"sort"`  // "cpu", "mem" or "io"
+	Total     int          `json:"total"` // processes on the host
+	Processes []DTOProcess `json:"processes"`
+}
+
+func Domain2DTOProcesses(v domain.ProcessList, by domain.TopSort, limit int) *DTOProcesses {
+	top := v.Top(by, limit)
+
+	dto := DTOProcesses{
+		Sort:      string(by),
+		Total:     len(v),
+		Processes: make([]DTOProcess, len(top)),
+	}
+
+	for i, p := range top {
+		dto.Processes[i] = DTOProcess{
+			PID:     p.PID,
+			PPID:    p.PPID,
+			Name:    p.Name,
+			Command: p.Command,
+			State:   p.State,
+			User:    p.User,
+			Cpu:     fmt.Sprintf("%.1f%%", p.CPUPercent),
+			CpuRaw:  p.CPUPercent,
+			Memory:  NewQBBSBuilder(0).Add(p.RSS).Build(),
+			RSS:     p.RSS,
+			PSS:     p.PSS,
+			Threads: p.Threads,
+			FDs:     p.FDs,
+			IO:      NewIOBuilder(p.ReadBytes, p.WriteBytes).AutoUnits().Build(),
+			IOSpeed: NewIOBuilder(p.ReadRate, p.WriteRate).AutoUnitsPerSec().Build(),
+		}
+	}
+
+	return &dto
+}
+
+// ============================ Watched services dto ============================
+
+// DTOService – state of a watched service.
+type DTOService struct {
+	Name      string `json:"name"`       // "nginx"
+	Match     string `json:"match"`      // "exe:/usr/sbin/nginx"
+	Status    string `json:"status"`     // "up" or "down"
+	Running   bool   `json:"running"`    //
+	Instances int    `json:"instances"`  // "5"
+	PIDs      []int  `json:"pids"`       // oldest first
+	StartedAt string `json:"started_at"` // RFC3339 start of the oldest instance
+	Uptime    string `json:"uptime"`     // "3h:22m:5s"
+	Restarts  int    `json:"restarts"`   // restarts since the agent start
+
+	Cpu    string  `json:"cpu"`     // "12.5%"
+	CpuRaw float64 `json:"cpu_raw"` // "12.5"
+	Memory string  `json:"memory"`  // "120.3MB"
+	RSS    uint64  `json:"rss"`
+}
+
+func Domain2DTOServices(v domain.ServiceList, now time.Time) []DTOService {
+	dto := make([]DTOService, len(v))
+
+	for i, s := range v {
+		svc := DTOService{
+			Name:      s.Name,
+			Match:     s.Match,
+			Status:    "down",
+			Running:   s.Running,
+			Instances: s.Instances,
+			PIDs:      s.PIDs,
+			StartedAt: formatTime(s.StartedAt),
+			Restarts:  s.Restarts,
+			Cpu:       fmt.Sprintf("%.1f%%", s.CPUPercent),
+			CpuRaw:    s.CPUPercent,
+			Memory:    NewQBBSBuilder(0).Add(s.RSS).Build(),
+			RSS:       s.RSS,
+		}
+
+		if s.Running {
+			svc.Status = "up"
+			svc.Uptime = formatDuration(now.Sub(s.StartedAt), ":", true)
+		}
+
+		dto[i] = svc
+	}
+
+	return dto
+}
+
+// ============================ Systemd dto ============================
+
+// DTOSystemdUnit – state of a watched systemd unit.
+type DTOSystemdUnit struct {
+	Name        string `json:"name"`        // "nginx.service"
+	Description string `json:"description"` // "A high performance web server"
+	State       string `json:"state"`       // "active (running)"
+	LoadState   string `json:"load_state"`  // "loaded"
+	ActiveState string `json:"active_state"`
+	SubState    string `json:"sub_state"`
+	Result      string `json:"result,omitempty"`       // "success", "exit-code"
+	ActiveSince string `json:"active_since,omitempty"` // RFC3339
+
+	MainPID  uint32 `json:"main_pid,omitempty"`
+	Restarts uint32 `json:"restarts"`
+	Memory   string `json:"memory,omitempty"` // "64.0MB"
+	Cpu      string `json:"cpu,omitempty"`    // "1.5%"
+	CpuTime  string `json:"cpu_time,omitempty"`
+
+	LastTrigger string `json:"last_trigger,omitempty"` // RFC3339 last run of a timer
+	NextElapse  string `json:"next_elapse,omitempty"`  // RFC3339 next run of a timer
+}
+
+// DTOSystemd – unit counts of the service manager and the watched units.
+type DTOSystemd struct {
+	Total       int              `json:"total"`
+	Active      int              `json:"active"`
+	Inactive    int              `json:"inactive"`
+	Failed      int              `json:"failed"`
+	Changing    int              `json:"changing"`
+	Summary     string           `json:"summary"` // "212/250 active, 1 failed"
+	FailedUnits []string         `json:"failed_units"`
+	Units       []DTOSystemdUnit `json:"units"`
+}
+
+func Domain2DTOSystemd(v domain.SystemdMetrics) *DTOSystemd {
+	dto := DTOSystemd{
+		Total:       v.Total,
+		Active:      v.Active,
+		Inactive:    v.Inactive,
+		Failed:      v.Failed,
+		Changing:    v.Changing,
+		Summary:     fmt.Sprintf("%d/%d active, %d failed", v.Active, v.Total, v.Failed),
+		FailedUnits: v.FailedUnits,
+		Units:       make([]DTOSystemdUnit, len(v.Units)),
+	}
+
+	if dto.FailedUnits == nil {
+		dto.FailedUnits = []string{}
+	}
+
+	for i, u := range v.Units {
+		unit := DTOSystemdUnit{
+			Name:        u.Name,
+			Description: u.Description,
+			State:       fmt.Sprintf("%s (%s)", u.ActiveState, u.SubState),
+			LoadState:   u.LoadState,
+			ActiveState: u.ActiveState,
+			SubState:    u.SubState,
+			Result:      u.Result,
+			ActiveSince: formatTime(u.ActiveSince),
+			MainPID:     u.MainPID,
+			Restarts:    u.Restarts,
+			LastTrigger: formatTime(u.LastTrigger),
+			NextElapse:  formatTime(u.NextElapse),
+		}
+
+		if u.Memory > 0 {
+			unit.Memory = NewQBBSBuilder(0).Add(u.Memory).Build()
+		}
+		if u.CPUUsage > 0 {
+			unit.Cpu = fmt.Sprintf("%.1f%%", u.CPUPercent)
+			unit.CpuTime = fmt.Sprintf("%.2fs", u.CPUUsage.Seconds())
+		}
+
+		dto.Units[i] = unit
+	}
+
+	return &dto
+}
+
+// ============================ Cgroups dto ============================
+
+// DTOCgroup – resource usage of a cgroup, raw sizes in bytes.
+type DTOCgroup struct {
+	Path  string `json:"path"`  // "/system.slice/docker-4f1c...e2.scope"
+	Kind  string `json:"kind"`  // "slice", "service", "scope", "docker", "podman" or "other"
+	Name  string `json:"name"`  // "nginx.service" or "4f1c0d9ab3e2"
+	Slice string `json:"slice"` // "system.slice"
+
+	Cpu     string  `json:"cpu"`      // "12.5%"
+	CpuRaw  float64 `json:"cpu_raw"`  // "12.5"
+	CpuTime string  `json:"cpu_time"` // "1520.31s"
+
+	Memory        string `json:"memory"`       // "120.3MB"
+	MemoryLimit   string `json:"memory_limit"` // "512.0MB" or "unlimited"
+	MemoryCurrent uint64 `json:"memory_current"`
+	MemoryMax     uint64 `json:"memory_max"`
+	MemoryPeak    uint64 `json:"memory_peak"`
+	OOM           uint64 `json:"oom"`
+	OOMKill       uint64 `json:"oom_kill"`
+
+	IO        map[string]DTOCgroupIO `json:"io"`       // by device
+	IOSpeed   IO[uint64]             `json:"io_speed"` // "1.2MB/s"
+	Pids      uint64                 `json:"pids"`
+	PidsLimit uint64                 `json:"pids_limit"` // zero is unlimited
+}
+
+// DTOCgroupIO – storage I/O of a cgroup on a device.
+type DTOCgroupIO struct {
+	Bytes IO[uint64] `json:"bytes"`
+	Ops   IO[uint64] `json:"ops"`
+}
+
+// DTOCgroups – top cgroups in the requested order.
+type DTOCgroups struct {
+	Sort    string      `json:"sort"`  // "cpu", "mem" or "io"
+	Total   int         `json:"total"` // cgroups of the requested kinds
+	Cgroups []DTOCgroup `json:"cgroups"`
+}
+
+/*
+Domain2DTOCgroups – converts the top cgroups of the given kinds.
+
+	Without kinds all cgroups except slices are listed, a slice
+	already sums up every cgroup below it.
+*/
+func Domain2DTOCgroups(v domain.CgroupList, kinds []domain.CgroupKind, by domain.TopSort, limit int) *DTOCgroups {
+	selected := make(domain.CgroupList, 0, len(v))
+	for _, c := range v {
+		if len(kinds) == 0 && c.Kind != domain.CgroupSlice || slices.Contains(kinds, c.Kind) {
+			selected = append(selected, c)
+		}
+	}
+
+	top := selected.Top(by, limit)
+
+	dto := DTOCgroups{
+		Sort:    string(by),
+		Total:   len(selected),
+		Cgroups: make([]DTOCgroup, len(top)),
+	}
+
+	for i, c := range top {
+		cg := DTOCgroup{
+			Path:          c.Path,
+			Kind:          string(c.Kind),
+			Name:          c.Name,
+			Slice:         c.Slice,
+			Cpu:           fmt.Sprintf("%.1f%%", c.CPUPercent),
+			CpuRaw:        c.CPUPercent,
+			CpuTime:       fmt.Sprintf("%.2fs", c.CPUUsage.Seconds()),
+			Memory:        NewQBBSBuilder(0).Add(c.MemoryCurrent).Build(),
+			MemoryLimit:   "unlimited",
+			MemoryCurrent: c.MemoryCurrent,
+			MemoryMax:     c.MemoryMax,
+			MemoryPeak:    c.MemoryPeak,
+			OOM:           c.OOM,
+			OOMKill:       c.OOMKill,
+			IO:            make(map[string]DTOCgroupIO, len(c.IO)),
+			IOSpeed:       NewIOBuilder(c.ReadRate, c.WriteRate).AutoUnitsPerSec().Build(),
+			Pids:          c.Pids,
+			PidsLimit:     c.PidsMax,
+		}
+
+		if c.MemoryMax > 0 {
+			cg.MemoryLimit = NewQBBSBuilder(0).Add(c.MemoryMax).Build()
+		}
+		for _, io := range c.IO {
+			cg.IO[io.Device] = DTOCgroupIO{
+				Bytes: NewIOBuilder(io.ReadBytes, io.WriteBytes).AutoUnits().Build(),
+				Ops:   NewIOBuilder(io.ReadOps, io.WriteOps).AutoMetricUnits().Build(),
+			}
+		}
+
+		dto.Cgroups[i] = cg
+	}
+
+	return &dto
+}
+
+// ============================ Pressure dto ============================
+
+// DTOPressureLine – stall statistics of a PSI line.
+type DTOPressureLine struct {
+	Avg10     string  `json:"avg10"`      // "1.25%"
+	Avg60     string  `json:"avg60"`      // "0.80%"
+	Avg300    string  `json:"avg300"`     // "0.31%"
+	Avg10Raw  float64 `json:"avg10_raw"`  // "1.25"
+	Stall     string  `json:"stall"`      // "0.125s" since the previous scrape
+	StallRaw  float64 `json:"stall_raw"`  // "1.25" percent of the scrape interval
+	TotalUsec uint64  `json:"total_usec"` // cumulative stall time
+}
+
+// DTOPressureStats – some and full PSI of a resource.
+type DTOPressureStats struct {
+	Some DTOPressureLine `json:"some"`
+	Full DTOPressureLine `json:"full"`
+}
+
+// DTOPressureResources – PSI of the CPU, memory and I/O.
+type DTOPressureResources struct {
+	CPU    DTOPressureStats `json:"cpu"`
+	Memory DTOPressureStats `json:"memory"`
+	IO     DTOPressureStats `json:"io"`
+}
+
+// DTOCgroupPressure – PSI of a cgroup.
+type DTOCgroupPressure struct {
+	Path string `json:"path"` // "/system.slice/nginx.service"
+	Kind string `json:"kind"` // "service"
+	Name string `json:"name"` // "nginx.service"
+	DTOPressureResources
+}
+
+// DTOPressure – PSI of the host and the cgroups under the most pressure.
+type DTOPressure struct {
+	Available bool   `json:"available"` // false when the kernel has PSI disabled
+	Sort      string `json:"sort"`      // resource the cgroups are ordered by: "cpu", "mem" or "io"
+	DTOPressureResources
+	TotalCgroups int                 `json:"total_cgroups"`
+	Cgroups      []DTOCgroupPressure `json:"cgroups"`
+}
+
+func pressureLine2DTO(l domain.PressureLine) DTOPressureLine {
+	return DTOPressureLine{
+		Avg10:     fmt.Sprintf("%.2f%%", l.Avg10),
+		Avg60:     fmt.Sprintf("%.2f%%", l.Avg60),
+		Avg300:    fmt.Sprintf("%.2f%%", l.Avg300),
+		Avg10Raw:  l.Avg10,
+		Stall:     fmt.Sprintf("%.3fs", l.Stall.Seconds()),
+		StallRaw:  l.StallPercent,
+		TotalUsec: uint64(l.Total / time.Microsecond),
+	}
+}
+
+func pressure2DTO(p domain.Pressure) DTOPressureResources {
+	stats := func(s domain.PressureStats) DTOPressureStats {
+		return DTOPressureStats{
+			Some: pressureLine2DTO(s.Some),
+			Full: pressureLine2DTO(s.Full),
+		}
+	}
+
+	return DTOPressureResources{
+		CPU:    stats(p.CPU),
+		Memory: stats(p.Memory),
+		IO:     stats(p.IO),
+	}
+}
+
+func Domain2DTOPressure(v domain.PressureMetrics, by domain.TopSort, limit int) *DTOPressure {
+	top := v.TopCgroups(by, limit)
+
+	dto := DTOPressure{
+		Available:            v.Available,
+		Sort:                 string(by),
+		DTOPressureResources: pressure2DTO(v.Host),
+		TotalCgroups:         len(v.Cgroups),
+		Cgroups:              make([]DTOCgroupPressure, len(top)),
+	}
+
+	for i, c := range top {
+		dto.Cgroups[i] = DTOCgroupPressure{
+			Path:                 c.Path,
+			Kind:                 string(c.Kind),
+			Name:                 c.Name,
+			DTOPressureResources: pressure2DTO(c.Pressure),
+		}
+	}
+
+	return &dto
+}
+
+// ============================ Sockets dto ============================
+
+// DTORemoteConnections – TCP connections with a remote address.
+type DTORemoteConnections struct {
+	Address     string `json:"address"`     // "10.0.0.2"
+	Connections int    `json:"connections"` // "12"
+}
+
+// DTOSockets – socket counts of the host and the busiest remote addresses.
+type DTOSockets struct {
+	TCP          int                    `json:"tcp"`
+	UDP          int                    `json:"udp"`
+	UNIX         int                    `json:"unix"`
+	UNIXListen   int                    `json:"unix_listen"`
+	Listening    int                    `json:"listening"`  // listening TCP and bound UDP sockets
+	TCPStates    map[string]int         `json:"tcp_states"` // {"ESTABLISHED": 12, "TIME_WAIT": 3}
+	TotalRemotes int                    `json:"total_remotes"`
+	Remotes      []DTORemoteConnections `json:"remotes"`
+}
+
+func Domain2DTOSockets(v domain.SocketMetrics, limit int) *DTOSockets {
+	top := v.Remotes[:min(len(v.Remotes), limit)]
+
+	dto := DTOSockets{
+		TCP:          v.TCP,
+		UDP:          v.UDP,
+		UNIX:         v.UNIX,
+		UNIXListen:   v.UNIXListen,
+		Listening:    len(v.Listening),
+		TCPStates:    v.TCPStates,
+		TotalRemotes: len(v.Remotes),
+		Remotes:      make([]DTORemoteConnections, len(top)),
+	}
+
+	for i, r := range top {
+		dto.Remotes[i] = DTORemoteConnections(r)
+	}
+
+	return &dto
+}
+
+// DTOListeningSocket – listening TCP or bound UDP socket.
+type DTOListeningSocket struct {
+	Protocol string `json:"protocol"` // "tcp", "tcp6", "udp" or "udp6"
+	Endpoint string `json:"endpoint"` // "0.0.0.0:22", "[::1]:631"
+	Port     uint64 `json:"port"`     // "22"
+	Loopback bool   `json:"loopback"` // reachable from the host only
+	PID      int    `json:"pid"`      // "0" when the owner is unknown
+	Process  string `json:"process"`  // "sshd"
+	UID      uint64 `json:"uid"`      // "0"
+}
+
+// DTOListening – listening ports of the host.
+type DTOListening struct {
+	Total   int                  `json:"total"`
+	Sockets []DTOListeningSocket `json:"sockets"`
+}
+
+/*
+Domain2DTOListening – converts listening sockets to DTO.
+
+	An empty proto keeps all sockets, "tcp" or "udp" keeps the
+	protocol over both IPv4 and IPv6.
+*/
+func Domain2DTOListening(v domain.SocketMetrics, proto string) *DTOListening {
+	dto := DTOListening{
+		Sockets: make([]DTOListeningSocket, 0, len(v.Listening)),
+	}
+
+	for _, l := range v.Listening {
+		if proto != "" && strings.TrimSuffix(l.Protocol, "6") != proto {
+			continue
+		}
+
+		ip := net.ParseIP(l.Address)
+
+		dto.Sockets = append(dto.Sockets, DTOListeningSocket{
+			Protocol: l.Protocol,
+			Endpoint: net.JoinHostPort(l.Address, strconv.FormatUint(l.Port, 10)),
+			Port:     l.Port,
+			Loopback: ip != nil && ip.IsLoopback(),
+			PID:      l.PID,
+			Process:  l.Process,
+			UID:      l.UID,
+		})
+	}
+	dto.Total = len(dto.Sockets)
+
+	return &dto
+}
+
+// ============================ Network protocols dto ============================
+
+// DTOProtocolCounter – protocol counter with its rate.
+type DTOProtocolCounter struct {
+	Rate    string  `json:"rate"`     // "1.5/s", "12.4K/s"
+	RateRaw float64 `json:"rate_raw"` // "1.5"
+	Total   uint64  `json:"total"`    // since boot
+}
+
+// DTOTCPProtocol – TCP counters.
+type DTOTCPProtocol struct {
+	CurrEstab         uint64             `json:"curr_estab"`         // "42"
+	Retransmits       DTOProtocolCounter `json:"retransmits"`        // retransmitted segments
+	RetransmitPercent string             `json:"retransmit_percent"` // "0.25%" of sent segments
+	RetransmitRaw     float64            `json:"retransmit_raw"`     // "0.25"
+	ActiveOpens       DTOProtocolCounter `json:"active_opens"`
+	PassiveOpens      DTOProtocolCounter `json:"passive_opens"`
+	AttemptFails      DTOProtocolCounter `json:"attempt_fails"`
+	EstabResets       DTOProtocolCounter `json:"estab_resets"`
+	OutResets         DTOProtocolCounter `json:"out_resets"`
+	InSegs            DTOProtocolCounter `json:"in_segs"`
+	OutSegs           DTOProtocolCounter `json:"out_segs"`
+	InErrors          DTOProtocolCounter `json:"in_errors"`
+	InCsumErrors      DTOProtocolCounter `json:"in_csum_errors"`
+	ListenOverflows   DTOProtocolCounter `json:"listen_overflows"`
+	ListenDrops       DTOProtocolCounter `json:"listen_drops"`
+	Timeouts          DTOProtocolCounter `json:"timeouts"`
+	SynRetrans        DTOProtocolCounter `json:"syn_retrans"`
+	FastRetrans       DTOProtocolCounter `json:"fast_retrans"`
+	LostRetransmit    DTOProtocolCounter `json:"lost_retransmit"`
+	AbortOnTimeout    DTOProtocolCounter `json:"abort_on_timeout"`
+	BacklogDrop       DTOProtocolCounter `json:"backlog_drop"`
+}
+
+// DTOUDPProtocol – UDP counters.
+type DTOUDPProtocol struct {
+	InDatagrams  DTOProtocolCounter `json:"in_datagrams"`
+	OutDatagrams DTOProtocolCounter `json:"out_datagrams"`
+	NoPorts      DTOProtocolCounter `json:"no_ports"`
+	InErrors     DTOProtocolCounter `json:"in_errors"`
+	RcvbufErrors DTOProtocolCounter `json:"rcvbuf_errors"`
+	SndbufErrors DTOProtocolCounter `json:"sndbuf_errors"`
+}
+
+// DTOICMPProtocol – ICMP counters.
+type DTOICMPProtocol struct {
+	InMsgs         DTOProtocolCounter `json:"in_msgs"`
+	OutMsgs        DTOProtocolCounter `json:"out_msgs"`
+	InErrors       DTOProtocolCounter `json:"in_errors"`
+	OutErrors      DTOProtocolCounter `json:"out_errors"`
+	InDestUnreachs DTOProtocolCounter `json:"in_dest_unreachs"`
+}
+
+// DTONetProtocols – kernel network protocol counters.
+type DTONetProtocols struct {
+	TCP  DTOTCPProtocol  `json:"tcp"`
+	UDP  DTOUDPProtocol  `json:"udp"`
+	ICMP DTOICMPProtocol `json:"icmp"`
+}
+
+func protocolCounter2DTO(c domain.ProtocolCounter) DTOProtocolCounter {
+	rate := fmt.Sprintf("%.1f/s", c.PerSec)
+	if c.PerSec >= 1000 {
+		fv, unit := sizes.DetermMetricBase(uint64(c.PerSec))
+		rate = fmt.Sprintf("%.1f%s/s", fv, unit)
+	}
+
+	return DTOProtocolCounter{
+		Rate:    rate,
+		RateRaw: c.PerSec,
+		Total:   c.Total,
+	}
+}
+
+func Domain2DTONetProtocols(v domain.NetProtocolMetrics) *DTONetProtocols {
+	c := protocolCounter2DTO
+
+	return &DTONetProtocols{
+		TCP: DTOTCPProtocol{
+			CurrEstab:         v.TCP.CurrEstab,
+			Retransmits:       c(v.TCP.Retransmits),
+			RetransmitPercent: fmt.Sprintf("%.2f%%", v.TCP.RetransmitPercent),
+			RetransmitRaw:     v.TCP.RetransmitPercent,
+			ActiveOpens:       c(v.TCP.ActiveOpens),
+			PassiveOpens:      c(v.TCP.PassiveOpens),
+			AttemptFails:      c(v.TCP.AttemptFails),
+			EstabResets:       c(v.TCP.EstabResets),
+			OutResets:         c(v.TCP.OutResets),
+			InSegs:            c(v.TCP.InSegs),
+			OutSegs:           c(v.TCP.OutSegs),
+			InErrors:          c(v.TCP.InErrors),
+			InCsumErrors:      c(v.TCP.InCsumErrors),
+			ListenOverflows:   c(v.TCP.ListenOverflows),
+			ListenDrops:       c(v.TCP.ListenDrops),
+			Timeouts:          c(v.TCP.Timeouts),
+			SynRetrans:        c(v.TCP.SynRetrans),
+			FastRetrans:       c(v.TCP.FastRetrans),
+			LostRetransmit:    c(v.TCP.LostRetransmit),
+			AbortOnTimeout:    c(v.TCP.AbortOnTimeout),
+			BacklogDrop:       c(v.TCP.BacklogDrop),
+		},
+		UDP: DTOUDPProtocol{
+			InDatagrams:  c(v.UDP.InDatagrams),
+			OutDatagrams: c(v.UDP.OutDatagrams),
+			NoPorts:      c(v.UDP.NoPorts),
+			InErrors:     c(v.UDP.InErrors),
+			RcvbufErrors: c(v.UDP.RcvbufErrors),
+			SndbufErrors: c(v.UDP.SndbufErrors),
+		},
+		ICMP: DTOICMPProtocol{
+			InMsgs:         c(v.ICMP.InMsgs),
+			OutMsgs:        c(v.ICMP.OutMsgs),
+			InErrors:       c(v.ICMP.InErrors),
+			OutErrors:      c(v.ICMP.OutErrors),
+			InDestUnreachs: c(v.ICMP.InDestUnreachs),
+		},
+	}
+}
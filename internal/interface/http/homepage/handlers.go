@@ -5,9 +5,12 @@ package httphomepage
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eterline/fstmon/internal/domain"
@@ -16,7 +19,7 @@ import (
 )
 
 type ActualStateStore interface {
-	ActualMetric(key string) (value any, scheduleExists bool, stateExists bool, retryIn time.Duration)
+	ActualMetric(ctx context.Context, key string) (actual domain.ActualMetric, scheduleExists bool, stateExists bool)
 }
 
 type HomepageHandlerGroup struct {
@@ -29,16 +32,40 @@ func New(ass ActualStateStore) *HomepageHandlerGroup {
 	}
 }
 
+// ExpiryPolicy – optional ActualStateStore policy of expired values.
+type ExpiryPolicy interface {
+	ServeExpired() bool
+}
+
+func serveExpired(ass ActualStateStore) bool {
+	p, ok := ass.(ExpiryPolicy)
+	return ok && p.ServeExpired()
+}
+
+// setAgeHeader – sets the Age header to the oldest served value age.
+func setAgeHeader(w http.ResponseWriter, age time.Duration) {
+	sec := int(age.Seconds())
+	if prev, err := strconv.Atoi(w.Header().Get("Age")); err == nil && prev > sec {
+		return
+	}
+	w.Header().Set("Age", strconv.Itoa(sec))
+}
+
 // GetMetric – generic wrapper for retrieving actual metrics by key.
 // – 404 if schedule does not exist
 // – 503 if state does not exist (adds Retry-In header)
-// – 500 if the metric type is not assignable to T
-func GetMetric[T any](ctx context.Context, ass ActualStateStore, w http.ResponseWriter, key string) (T, bool) {
+// – 503 if the value expired and the store does not serve expired values (adds Age and Retry-In headers)
+// – 500 if the stored value is not a T, which only happens when two keys share a name
+// Stale values are served with the Age header.
+// Scrape health of the served value is recorded into meta under the key.
+func GetMetric[T any](ctx context.Context, ass ActualStateStore, w http.ResponseWriter, k domain.Key[T], meta DTOMeta) (T, bool) {
 	log := log.MustLoggerFromContext(ctx)
 
 	var zero T
+	key := k.Name()
 
-	value, scheduleExists, stateExists, retryIn := ass.ActualMetric(key)
+	actual, scheduleExists, stateExists := ass.ActualMetric(ctx, key)
+	retryIn := actual.RetryIn
 	if !scheduleExists {
 		api.NewResponse().
 			SetCode(http.StatusNotFound).
@@ -63,6 +90,21 @@ func GetMetric[T any](ctx context.Context, ass ActualStateStore, w http.Response
 		return zero, false
 	}
 
+	if actual.Freshness == domain.FreshnessExpired && !serveExpired(ass) {
+		setAgeHeader(w, actual.Age)
+		w.Header().Set("Retry-In", strconv.Itoa(int(retryIn.Seconds())))
+		api.NewResponse().
+			SetCode(http.StatusServiceUnavailable).
+			SetMessage("metric not available").
+			AddStringError("metric value expired").
+			Write(w)
+
+		log.Warn("metric value expired", "metric_key", key, "age", actual.Age)
+		return zero, false
+	}
+
+	value := actual.Value
+
 	casted, ok := value.(T)
 	if !ok {
 		api.InternalErrorResponse().
@@ -80,17 +122,32 @@ func GetMetric[T any](ctx context.Context, ass ActualStateStore, w http.Response
 		return zero, false
 	}
 
+	if actual.Stale {
+		setAgeHeader(w, actual.Age)
+		log.Warn(
+			"serving stale metric", "metric_key", key,
+			"freshness", actual.Freshness, "age", actual.Age,
+			"consecutive_failures", actual.Status.ConsecutiveFailures,
+			"last_error", actual.Status.LastError,
+		)
+	}
+
+	if meta != nil {
+		meta[key] = Domain2DTOMetricStatus(actual)
+	}
+
 	return casted, true
 }
 
 func (hhg *HomepageHandlerGroup) HandleThermal(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
-	m, ok := GetMetric[domain.ThermalMetricsMap](r.Context(), hhg.actualStore, w, "thermal")
+	meta := make(DTOMeta, 1)
+	m, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyThermal, meta)
 	if !ok {
 		return
 	}
 
-	err := api.NewResponse().WrapData(m).Write(w)
+	err := api.NewResponse().WrapData(m).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
@@ -98,15 +155,16 @@ func (hhg *HomepageHandlerGroup) HandleThermal(w http.ResponseWriter, r *http.Re
 
 func (hhg *HomepageHandlerGroup) HandleSystem(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
 
-	m, ok := GetMetric[domain.SystemInfo](r.Context(), hhg.actualStore, w, "system")
+	m, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeySystem, meta)
 	if !ok {
 		return
 	}
 
 	dto := Domain2DTOSystem(m)
 
-	err := api.NewResponse().WrapData(dto).Write(w)
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
@@ -114,15 +172,16 @@ func (hhg *HomepageHandlerGroup) HandleSystem(w http.ResponseWriter, r *http.Req
 
 func (hhg *HomepageHandlerGroup) HandleNetwork(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
 
-	m, ok := GetMetric[domain.InterfacesIOMap](r.Context(), hhg.actualStore, w, "net_io")
+	m, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyNetIO, meta)
 	if !ok {
 		return
 	}
 
 	dto := Domain2DTONetworkInterfaceIO(m)
 
-	err := api.NewResponse().WrapData(dto).Write(w)
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
@@ -130,15 +189,16 @@ func (hhg *HomepageHandlerGroup) HandleNetwork(w http.ResponseWriter, r *http.Re
 
 func (hhg *HomepageHandlerGroup) HandleMemory(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
 
-	m, ok := GetMetric[domain.MemoryMetrics](r.Context(), hhg.actualStore, w, "memory")
+	m, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyMemory, meta)
 	if !ok {
 		return
 	}
 
 	dto := Domain2DTOMemory(m)
 
-	err := api.NewResponse().WrapData(dto).Write(w)
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
@@ -146,20 +206,21 @@ func (hhg *HomepageHandlerGroup) HandleMemory(w http.ResponseWriter, r *http.Req
 
 func (hhg *HomepageHandlerGroup) HandleCpu(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 2)
 
-	pkg, ok := GetMetric[domain.CpuPackage](r.Context(), hhg.actualStore, w, "cpu")
+	pkg, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyCpu, meta)
 	if !ok {
 		return
 	}
 
-	mtrcs, ok := GetMetric[domain.CpuMetrics](r.Context(), hhg.actualStore, w, "cpu_usage")
+	mtrcs, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyCpuUsage, meta)
 	if !ok {
 		return
 	}
 
 	dto := Domain2DTOCpu(pkg, mtrcs)
 
-	err := api.NewResponse().WrapData(dto).Write(w)
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
@@ -167,15 +228,16 @@ func (hhg *HomepageHandlerGroup) HandleCpu(w http.ResponseWriter, r *http.Reques
 
 func (hhg *HomepageHandlerGroup) HandlePartitions(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
 
-	data, ok := GetMetric[domain.Partitions](r.Context(), hhg.actualStore, w, "partitions")
+	data, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyPartitions, meta)
 	if !ok {
 		return
 	}
 
 	dto := Domain2DTOPartitions(data)
 
-	err := api.NewResponse().WrapData(dto).Write(w)
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
@@ -183,15 +245,293 @@ func (hhg *HomepageHandlerGroup) HandlePartitions(w http.ResponseWriter, r *http
 
 func (hhg *HomepageHandlerGroup) HandleDiskIO(w http.ResponseWriter, r *http.Request) {
 	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
 
-	disks, ok := GetMetric[domain.DiskIOMap](r.Context(), hhg.actualStore, w, "disk_io")
+	disks, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyDiskIO, meta)
 	if !ok {
 		return
 	}
 
 	dto := Domain2DTODiskIOs(disks)
 
-	err := api.NewResponse().WrapData(dto).Write(w)
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandleSelf(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
+
+	self, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeySelf, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOSelf(self)
+
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+/*
+parseTopQuery – parses the ?sort=cpu|mem|io&limit=N query.
+
+	Missing parameters are the CPU order and the default limit.
+*/
+func parseTopQuery(q url.Values) (domain.TopSort, int, error) {
+	by, ok := domain.ParseTopSort(q.Get("sort"))
+	if !ok {
+		return "", 0, fmt.Errorf("invalid sort %q: expected cpu, mem or io", q.Get("sort"))
+	}
+
+	limit, err := parseLimitQuery(q)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return by, limit, nil
+}
+
+// parseLimitQuery – parses the ?limit=5 query, a missing limit is domain.TopDefaultLimit.
+func parseLimitQuery(q url.Values) (int, error) {
+	s := q.Get("limit")
+	if s == "" {
+		return domain.TopDefaultLimit, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > domain.TopMaxLimit {
+		return 0, fmt.Errorf("invalid limit %q: expected 1..%d", s, domain.TopMaxLimit)
+	}
+
+	return n, nil
+}
+
+func (hhg *HomepageHandlerGroup) HandleProcesses(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+
+	by, limit, err := parseTopQuery(r.URL.Query())
+	if err != nil {
+		api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid processes query").
+			AddError(err).
+			Write(w)
+		return
+	}
+
+	meta := make(DTOMeta, 1)
+
+	procs, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyProcesses, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOProcesses(procs, by, limit)
+
+	err = api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandleServices(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
+
+	services, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyServices, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOServices(services, time.Now())
+
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandleSystemd(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
+
+	units, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeySystemd, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOSystemd(units)
+
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+/*
+parseCgroupKinds – parses the ?kind=docker,podman query.
+
+	A missing parameter returns no kinds.
+*/
+func parseCgroupKinds(q url.Values) ([]domain.CgroupKind, error) {
+	s := q.Get("kind")
+	if s == "" {
+		return nil, nil
+	}
+
+	var kinds []domain.CgroupKind
+	for _, k := range strings.Split(s, ",") {
+		switch kind := domain.CgroupKind(k); kind {
+		case domain.CgroupSlice, domain.CgroupService, domain.CgroupScope,
+			domain.CgroupDocker, domain.CgroupPodman, domain.CgroupOther:
+			kinds = append(kinds, kind)
+		default:
+			return nil, fmt.Errorf("invalid kind %q: expected slice, service, scope, docker, podman or other", k)
+		}
+	}
+
+	return kinds, nil
+}
+
+func (hhg *HomepageHandlerGroup) HandleCgroups(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+
+	by, limit, err := parseTopQuery(r.URL.Query())
+	if err != nil {
+		api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid cgroups query").
+			AddError(err).
+			Write(w)
+		return
+	}
+
+	kinds, err := parseCgroupKinds(r.URL.Query())
+	if err != nil {
+		api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid cgroups query").
+			AddError(err).
+			Write(w)
+		return
+	}
+
+	meta := make(DTOMeta, 1)
+
+	cgroups, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyCgroups, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOCgroups(cgroups, kinds, by, limit)
+
+	err = api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandlePressure(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+
+	by, limit, err := parseTopQuery(r.URL.Query())
+	if err != nil {
+		api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid pressure query").
+			AddError(err).
+			Write(w)
+		return
+	}
+
+	meta := make(DTOMeta, 1)
+
+	pressure, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyPressure, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOPressure(pressure, by, limit)
+
+	err = api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandleNetProtocols(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+	meta := make(DTOMeta, 1)
+
+	protocols, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeyProtocols, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTONetProtocols(protocols)
+
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandleSockets(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+
+	limit, err := parseLimitQuery(r.URL.Query())
+	if err != nil {
+		api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid sockets query").
+			AddError(err).
+			Write(w)
+		return
+	}
+
+	meta := make(DTOMeta, 1)
+
+	sockets, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeySockets, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOSockets(sockets, limit)
+
+	err = api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
+	if err != nil {
+		log.Error("response error", "error", err)
+	}
+}
+
+func (hhg *HomepageHandlerGroup) HandleListening(w http.ResponseWriter, r *http.Request) {
+	log := log.MustLoggerFromContext(r.Context())
+
+	proto := r.URL.Query().Get("proto")
+	if proto != "" && proto != "tcp" && proto != "udp" {
+		api.NewResponse().
+			SetCode(http.StatusBadRequest).
+			SetMessage("invalid listening query").
+			AddError(fmt.Errorf("invalid proto %q: expected tcp or udp", proto)).
+			Write(w)
+		return
+	}
+
+	meta := make(DTOMeta, 1)
+
+	sockets, ok := GetMetric(r.Context(), hhg.actualStore, w, domain.KeySockets, meta)
+	if !ok {
+		return
+	}
+
+	dto := Domain2DTOListening(sockets, proto)
+
+	err := api.NewResponse().WrapData(dto).WrapMeta(meta).Write(w)
 	if err != nil {
 		log.Error("response error", "error", err)
 	}
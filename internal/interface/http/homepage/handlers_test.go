@@ -0,0 +1,77 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package httphomepage
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+)
+
+// fakeStore – serves fresh values by metric key.
+type fakeStore map[string]any
+
+func (fs fakeStore) ActualMetric(_ context.Context, key string) (domain.ActualMetric, bool, bool) {
+	v, ok := fs[key]
+	return domain.ActualMetric{Value: v, Freshness: domain.FreshnessFresh}, ok, ok
+}
+
+func Test_HandleProcesses(t *testing.T) {
+	procs := make(domain.ProcessList, 10)
+	for i := range procs {
+		procs[i] = domain.ProcessInfo{PID: i + 1, CPUPercent: float64(i), RSS: uint64(10 - i)}
+	}
+	hhg := New(fakeStore{domain.KeyProcesses.Name(): procs})
+
+	tests := []struct {
+		query string
+		code  int
+		count int
+		first int
+	}{
+		{"", http.StatusOK, domain.TopDefaultLimit, 10},
+		{"?sort=mem&limit=3", http.StatusOK, 3, 1},
+		{"?sort=io&limit=100", http.StatusOK, 10, 1},
+		{"?sort=disk", http.StatusBadRequest, 0, 0},
+		{"?limit=0", http.StatusBadRequest, 0, 0},
+		{"?limit=101", http.StatusBadRequest, 0, 0},
+		{"?limit=five", http.StatusBadRequest, 0, 0},
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/metric/homepage/processes"+tt.query, nil)
+			r = r.WithContext(log.WrapLoggerToContext(r.Context(), logger))
+			w := httptest.NewRecorder()
+
+			hhg.HandleProcesses(w, r)
+
+			if w.Code != tt.code {
+				t.Fatalf("code = %d, want %d: %s", w.Code, tt.code, w.Body)
+			}
+			if tt.code != http.StatusOK {
+				return
+			}
+
+			var res struct {
+				Data DTOProcesses `json:"data"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Data.Processes) != tt.count || res.Data.Processes[0].PID != tt.first || res.Data.Total != len(procs) {
+				t.Errorf("processes = %+v, want %d from pid %d", res.Data, tt.count, tt.first)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+var (
+	ErrCollectorExists   = errors.New("collector already registered")
+	ErrCollectorNotFound = errors.New("collector not found")
+	ErrValueType         = errors.New("unexpected collector value type")
+)
+
+/*
+Collector – source of a single metric.
+
+	Name is the metric key used by the store, HTTP, gRPC and exporters.
+	DefaultInterval is used when the configuration has no override.
+*/
+type Collector interface {
+	Name() string
+	DefaultInterval() time.Duration
+	Scrape(ctx context.Context) (any, error)
+}
+
+// CollectorDescriber – optional human readable collector metadata.
+type CollectorDescriber interface {
+	Description() string
+	Units() string
+}
+
+/*
+CollectorDecoder – optional decoding of collector values saved as JSON.
+
+	ValueType names the Go type of the values, so a value saved by
+	another collector version is not decoded into a different type.
+*/
+type CollectorDecoder interface {
+	ValueType() string
+	DecodeValue(data []byte) (any, error)
+}
+
+// ============================ Func collector ============================
+
+type funcCollector struct {
+	name        string
+	interval    time.Duration
+	description string
+	units       string
+	scrape      UpdateWorker
+	valueType   string
+	decode      func([]byte) (any, error)
+}
+
+func (fc *funcCollector) Name() string                            { return fc.name }
+func (fc *funcCollector) DefaultInterval() time.Duration          { return fc.interval }
+func (fc *funcCollector) Scrape(ctx context.Context) (any, error) { return fc.scrape(ctx) }
+func (fc *funcCollector) Description() string                     { return fc.description }
+func (fc *funcCollector) Units() string                           { return fc.units }
+func (fc *funcCollector) ValueType() string                       { return fc.valueType }
+func (fc *funcCollector) DecodeValue(data []byte) (any, error)    { return fc.decode(data) }
+
+// CollectorOption – functional option for NewCollector.
+type CollectorOption func(*funcCollector)
+
+// WithDescription – sets the collector description.
+func WithDescription(d string) CollectorOption {
+	return func(fc *funcCollector) {
+		fc.description = d
+	}
+}
+
+// WithUnits – sets the units of the collected values, e.g. "bytes", "percent".
+func WithUnits(u string) CollectorOption {
+	return func(fc *funcCollector) {
+		fc.units = u
+	}
+}
+
+/*
+NewCollector – creates a collector of the key from a typed scrape function.
+
+	Replaces manual wrapping of scrape methods into UpdateWorker.
+	The scrape function must return the value type of the key.
+*/
+func NewCollector[T any](
+	key domain.Key[T],
+	interval time.Duration,
+	scrape func(context.Context) (T, error),
+	opts ...CollectorOption,
+) Collector {
+	fc := &funcCollector{
+		name:     key.Name(),
+		interval: interval,
+		scrape: func(ctx context.Context) (any, error) {
+			return scrape(ctx)
+		},
+		valueType: reflect.TypeFor[T]().String(),
+		decode: func(data []byte) (any, error) {
+			var v T
+			err := json.Unmarshal(data, &v)
+			return v, err
+		},
+	}
+
+	for _, opt := range opts {
+		opt(fc)
+	}
+
+	return fc
+}
+
+// ============================ Registry ============================
+
+/*
+Registry – set of available collectors in registration order.
+
+	The app iterates the registry to start workers, transports iterate it
+	to expose every collector without knowing it in advance.
+*/
+type Registry struct {
+	mu         sync.RWMutex
+	collectors []Collector
+	byName     map[string]Collector
+}
+
+// NewRegistry – creates an empty collector registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byName: make(map[string]Collector),
+	}
+}
+
+// Register – adds collectors to the registry. Names must be unique.
+func (r *Registry) Register(cs ...Collector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range cs {
+		if _, exists := r.byName[c.Name()]; exists {
+			return fmt.Errorf("%w: '%s'", ErrCollectorExists, c.Name())
+		}
+
+		r.byName[c.Name()] = c
+		r.collectors = append(r.collectors, c)
+	}
+
+	return nil
+}
+
+// MustRegister – same as Register, but panics on duplicated names.
+func (r *Registry) MustRegister(cs ...Collector) {
+	if err := r.Register(cs...); err != nil {
+		panic(err)
+	}
+}
+
+// Get – returns the collector by name.
+func (r *Registry) Get(name string) (Collector, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.byName[name]
+	return c, ok
+}
+
+// Collectors – returns all registered collectors in registration order.
+func (r *Registry) Collectors() []Collector {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.collectors)
+}
+
+/*
+Select – returns a registry of collectors allowed by the configuration.
+
+	Empty enabled list allows every collector, disabled names are
+	always excluded.
+*/
+func (r *Registry) Select(enabled, disabled []string) *Registry {
+	selected := NewRegistry()
+
+	for _, c := range r.Collectors() {
+		if len(enabled) > 0 && !slices.Contains(enabled, c.Name()) {
+			continue
+		}
+		if slices.Contains(disabled, c.Name()) {
+			continue
+		}
+		selected.MustRegister(c)
+	}
+
+	return selected
+}
+
+// Unknown – returns the names that match no registered collector.
+func (r *Registry) Unknown(names ...string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var unknown []string
+	for _, name := range names {
+		if _, ok := r.byName[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+/*
+DecodeValue – decodes a saved value of the collector by its name.
+
+	Fails with ErrValueType when the value was saved with another type.
+*/
+func (r *Registry) DecodeValue(name, valueType string, data []byte) (any, error) {
+	c, ok := r.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrCollectorNotFound, name)
+	}
+
+	d, ok := c.(CollectorDecoder)
+	if !ok || d.ValueType() != valueType {
+		return nil, fmt.Errorf("%w: '%s' for '%s'", ErrValueType, valueType, name)
+	}
+
+	return d.DecodeValue(data)
+}
+
+// CollectorInfo – returns the collector metadata.
+func CollectorInfo(c Collector) domain.CollectorInfo {
+	info := domain.CollectorInfo{
+		Name:            c.Name(),
+		DefaultInterval: c.DefaultInterval(),
+	}
+
+	if d, ok := c.(CollectorDescriber); ok {
+		info.Description = d.Description()
+		info.Units = d.Units()
+	}
+
+	return info
+}
+
+// Infos – returns metadata of all registered collectors.
+func (r *Registry) Infos() []domain.CollectorInfo {
+	all := r.Collectors()
+	infos := make([]domain.CollectorInfo, len(all))
+	for i, c := range all {
+		infos[i] = CollectorInfo(c)
+	}
+	return infos
+}
+
+// AddCollector – registers a periodic worker for the collector.
+func (sp *ServicePooler) AddCollector(c Collector, interval time.Duration) {
+	if interval <= 0 {
+		interval = c.DefaultInterval()
+	}
+	sp.AddMetricPooling(c.Scrape, c.Name(), interval)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/services/monitor"
+)
+
+func testCollector(name string) monitor.Collector {
+	return monitor.NewCollector(domain.NewKey[int](name), time.Second,
+		func(context.Context) (int, error) { return 1, nil },
+		monitor.WithDescription(name+" collector"),
+		monitor.WithUnits("bytes"),
+	)
+}
+
+func collectorNames(r *monitor.Registry) []string {
+	names := []string{}
+	for _, c := range r.Collectors() {
+		names = append(names, c.Name())
+	}
+	return names
+}
+
+func Test_Registry_Register(t *testing.T) {
+	reg := monitor.NewRegistry()
+
+	if err := reg.Register(testCollector("cpu"), testCollector("memory")); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	err := reg.Register(testCollector("cpu"))
+	if !errors.Is(err, monitor.ErrCollectorExists) {
+		t.Errorf("duplicate got %v, want ErrCollectorExists", err)
+	}
+
+	if got := collectorNames(reg); !reflect.DeepEqual(got, []string{"cpu", "memory"}) {
+		t.Errorf("collectors %v", got)
+	}
+
+	info := reg.Infos()[1]
+	if info.Name != "memory" || info.Description != "memory collector" || info.Units != "bytes" || info.DefaultInterval != time.Second {
+		t.Errorf("unexpected info %+v", info)
+	}
+}
+
+func Test_Registry_Select(t *testing.T) {
+	reg := monitor.NewRegistry()
+	reg.MustRegister(testCollector("cpu"), testCollector("memory"), testCollector("thermal"))
+
+	tests := []struct {
+		name     string
+		enabled  []string
+		disabled []string
+		expected []string
+	}{
+		{"all", nil, nil, []string{"cpu", "memory", "thermal"}},
+		{"enabled", []string{"thermal", "cpu"}, nil, []string{"cpu", "thermal"}},
+		{"disabled", nil, []string{"memory"}, []string{"cpu", "thermal"}},
+		{"both", []string{"cpu", "memory"}, []string{"memory"}, []string{"cpu"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectorNames(reg.Select(tt.enabled, tt.disabled)); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := reg.Unknown("cpu", "gpu"); !reflect.DeepEqual(got, []string{"gpu"}) {
+		t.Errorf("unknown got %v", got)
+	}
+}
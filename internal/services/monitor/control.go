@@ -0,0 +1,166 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+// workerInfo – returns a consistent snapshot of the job runtime state.
+func (j *workerJob) workerInfo() domain.WorkerInfo {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return domain.WorkerInfo{
+		Key:      j.key,
+		Mode:     j.mode.String(),
+		Interval: j.cfg.Interval,
+		Timeout:  j.cfg.Timeout,
+		Paused:   j.paused,
+		Running:  j.running.Load(),
+		Status:   j.status,
+	}
+}
+
+// Workers – returns runtime state of all registered workers sorted by key.
+func (sp *ServicePooler) Workers() []domain.WorkerInfo {
+	sp.mu.RLock()
+	infos := make([]domain.WorkerInfo, 0, len(sp.jobPool))
+	for _, job := range sp.jobPool {
+		infos = append(infos, job.workerInfo())
+	}
+	sp.mu.RUnlock()
+
+	slices.SortFunc(infos, func(a, b domain.WorkerInfo) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return infos
+}
+
+// Worker – returns runtime state of the worker under key.
+func (sp *ServicePooler) Worker(key string) (domain.WorkerInfo, error) {
+	job, ok := sp.job(key)
+	if !ok {
+		return domain.WorkerInfo{}, ErrWorkerNotFound
+	}
+	return job.workerInfo(), nil
+}
+
+/*
+PauseWorker – suspends planned scrapes of the worker.
+
+	The last value stays in the store and TriggerScrape still works.
+	An in-flight scrape is not interrupted.
+*/
+func (sp *ServicePooler) PauseWorker(key string) (domain.WorkerInfo, error) {
+	return sp.setPaused(key, true)
+}
+
+// ResumeWorker – resumes planned scrapes of the worker, overdue scrape runs at once.
+func (sp *ServicePooler) ResumeWorker(key string) (domain.WorkerInfo, error) {
+	return sp.setPaused(key, false)
+}
+
+func (sp *ServicePooler) setPaused(key string, paused bool) (domain.WorkerInfo, error) {
+	job, ok := sp.job(key)
+	if !ok {
+		return domain.WorkerInfo{}, ErrWorkerNotFound
+	}
+
+	job.mu.Lock()
+	job.paused = paused
+	job.mu.Unlock()
+
+	job.notify()
+	return job.workerInfo(), nil
+}
+
+/*
+SetWorkerInterval – changes the worker interval live.
+
+	The planned scrape is moved according to the new interval and the
+	current backoff. A derived scrape deadline follows the interval,
+	an overridden one is kept.
+*/
+func (sp *ServicePooler) SetWorkerInterval(key string, interval time.Duration) (domain.WorkerInfo, error) {
+	if interval <= 0 {
+		return domain.WorkerInfo{}, ErrInvalidInterval
+	}
+
+	job, ok := sp.job(key)
+	if !ok {
+		return domain.WorkerInfo{}, ErrWorkerNotFound
+	}
+
+	job.mu.Lock()
+	job.cfg.Interval = interval
+	if !job.timeoutSet {
+		job.cfg.Timeout = scrapeTimeout(interval)
+	}
+	if !job.status.LastRun.IsZero() {
+		job.status.Backoff = backoffDelay(interval, sp.backoffCap, job.status.ConsecutiveFailures)
+		job.status.NextScrape = job.status.LastRun.Add(job.status.LastDuration + job.status.Backoff)
+	}
+	job.mu.Unlock()
+
+	job.notify()
+	return job.workerInfo(), nil
+}
+
+/*
+TriggerScrape – runs an immediate scrape and waits for its result.
+
+	The scrape is executed by the worker loop, so it never overlaps
+	with a planned one. Returns the fresh metric state with the scrape
+	error if the attempt failed.
+*/
+func (sp *ServicePooler) TriggerScrape(ctx context.Context, key string) (domain.ActualMetric, error) {
+	job, ok := sp.job(key)
+	if !ok {
+		return domain.ActualMetric{}, ErrWorkerNotFound
+	}
+
+	err := sp.triggerJob(ctx, job)
+	if errors.Is(err, ErrPoolerNotRunning) || ctx.Err() != nil {
+		return domain.ActualMetric{}, err
+	}
+
+	actual, _, _ := sp.ActualMetric(ctx, key)
+	return actual, err
+}
+
+// triggerJob – asks the worker loop for an immediate scrape and waits for its error.
+func (sp *ServicePooler) triggerJob(ctx context.Context, job *workerJob) error {
+	sp.mu.RLock()
+	runCtx := sp.runCtx
+	sp.mu.RUnlock()
+
+	if runCtx == nil {
+		return ErrPoolerNotRunning
+	}
+
+	reply := make(chan error, 1)
+
+	select {
+	case job.trigger <- reply:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-runCtx.Done():
+		return ErrPoolerNotRunning
+	}
+
+	select {
+	case err := <-reply:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+)
+
+func Test_ServicePooler_control(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.WrapLoggerToContext(
+		context.Background(),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	))
+	defer cancel()
+
+	var calls atomic.Int64
+	store := &testStore{state: map[string]domain.MetricState{}}
+
+	sp := NewServicePooler(store)
+	sp.AddMetricPooling(func(context.Context) (any, error) {
+		return calls.Add(1), nil
+	}, "counter", time.Hour)
+
+	if _, err := sp.TriggerScrape(ctx, "counter"); !errors.Is(err, ErrPoolerNotRunning) {
+		t.Fatalf("trigger before start error = %v, want ErrPoolerNotRunning", err)
+	}
+
+	sp.RunPooling(ctx)
+	defer func() {
+		cancel()
+		sp.Wait()
+	}()
+
+	actual, err := sp.TriggerScrape(ctx, "counter")
+	if err != nil {
+		t.Fatalf("trigger error: %v", err)
+	}
+	if n := calls.Load(); actual.Value != n {
+		t.Errorf("triggered value = %v, want fresh value %d", actual.Value, n)
+	}
+
+	info, err := sp.PauseWorker("counter")
+	if err != nil || !info.Paused {
+		t.Fatalf("pause = %+v, %v, want paused worker", info, err)
+	}
+
+	info, err = sp.SetWorkerInterval("counter", time.Minute)
+	if err != nil {
+		t.Fatalf("set interval error: %v", err)
+	}
+	if info.Interval != time.Minute || info.Timeout != scrapeTimeout(time.Minute) {
+		t.Errorf("got interval %v timeout %v, want %v and derived timeout", info.Interval, info.Timeout, time.Minute)
+	}
+
+	if _, err := sp.SetWorkerInterval("counter", 0); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("zero interval error = %v, want ErrInvalidInterval", err)
+	}
+
+	if _, err := sp.ResumeWorker("missing"); !errors.Is(err, ErrWorkerNotFound) {
+		t.Errorf("missing worker error = %v, want ErrWorkerNotFound", err)
+	}
+
+	sp.AddMetricPooling(func(context.Context) (any, error) {
+		return "late", nil
+	}, "late", time.Hour)
+
+	if actual, err := sp.TriggerScrape(ctx, "late"); err != nil || actual.Value != "late" {
+		t.Errorf("late worker = %v, %v, want started worker", actual.Value, err)
+	}
+
+	workers := sp.Workers()
+	if len(workers) != 2 || workers[0].Key != "counter" || workers[1].Key != "late" {
+		t.Errorf("got workers %+v, want counter and late sorted", workers)
+	}
+}
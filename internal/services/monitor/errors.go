@@ -0,0 +1,44 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrScrapeTimeout = errors.New("scrape deadline exceeded")
+	ErrScrapeBusy    = errors.New("previous scrape is still running")
+)
+
+/*
+PanicError – panic recovered from a metric update worker.
+
+	Value holds the recovered value, Stack the goroutine stack trace
+	captured at the moment of recovery.
+*/
+type PanicError struct {
+	Key   string
+	Value any
+	Stack []byte
+}
+
+func (pe *PanicError) Error() string {
+	return fmt.Sprintf("worker '%s' panic: %v", pe.Key, pe.Value)
+}
+
+// Unwrap – returns the recovered value when the worker panicked with an error.
+func (pe *PanicError) Unwrap() error {
+	if err, ok := pe.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+var (
+	ErrWorkerNotFound   = errors.New("metric worker not found")
+	ErrPoolerNotRunning = errors.New("metric workers are not running")
+	ErrInvalidInterval  = errors.New("worker interval must be positive")
+)
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultQueueSize – default event queue capacity of a subscriber.
+const DefaultQueueSize = 64
+
+// MetricEvent – fresh metric value saved by a worker.
+type MetricEvent struct {
+	Key       string
+	Value     any
+	Timestamp time.Time
+}
+
+// DropPolicy – selects which event is lost when a subscriber queue is full.
+type DropPolicy int
+
+const (
+	DropOldest DropPolicy = iota // queued event is replaced by the new one
+	DropNewest                   // new event is discarded
+)
+
+func (p DropPolicy) String() string {
+	switch p {
+	case DropOldest:
+		return "drop_oldest"
+	case DropNewest:
+		return "drop_newest"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+EventBus – publish/subscribe layer for metric updates.
+
+	Publish never blocks: every subscriber owns a bounded queue and
+	overflow is resolved by its drop policy, so a slow consumer
+	can't stall the workers.
+*/
+type EventBus struct {
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+// NewEventBus – creates an event bus without subscribers.
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// SubscribeOption – functional option for a subscription.
+type SubscribeOption func(*Subscription)
+
+// WithKeys – limits the subscription to the metric keys. No keys means all keys.
+func WithKeys(keys ...string) SubscribeOption {
+	return func(s *Subscription) {
+		if len(keys) == 0 {
+			return
+		}
+
+		s.keys = make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			s.keys[key] = struct{}{}
+		}
+	}
+}
+
+// WithQueueSize – sets the subscriber queue capacity.
+func WithQueueSize(n int) SubscribeOption {
+	return func(s *Subscription) {
+		if n > 0 {
+			s.size = n
+		}
+	}
+}
+
+// WithDropPolicy – sets the overflow behaviour of the subscriber queue.
+func WithDropPolicy(p DropPolicy) SubscribeOption {
+	return func(s *Subscription) {
+		s.policy = p
+	}
+}
+
+/*
+Subscribe – registers a new subscriber.
+
+	Defaults: all keys, DefaultQueueSize queue and DropOldest policy.
+	The caller must Close the subscription when it is not needed.
+*/
+func (b *EventBus) Subscribe(opts ...SubscribeOption) *Subscription {
+	s := &Subscription{
+		bus:    b,
+		size:   DefaultQueueSize,
+		policy: DropOldest,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.queue = make(chan MetricEvent, s.size)
+
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+
+	return s
+}
+
+// Publish – delivers the event to all subscribers of its key.
+func (b *EventBus) Publish(ev MetricEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subs {
+		if s.wants(ev.Key) {
+			s.push(ev)
+		}
+	}
+}
+
+// Subscribers – returns the number of active subscriptions.
+func (b *EventBus) Subscribers() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs)
+}
+
+func (b *EventBus) unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	delete(b.subs, s)
+	b.mu.Unlock()
+}
+
+// Subscription – subscriber of the event bus with its own bounded queue.
+type Subscription struct {
+	bus    *EventBus
+	keys   map[string]struct{} // nil – all keys
+	size   int
+	policy DropPolicy
+
+	mu      sync.Mutex // serializes publishers and Close on the queue
+	queue   chan MetricEvent
+	closed  bool
+	dropped atomic.Uint64
+}
+
+func (s *Subscription) wants(key string) bool {
+	if s.keys == nil {
+		return true
+	}
+	_, ok := s.keys[key]
+	return ok
+}
+
+// push – enqueues the event without blocking, resolving overflow by the policy.
+func (s *Subscription) push(ev MetricEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.queue <- ev:
+		return
+	default:
+	}
+
+	if s.policy == DropOldest {
+		select {
+		case <-s.queue:
+			s.dropped.Add(1)
+		default: // consumer already freed a slot
+		}
+
+		select {
+		case s.queue <- ev:
+			return
+		default:
+		}
+	}
+
+	s.dropped.Add(1)
+}
+
+// Events – returns the event channel. It is closed by Close.
+func (s *Subscription) Events() <-chan MetricEvent {
+	return s.queue
+}
+
+// Dropped – returns the number of events lost by queue overflow.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close – unsubscribes and closes the event channel. Safe to call twice.
+func (s *Subscription) Close() {
+	s.bus.unsubscribe(s)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+}
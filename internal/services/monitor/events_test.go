@@ -0,0 +1,92 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/services/monitor"
+)
+
+func publishValues(bus *monitor.EventBus, key string, values ...int) {
+	for _, v := range values {
+		bus.Publish(monitor.MetricEvent{Key: key, Value: v, Timestamp: time.Now()})
+	}
+}
+
+func drainValues(s *monitor.Subscription) []any {
+	values := []any{}
+	for {
+		select {
+		case ev := <-s.Events():
+			values = append(values, ev.Value)
+		default:
+			return values
+		}
+	}
+}
+
+func Test_EventBus_DropPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   monitor.DropPolicy
+		expected []any
+	}{
+		{"drop oldest", monitor.DropOldest, []any{3, 4}},
+		{"drop newest", monitor.DropNewest, []any{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := monitor.NewEventBus()
+			s := bus.Subscribe(monitor.WithQueueSize(2), monitor.WithDropPolicy(tt.policy))
+			defer s.Close()
+
+			publishValues(bus, "cpu", 1, 2, 3, 4)
+
+			if got := drainValues(s); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+			if s.Dropped() != 2 {
+				t.Errorf("dropped %d, want 2", s.Dropped())
+			}
+		})
+	}
+}
+
+func Test_EventBus_Keys(t *testing.T) {
+	bus := monitor.NewEventBus()
+
+	all := bus.Subscribe()
+	cpu := bus.Subscribe(monitor.WithKeys("cpu"))
+	several := bus.Subscribe(monitor.WithKeys("cpu", "memory"))
+
+	publishValues(bus, "cpu", 1)
+	publishValues(bus, "memory", 2)
+	publishValues(bus, "thermal", 3)
+
+	if got := drainValues(all); !reflect.DeepEqual(got, []any{1, 2, 3}) {
+		t.Errorf("all keys got %v", got)
+	}
+	if got := drainValues(cpu); !reflect.DeepEqual(got, []any{1}) {
+		t.Errorf("one key got %v", got)
+	}
+	if got := drainValues(several); !reflect.DeepEqual(got, []any{1, 2}) {
+		t.Errorf("several keys got %v", got)
+	}
+
+	cpu.Close()
+	cpu.Close()
+
+	if _, ok := <-cpu.Events(); ok {
+		t.Error("events channel must be closed")
+	}
+	if n := bus.Subscribers(); n != 2 {
+		t.Errorf("subscribers %d, want 2", n)
+	}
+
+	publishValues(bus, "cpu", 4) // must not panic on the closed subscription
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultLazyIdleTimeout – default time without requests after which a lazy worker sleeps.
+const DefaultLazyIdleTimeout = 10 * time.Minute
+
+// WorkerMode – scheduling mode of a metric worker.
+type WorkerMode int
+
+const (
+	ModePeriodic WorkerMode = iota // scrapes on its interval all the time
+	ModeLazy                       // scrapes only while the metric is requested
+)
+
+func (m WorkerMode) String() string {
+	switch m {
+	case ModePeriodic:
+		return "periodic"
+	case ModeLazy:
+		return "lazy"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+LazyConfig – settings of the on-demand scraping mode.
+
+	MaxAge      – requested value older than this is scraped synchronously,
+	              zero means the worker interval.
+	IdleTimeout – background refresh stops after this time without requests.
+*/
+type LazyConfig struct {
+	MaxAge      time.Duration
+	IdleTimeout time.Duration
+}
+
+/*
+WithLazyWorkers – switches the workers under keys to the lazy mode.
+
+	A lazy worker sleeps until ActualMetric asks for its key. A value
+	older than MaxAge is then scraped on the spot, concurrent requests
+	share one in-flight scrape. While requests keep coming the worker
+	refreshes the value on its interval in background, after IdleTimeout
+	without requests it goes back to sleep.
+*/
+func WithLazyWorkers(cfg LazyConfig, keys ...string) PoolerOption {
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = DefaultLazyIdleTimeout
+	}
+
+	return func(sp *ServicePooler) {
+		for _, key := range keys {
+			sp.lazy[key] = cfg
+		}
+	}
+}
+
+// flightCall – scrape shared by concurrent lazy requests.
+type flightCall struct {
+	done chan struct{}
+	err  error
+}
+
+// maxAge – returns the age limit of a served lazy value.
+func (j *workerJob) maxAge() time.Duration {
+	if j.lazy.MaxAge > 0 {
+		return j.lazy.MaxAge
+	}
+	return j.config().Interval
+}
+
+/*
+wakeDelay – returns the timer delay after a schedule change.
+
+	A lazy worker woken with an overdue scrape is refreshed by the waking
+	request itself, so background refresh resumes one interval later.
+*/
+func (j *workerJob) wakeDelay() time.Duration {
+	next := j.untilNext()
+	if next == 0 && j.mode == ModeLazy {
+		return j.config().Interval
+	}
+	return next
+}
+
+/*
+sleepy – reports whether a lazy worker should skip the planned scrape.
+
+	The worker is marked idle, so the next request wakes it up.
+*/
+func (j *workerJob) sleepy() bool {
+	if j.mode != ModeLazy {
+		return false
+	}
+
+	last := j.lastAccess.Load()
+	if last != 0 && time.Since(time.Unix(0, last)) < j.lazy.IdleTimeout {
+		return false
+	}
+
+	j.idle.Store(true)
+	return true
+}
+
+/*
+touch – records a request of the lazy metric and refreshes it when needed.
+
+	Wakes an idle worker, then scrapes synchronously if the stored value
+	is missing or older than max-age. Failing workers are not retried
+	before their backoff expires and paused workers are never scraped.
+	The caller stops waiting for the scrape once ctx is done.
+*/
+func (sp *ServicePooler) touch(ctx context.Context, job *workerJob) {
+	job.lastAccess.Store(time.Now().UnixNano())
+
+	if job.idle.CompareAndSwap(true, false) {
+		job.notify()
+	}
+
+	if job.isPaused() {
+		return
+	}
+
+	if state, ok := sp.metricSt.GetState(job.key); ok && time.Since(state.LastUpdate) <= job.maxAge() {
+		return
+	}
+
+	if status := job.scrapeStatus(); status.Failing() && time.Now().Before(status.NextScrape) {
+		return
+	}
+
+	sp.mu.RLock()
+	runCtx := sp.runCtx
+	sp.mu.RUnlock()
+
+	if runCtx == nil {
+		return
+	}
+
+	sp.refresh(ctx, runCtx, job)
+}
+
+/*
+refresh – runs one shared scrape of the job for all concurrent callers.
+
+	The scrape runs under runCtx, so a caller leaving on its own ctx
+	neither cancels it for the others nor waits for it to finish.
+*/
+func (sp *ServicePooler) refresh(ctx, runCtx context.Context, job *workerJob) error {
+	job.flightMu.Lock()
+	f := job.flight
+	if f == nil {
+		f = &flightCall{done: make(chan struct{})}
+		job.flight = f
+		go sp.fly(runCtx, job, f)
+	}
+	job.flightMu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fly – runs the shared scrape and releases its waiters.
+func (sp *ServicePooler) fly(runCtx context.Context, job *workerJob, f *flightCall) {
+	f.err = sp.triggerJob(runCtx, job)
+
+	job.flightMu.Lock()
+	job.flight = nil
+	job.flightMu.Unlock()
+
+	close(f.done)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+	"github.com/eterline/fstmon/internal/infra/log"
+)
+
+type syncStore struct {
+	mu sync.Mutex
+	testStore
+}
+
+func (s *syncStore) SaveValue(key string, value any, timestamp time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.testStore.SaveValue(key, value, timestamp)
+}
+
+func (s *syncStore) GetState(key string) (domain.MetricState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.testStore.GetState(key)
+}
+
+func Test_ServicePooler_lazy(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.WrapLoggerToContext(
+		context.Background(),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	))
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+
+	sp := NewServicePooler(
+		&syncStore{testStore: testStore{state: map[string]domain.MetricState{}}},
+		WithLazyWorkers(LazyConfig{MaxAge: time.Hour, IdleTimeout: time.Hour}, "lazy"),
+	)
+	sp.AddMetricPooling(func(context.Context) (any, error) {
+		<-release
+		return calls.Add(1), nil
+	}, "lazy", time.Hour)
+
+	sp.RunPooling(ctx)
+	defer func() {
+		cancel()
+		sp.Wait()
+	}()
+
+	time.Sleep(20 * time.Millisecond) // first planned tick must not scrape an idle worker
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("idle lazy worker scraped %d times", n)
+	}
+
+	const requests = 8
+
+	var wg sync.WaitGroup
+	values := make([]any, requests)
+
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actual, _, _ := sp.ActualMetric(ctx, "lazy")
+			values[i] = actual.Value
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// a disconnected client leaves the blocked scrape to the others
+	reqCtx, reqCancel := context.WithCancel(ctx)
+	left := make(chan struct{})
+	go func() {
+		sp.ActualMetric(reqCtx, "lazy")
+		close(left)
+	}()
+	reqCancel()
+
+	select {
+	case <-left:
+	case <-time.After(time.Second):
+		t.Fatal("cancelled request still waits for the scrape")
+	}
+
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("concurrent requests caused %d scrapes, want one shared", n)
+	}
+	for i, v := range values {
+		if v != int64(1) {
+			t.Errorf("request %d got %v, want fresh value 1", i, v)
+		}
+	}
+
+	if actual, _, _ := sp.ActualMetric(ctx, "lazy"); actual.Value != int64(1) || calls.Load() != 1 {
+		t.Errorf("value within max-age must be served from the store, got %v", actual.Value)
+	}
+
+	info, _ := sp.Worker("lazy")
+	if info.Mode != "lazy" {
+		t.Errorf("worker mode %q, want lazy", info.Mode)
+	}
+}
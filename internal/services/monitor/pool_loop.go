@@ -5,8 +5,12 @@ package monitor
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/eterline/fstmon/internal/domain"
@@ -14,6 +18,11 @@ import (
 	"github.com/eterline/fstmon/internal/utils/usecase"
 )
 
+const (
+	DefaultBackoffCap = 5 * time.Minute // upper bound of the retry delay for failing workers
+	MinScrapeTimeout  = time.Second     // lower bound of the derived scrape deadline
+)
+
 /*
 UpdateWorker – metric update function.
 
@@ -24,35 +33,258 @@ type UpdateWorker func(context.Context) (any, error)
 
 type WorkerConfig struct {
 	Interval time.Duration
+	Timeout  time.Duration
 	Worker   UpdateWorker
 }
 
+/*
+scrapeTimeout – returns the deadline of a single scrape for the interval.
+
+	Three quarters of the interval leave room for the next tick,
+	but the deadline never drops below MinScrapeTimeout.
+*/
+func scrapeTimeout(interval time.Duration) time.Duration {
+	return max(interval-interval/4, MinScrapeTimeout)
+}
+
 type MetricStore interface {
 	SaveValue(key string, value any, timestamp time.Time)
 	GetState(key string) (domain.MetricState, bool)
 }
 
+/*
+workerJob – registered worker with its schedule and scrape health.
+
+	Config, pause flag and status are changed by the worker goroutine
+	and by the control API concurrently, so they are guarded by mu.
+	wake and trigger deliver control commands into the worker loop.
+*/
+type workerJob struct {
+	key     string
+	running atomic.Bool // worker call is in flight, possibly hung past its deadline
+
+	mode       WorkerMode
+	lazy       LazyConfig
+	lastAccess atomic.Int64 // unix nano of the last lazy request
+	idle       atomic.Bool  // lazy worker sleeps until the next request
+
+	flightMu sync.Mutex
+	flight   *flightCall // in-flight lazy refresh
+
+	wake    chan struct{}   // schedule changed: pause, resume or new interval
+	trigger chan chan error // immediate scrape requests
+
+	mu         sync.RWMutex
+	cfg        WorkerConfig
+	timeoutSet bool // timeout is overridden and must not follow the interval
+	paused     bool
+	status     domain.ScrapeStatus
+}
+
+type scrapeResult struct {
+	value any
+	err   error
+}
+
+func newWorkerJob(key string, cfg WorkerConfig, timeoutSet bool) *workerJob {
+	return &workerJob{
+		key:        key,
+		mode:       ModePeriodic,
+		wake:       make(chan struct{}, 1),
+		trigger:    make(chan chan error),
+		cfg:        cfg,
+		timeoutSet: timeoutSet,
+	}
+}
+
+func (j *workerJob) config() WorkerConfig {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.cfg
+}
+
+func (j *workerJob) scrapeStatus() domain.ScrapeStatus {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.status
+}
+
+func (j *workerJob) isPaused() bool {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.paused
+}
+
+// skipTick – counts a planned scrape that was not run.
+func (j *workerJob) skipTick() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Skipped++
+}
+
+// untilNext – returns the delay left until the planned scrape.
+func (j *workerJob) untilNext() time.Duration {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	if j.status.NextScrape.IsZero() {
+		return 0
+	}
+	return max(time.Until(j.status.NextScrape), 0)
+}
+
+// notify – wakes the worker loop to re-read its schedule.
+func (j *workerJob) notify() {
+	select {
+	case j.wake <- struct{}{}:
+	default: // loop is already notified
+	}
+}
+
 // ServicePooler – manages a pool of metric update workers.
 type ServicePooler struct {
-	metricSt  MetricStore
-	jobPool   map[string]*WorkerConfig
+	metricSt   MetricStore
+	bus        *EventBus
+	backoffCap time.Duration
+	timeouts   map[string]time.Duration
+	lazy       map[string]LazyConfig
+	staleness  StalenessConfig
+
+	mu        sync.RWMutex
+	jobPool   map[string]*workerJob
+	runCtx    context.Context // set once RunPooling is called
 	workersWg sync.WaitGroup
 }
 
+// PoolerOption – functional option for ServicePooler.
+type PoolerOption func(*ServicePooler)
+
+// WithBackoffCap – sets the maximum retry delay for failing workers.
+func WithBackoffCap(d time.Duration) PoolerOption {
+	return func(sp *ServicePooler) {
+		if d > 0 {
+			sp.backoffCap = d
+		}
+	}
+}
+
+// WithEventBus – publishes metric updates into the shared event bus.
+func WithEventBus(bus *EventBus) PoolerOption {
+	return func(sp *ServicePooler) {
+		if bus != nil {
+			sp.bus = bus
+		}
+	}
+}
+
+/*
+WithScrapeTimeouts – overrides scrape deadlines of workers by their keys.
+
+	Workers without an override use a deadline derived from their interval.
+*/
+func WithScrapeTimeouts(timeouts map[string]time.Duration) PoolerOption {
+	return func(sp *ServicePooler) {
+		for key, d := range timeouts {
+			if d > 0 {
+				sp.timeouts[key] = d
+			}
+		}
+	}
+}
+
 // NewServicePooler – creates a new worker pool instance.
-func NewServicePooler(ms MetricStore) *ServicePooler {
-	return &ServicePooler{
-		metricSt: ms,
-		jobPool:  make(map[string]*WorkerConfig),
+func NewServicePooler(ms MetricStore, opts ...PoolerOption) *ServicePooler {
+	sp := &ServicePooler{
+		metricSt:   ms,
+		bus:        NewEventBus(),
+		jobPool:    make(map[string]*workerJob),
+		backoffCap: DefaultBackoffCap,
+		timeouts:   make(map[string]time.Duration),
+		lazy:       make(map[string]LazyConfig),
+		staleness: StalenessConfig{
+			StaleFactor:  DefaultStaleFactor,
+			ExpireFactor: DefaultExpireFactor,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(sp)
 	}
+
+	return sp
 }
 
-// AddMetricPooling – registers a periodic metric update worker.
+/*
+AddMetricPooling – registers a periodic metric update worker.
+
+	Workers added after RunPooling start immediately.
+	Keys are unique: a key that is already running is not replaced.
+*/
 func (sp *ServicePooler) AddMetricPooling(w UpdateWorker, key string, wInterval time.Duration) {
-	sp.jobPool[key] = &WorkerConfig{
+	timeout, timeoutSet := sp.timeouts[key]
+	if !timeoutSet {
+		timeout = scrapeTimeout(wInterval)
+	}
+
+	job := newWorkerJob(key, WorkerConfig{
 		Interval: wInterval,
+		Timeout:  timeout,
 		Worker:   w,
+	}, timeoutSet)
+
+	if lazy, ok := sp.lazy[key]; ok {
+		job.mode = ModeLazy
+		job.lazy = lazy
+	}
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	if sp.runCtx == nil {
+		sp.jobPool[key] = job
+		return
+	}
+
+	logger := log.MustLoggerFromContext(sp.runCtx)
+
+	if _, exists := sp.jobPool[key]; exists {
+		logger.Warn("metric worker already running", "worker_key", key)
+		return
 	}
+
+	sp.jobPool[key] = job
+	sp.startWorker(sp.runCtx, logger, job)
+}
+
+// job – returns the registered job by key.
+func (sp *ServicePooler) job(key string) (*workerJob, bool) {
+	sp.mu.RLock()
+	defer sp.mu.RUnlock()
+
+	job, ok := sp.jobPool[key]
+	return job, ok
+}
+
+/*
+backoffDelay – returns the delay before the next scrape.
+
+	Healthy workers run on their interval. Every consecutive failure doubles
+	the delay, which is capped by backoffCap but never drops below the interval.
+*/
+func backoffDelay(interval, backoffCap time.Duration, failures int) time.Duration {
+	if failures <= 0 || interval >= backoffCap {
+		return interval
+	}
+
+	d := interval
+	for i := 0; i < failures; i++ {
+		d *= 2
+		if d >= backoffCap {
+			return backoffCap
+		}
+	}
+
+	return d
 }
 
 /*
@@ -60,10 +292,23 @@ RunPooling – starts all registered metric update workers.
 
 Each worker runs in its own goroutine.
 Updates are saved to the MetricSaverGeter under their respective keys.
+Failed scrapes keep the last good value, are tracked in the worker
+scrape status and delay the next attempt with exponential backoff.
+Every scrape runs under its own deadline and worker panics are
+recovered, so a single broken collector can't stop the others.
 */
 func (sp *ServicePooler) RunPooling(ctx context.Context) {
 	logger := log.MustLoggerFromContext(ctx)
 
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	if sp.runCtx != nil {
+		logger.Warn("metric workers already started")
+		return
+	}
+	sp.runCtx = ctx
+
 	metricKeys, _, n := usecase.MapSlicesLen(sp.jobPool)
 	if n == 0 {
 		logger.Warn("no metric workers registered")
@@ -72,53 +317,200 @@ func (sp *ServicePooler) RunPooling(ctx context.Context) {
 
 	logger.Info("metric metric workers starting", "count", n, "workers", metricKeys)
 
-	for key, cfg := range sp.jobPool {
+	for _, job := range sp.jobPool {
+		sp.startWorker(ctx, logger, job)
+	}
+}
 
-		wlog := logger.With(
-			"worker_key", key,
-			"worker_interval", cfg.Interval,
-		)
+// startWorker – runs the worker loop of the job in a new goroutine.
+func (sp *ServicePooler) startWorker(ctx context.Context, logger *slog.Logger, job *workerJob) {
+	cfg := job.config()
 
-		wlog.Info("metric worker start")
-		sp.workersWg.Add(1)
+	wlog := logger.With(
+		"worker_key", job.key,
+		"worker_mode", job.mode,
+		"worker_interval", cfg.Interval,
+		"worker_timeout", cfg.Timeout,
+	)
 
-		go func(key string, cfg *WorkerConfig, wlog *slog.Logger) {
+	wlog.Info("metric worker start")
+	sp.workersWg.Add(1)
 
-			defer sp.workersWg.Done()
+	go func() {
+		defer sp.workersWg.Done()
+		sp.workerLoop(ctx, job, wlog)
+	}()
+}
 
-			job := func() {
-				wlog.Debug("worker start update metric")
+/*
+workerLoop – schedules the job scrapes until ctx is done.
 
-				value, err := cfg.Worker(ctx)
-				if err != nil {
-					wlog.Error("worker update metric error", "error", err)
-					return
-				}
+	The timer fires the planned scrapes, wake re-reads the schedule
+	after control changes and trigger runs a scrape out of schedule.
+	Paused workers keep serving triggers but skip planned scrapes,
+	idle lazy workers skip them until the next request wakes them.
+*/
+func (sp *ServicePooler) workerLoop(ctx context.Context, job *workerJob, wlog *slog.Logger) {
+	timer := time.NewTimer(0) // first start
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wlog.Info("metric worker shutdown")
+			return
+
+		case <-timer.C:
+			if job.isPaused() {
+				job.skipTick()
+				continue
+			}
+			if job.sleepy() {
+				job.skipTick()
+				wlog.Debug("lazy worker idle")
+				continue
+			}
+			next, _ := sp.scrape(ctx, job, wlog)
+			timer.Reset(next)
 
-				now := time.Now()
-				wlog.Debug("worker updated metric", "update_time", now.Format(time.RFC1123))
+		case <-job.wake:
+			if job.isPaused() {
+				timer.Stop()
+				continue
+			}
+			timer.Reset(job.wakeDelay())
 
-				sp.metricSt.SaveValue(key, value, now)
+		case reply := <-job.trigger:
+			next, err := sp.scrape(ctx, job, wlog)
+			reply <- err
+			if !job.isPaused() {
+				timer.Reset(next)
 			}
+		}
+	}
+}
 
-			job() // first start
+// scrape – runs a single worker update and returns the delay before the next one.
+func (sp *ServicePooler) scrape(ctx context.Context, job *workerJob, wlog *slog.Logger) (time.Duration, error) {
+	wlog.Debug("worker start update metric")
 
-			ticker := time.NewTicker(cfg.Interval)
-			defer ticker.Stop()
+	cfg := job.config()
+	started := time.Now()
 
-			for {
-				select {
-				case <-ctx.Done():
-					wlog.Info("metric worker shutdown")
-					return
-				case <-ticker.C:
-					job()
-				}
-			}
+	value, err := sp.runWorker(ctx, job, cfg)
+	if ctx.Err() != nil {
+		return cfg.Interval, ctx.Err() // pooler shutdown, not a worker failure
+	}
+	now := time.Now()
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	// interval could be changed by the control API during the scrape
+	interval := job.cfg.Interval
+
+	job.status.LastRun = started
+	job.status.LastDuration = now.Sub(started)
+	job.status.TotalDuration += job.status.LastDuration
+	job.status.MaxDuration = max(job.status.MaxDuration, job.status.LastDuration)
+
+	if err != nil {
+		job.status.Errors++
+
+		var pe *PanicError
+		switch {
+		case errors.Is(err, ErrScrapeBusy):
+			job.status.Overlaps++
+		case errors.As(err, &pe):
+			job.status.Panics++
+			wlog.Error("worker panic recovered", "panic", pe.Value, "panics", job.status.Panics, "stack", string(pe.Stack))
+		case errors.Is(err, ErrScrapeTimeout):
+			job.status.Timeouts++
+		}
+
+		job.status.LastError = err.Error()
+		job.status.LastErrorTime = now
+		job.status.ConsecutiveFailures++
+		job.status.Backoff = backoffDelay(interval, sp.backoffCap, job.status.ConsecutiveFailures)
+		job.status.NextScrape = now.Add(job.status.Backoff)
+
+		wlog.Error(
+			"worker update metric error", "error", err,
+			"consecutive_failures", job.status.ConsecutiveFailures,
+			"retry_in", job.status.Backoff,
+		)
+		return job.status.Backoff, err
+	}
+
+	if job.status.ConsecutiveFailures > 0 {
+		wlog.Info("worker recovered", "failures", job.status.ConsecutiveFailures)
+	}
+
+	job.status.Successes++
+	job.status.LastSuccess = now
+	job.status.ConsecutiveFailures = 0
+	job.status.Backoff = interval
+	job.status.NextScrape = now.Add(interval)
+
+	wlog.Debug("worker updated metric", "update_time", now.Format(time.RFC1123))
+
+	sp.metricSt.SaveValue(job.key, value, now)
+	sp.bus.Publish(MetricEvent{Key: job.key, Value: value, Timestamp: now})
+
+	return interval, nil
+}
 
-		}(key, cfg, wlog)
+/*
+runWorker – calls the job worker under the scrape deadline.
+
+	The call runs in a separate goroutine, so a worker that ignores
+	its context can't block the schedule. Until such a call returns,
+	the following scrapes fail with ErrScrapeBusy instead of piling up
+	goroutines. Panics are recovered into *PanicError.
+*/
+func (sp *ServicePooler) runWorker(ctx context.Context, job *workerJob, cfg WorkerConfig) (any, error) {
+	if !job.running.CompareAndSwap(false, true) {
+		return nil, ErrScrapeBusy
 	}
 
+	sctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	done := make(chan scrapeResult, 1)
+
+	go func() {
+		defer job.running.Store(false)
+		defer func() {
+			if r := recover(); r != nil {
+				done <- scrapeResult{err: &PanicError{Key: job.key, Value: r, Stack: debug.Stack()}}
+			}
+		}()
+
+		value, err := cfg.Worker(sctx)
+		done <- scrapeResult{value: value, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		if res.err != nil && errors.Is(sctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w after %s: %w", ErrScrapeTimeout, cfg.Timeout, res.err)
+		}
+		return res.value, res.err
+	case <-sctx.Done():
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("%w after %s", ErrScrapeTimeout, cfg.Timeout)
+	}
+}
+
+/*
+Subscribe – subscribes to metric updates saved by the workers.
+
+	See EventBus.Subscribe for the options.
+*/
+func (sp *ServicePooler) Subscribe(opts ...SubscribeOption) *Subscription {
+	return sp.bus.Subscribe(opts...)
 }
 
 /*
@@ -133,27 +525,46 @@ func (sp *ServicePooler) Wait() {
 /*
 ActualMetric – retrieves the last known state of a metric.
 
-	value          – metric value (may be nil)
+	actual         – metric value (may be nil) with its scrape status and freshness
 	scheduleExists – worker for this key was registered
 	stateExists    – repository has at least one saved state
+
+	For lazy workers the call may block on the scrape of an outdated value
+	until ctx is done, the outdated value is returned then.
 */
-func (sp *ServicePooler) ActualMetric(key string) (value any, scheduleExists, stateExists bool, retryIn time.Duration) {
-	job, ok := sp.jobPool[key]
+func (sp *ServicePooler) ActualMetric(ctx context.Context, key string) (actual domain.ActualMetric, scheduleExists, stateExists bool) {
+	job, ok := sp.job(key)
 	if !ok {
-		return nil, false, false, 0
+		return domain.ActualMetric{}, false, false
 	}
 
-	state, ok := sp.metricSt.GetState(key)
-	if !ok {
-		return nil, true, false, job.Interval
+	if job.mode == ModeLazy {
+		sp.touch(ctx, job)
 	}
 
-	nextUpdate := state.LastUpdate.Add(job.Interval)
-	remaining := time.Until(nextUpdate)
+	status := job.scrapeStatus()
 
-	if remaining < 0 {
-		remaining = 0
+	actual = domain.ActualMetric{
+		RetryIn: job.config().Interval,
+		Stale:   status.Failing(),
+		Status:  status,
 	}
 
-	return state.Value, true, true, remaining
+	if !status.NextScrape.IsZero() {
+		actual.RetryIn = max(time.Until(status.NextScrape), 0)
+	}
+
+	state, ok := sp.metricSt.GetState(key)
+	if !ok {
+		return actual, true, false
+	}
+
+	actual.Value = state.Value
+	actual.LastUpdate = state.LastUpdate
+	actual.Restored = state.Restored
+	actual.Age = max(time.Since(state.LastUpdate), 0)
+	actual.Freshness = freshness(actual.Age, sp.ttl(key, job.config().Interval))
+	actual.Stale = actual.Stale || state.Restored || actual.Freshness != domain.FreshnessFresh
+
+	return actual, true, true
 }
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+type testStore struct {
+	state map[string]domain.MetricState
+}
+
+func (s *testStore) SaveValue(key string, value any, timestamp time.Time) {
+	s.state[key] = domain.MetricState{Value: value, Available: true, LastUpdate: timestamp}
+}
+
+func (s *testStore) GetState(key string) (domain.MetricState, bool) {
+	st, ok := s.state[key]
+	return st, ok
+}
+
+func Test_backoffDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		cap      time.Duration
+		failures int
+		expected time.Duration
+	}{
+		{"healthy", 10 * time.Second, time.Minute, 0, 10 * time.Second},
+		{"first failure", 10 * time.Second, time.Minute, 1, 20 * time.Second},
+		{"second failure", 10 * time.Second, time.Minute, 2, 40 * time.Second},
+		{"capped", 10 * time.Second, time.Minute, 3, time.Minute},
+		{"many failures", 10 * time.Second, time.Minute, 1000, time.Minute},
+		{"interval above cap", 2 * time.Minute, time.Minute, 3, 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := backoffDelay(tt.interval, tt.cap, tt.failures)
+			if got != tt.expected {
+				t.Errorf("backoffDelay(%v, %v, %d) = %v, want %v", tt.interval, tt.cap, tt.failures, got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_ServicePooler_scrape(t *testing.T) {
+	ctx := context.Background()
+	wlog := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var fail bool
+	sp := NewServicePooler(&testStore{state: map[string]domain.MetricState{}}, WithBackoffCap(time.Minute))
+	sp.AddMetricPooling(func(context.Context) (any, error) {
+		if fail {
+			return nil, errors.New("scrape failed")
+		}
+		return 42, nil
+	}, "test", 10*time.Second)
+
+	job := sp.jobPool["test"]
+	events := sp.Subscribe(WithKeys("test"))
+	defer events.Close()
+
+	if d, _ := sp.scrape(ctx, job, wlog); d != 10*time.Second {
+		t.Fatalf("healthy delay = %v, want %v", d, 10*time.Second)
+	}
+
+	select {
+	case ev := <-events.Events():
+		if ev.Key != "test" || ev.Value != 42 {
+			t.Errorf("got event %s=%v, want test=42", ev.Key, ev.Value)
+		}
+	default:
+		t.Error("scrape must publish the fresh value")
+	}
+
+	fail = true
+	sp.scrape(ctx, job, wlog)
+	if d, _ := sp.scrape(ctx, job, wlog); d != 40*time.Second {
+		t.Fatalf("failing delay = %v, want %v", d, 40*time.Second)
+	}
+
+	actual, scheduleExists, stateExists := sp.ActualMetric(ctx, "test")
+	if !scheduleExists || !stateExists {
+		t.Fatalf("ActualMetric exists = %v/%v, want true/true", scheduleExists, stateExists)
+	}
+	if !actual.Stale || actual.Value != 42 {
+		t.Errorf("got stale=%v value=%v, want stale last good value 42", actual.Stale, actual.Value)
+	}
+	if actual.Status.ConsecutiveFailures != 2 || actual.Status.LastError != "scrape failed" {
+		t.Errorf("got status %+v, want 2 failures with last error", actual.Status)
+	}
+
+	fail = false
+	sp.scrape(ctx, job, wlog)
+	actual, _, _ = sp.ActualMetric(ctx, "test")
+	if actual.Stale || actual.Status.ConsecutiveFailures != 0 {
+		t.Errorf("status after recovery = %+v, want healthy", actual.Status)
+	}
+	if actual.Status.Successes != 2 || actual.Status.Errors != 2 || actual.Status.MaxDuration < actual.Status.AvgDuration() {
+		t.Errorf("got totals %+v, want 2 successes and 2 errors", actual.Status)
+	}
+}
+
+func Test_ServicePooler_runWorker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("panic", func(t *testing.T) {
+		sp := NewServicePooler(&testStore{state: map[string]domain.MetricState{}})
+		sp.AddMetricPooling(func(context.Context) (any, error) {
+			panic("broken collector")
+		}, "panic", 10*time.Second)
+
+		_, err := sp.runWorker(ctx, sp.jobPool["panic"], sp.jobPool["panic"].config())
+
+		var pe *PanicError
+		if !errors.As(err, &pe) {
+			t.Fatalf("got error %v, want *PanicError", err)
+		}
+		if pe.Key != "panic" || pe.Value != "broken collector" {
+			t.Errorf("got panic %q: %v, want panic: broken collector", pe.Key, pe.Value)
+		}
+	})
+
+	t.Run("hung worker", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		sp := NewServicePooler(
+			&testStore{state: map[string]domain.MetricState{}},
+			WithScrapeTimeouts(map[string]time.Duration{"hung": 10 * time.Millisecond}),
+		)
+		sp.AddMetricPooling(func(context.Context) (any, error) {
+			<-release // ignores its context
+			return nil, nil
+		}, "hung", 10*time.Second)
+
+		job := sp.jobPool["hung"]
+
+		if _, err := sp.runWorker(ctx, job, job.config()); !errors.Is(err, ErrScrapeTimeout) {
+			t.Fatalf("got error %v, want ErrScrapeTimeout", err)
+		}
+		if _, err := sp.runWorker(ctx, job, job.config()); !errors.Is(err, ErrScrapeBusy) {
+			t.Fatalf("got error %v, want ErrScrapeBusy", err)
+		}
+	})
+}
+
+func Test_scrapeTimeout(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		expected time.Duration
+	}{
+		{10 * time.Second, 7500 * time.Millisecond},
+		{time.Minute, 45 * time.Second},
+		{time.Second, MinScrapeTimeout},
+	}
+
+	for _, tt := range tests {
+		if got := scrapeTimeout(tt.interval); got != tt.expected {
+			t.Errorf("scrapeTimeout(%v) = %v, want %v", tt.interval, got, tt.expected)
+		}
+	}
+}
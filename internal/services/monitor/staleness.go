@@ -0,0 +1,96 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+const (
+	DefaultStaleFactor  = 3  // value older than 3 worker intervals is stale
+	DefaultExpireFactor = 10 // value older than 10 worker intervals is expired
+)
+
+// TTL – age thresholds of a metric value. Zero fields are derived from the worker interval.
+type TTL struct {
+	StaleAfter  time.Duration
+	ExpireAfter time.Duration
+}
+
+/*
+StalenessConfig – freshness policy of the served metric values.
+
+	StaleFactor, ExpireFactor – default thresholds as multiples of the
+	                            worker interval, zero means the defaults.
+	TTLs                      – per-key threshold overrides.
+	ServeExpired              – expired values are still served as stale
+	                            instead of being reported unavailable.
+*/
+type StalenessConfig struct {
+	StaleFactor  float64
+	ExpireFactor float64
+	TTLs         map[string]TTL
+	ServeExpired bool
+}
+
+// WithStaleness – sets the freshness policy of the served metric values.
+func WithStaleness(cfg StalenessConfig) PoolerOption {
+	if cfg.StaleFactor <= 0 {
+		cfg.StaleFactor = DefaultStaleFactor
+	}
+	if cfg.ExpireFactor <= 0 {
+		cfg.ExpireFactor = DefaultExpireFactor
+	}
+
+	return func(sp *ServicePooler) {
+		sp.staleness = cfg
+	}
+}
+
+/*
+ttl – returns the thresholds of the key for its current interval.
+
+	The expire threshold never drops below the stale one.
+*/
+func (sp *ServicePooler) ttl(key string, interval time.Duration) TTL {
+	ttl := sp.staleness.TTLs[key]
+
+	if ttl.StaleAfter <= 0 {
+		ttl.StaleAfter = time.Duration(float64(interval) * sp.staleness.StaleFactor)
+	}
+	if ttl.ExpireAfter <= 0 {
+		ttl.ExpireAfter = time.Duration(float64(interval) * sp.staleness.ExpireFactor)
+	}
+	ttl.ExpireAfter = max(ttl.ExpireAfter, ttl.StaleAfter)
+
+	return ttl
+}
+
+// freshness – classifies the value age by the thresholds.
+func freshness(age time.Duration, ttl TTL) domain.Freshness {
+	switch {
+	case age > ttl.ExpireAfter:
+		return domain.FreshnessExpired
+	case age > ttl.StaleAfter:
+		return domain.FreshnessStale
+	default:
+		return domain.FreshnessFresh
+	}
+}
+
+// ServeExpired – reports whether expired values are served as stale.
+func (sp *ServicePooler) ServeExpired() bool {
+	return sp.staleness.ServeExpired
+}
+
+// TTL – returns the current age thresholds of the worker under the key.
+func (sp *ServicePooler) TTL(key string) (TTL, bool) {
+	job, ok := sp.job(key)
+	if !ok {
+		return TTL{}, false
+	}
+	return sp.ttl(key, job.config().Interval), true
+}
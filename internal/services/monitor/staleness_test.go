@@ -0,0 +1,44 @@
+// Copyright (c) 2025 EterLine (Andrew)
+// This file is part of fstmon.
+// Licensed under the MIT License. See the LICENSE file for details.
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eterline/fstmon/internal/domain"
+)
+
+func Test_ServicePooler_freshness(t *testing.T) {
+	sp := NewServicePooler(nil, WithStaleness(StalenessConfig{
+		TTLs: map[string]TTL{
+			"thermal": {StaleAfter: time.Minute},
+			"broken":  {StaleAfter: time.Hour, ExpireAfter: time.Minute},
+		},
+	}))
+
+	tests := []struct {
+		name     string
+		key      string
+		interval time.Duration
+		age      time.Duration
+		expected domain.Freshness
+	}{
+		{"fresh", "cpu", 10 * time.Second, 30 * time.Second, domain.FreshnessFresh},
+		{"stale by factor", "cpu", 10 * time.Second, 31 * time.Second, domain.FreshnessStale},
+		{"expired by factor", "cpu", 10 * time.Second, 101 * time.Second, domain.FreshnessExpired},
+		{"stale override", "thermal", 10 * time.Second, 50 * time.Second, domain.FreshnessFresh},
+		{"expire override keeps factor", "thermal", 10 * time.Second, 101 * time.Second, domain.FreshnessExpired},
+		{"expire below stale", "broken", time.Second, 2 * time.Hour, domain.FreshnessExpired},
+		{"expire raised to stale", "broken", time.Second, 30 * time.Minute, domain.FreshnessFresh},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := freshness(tt.age, sp.ttl(tt.key, tt.interval)); got != tt.expected {
+				t.Errorf("got %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
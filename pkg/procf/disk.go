@@ -81,6 +81,9 @@ Partitions – types of partitions in system
 	│ Mount        │ Manually mounted filesystems (/mnt/***)                       │
 	│ Run          │ Ephemeral tmpfs mounts (e.g., /run, /dev/shm)                 │
 	└──────────────┴───────────────────────────────────────────────────────────────┘
+
+Deprecated: fstmon classifies partitions with the filters of its
+partitions collector, Partitions is kept for existing importers.
 */
 type Partitions struct {
 	Main     []Partition `json:"main_partitions"`
@@ -129,6 +132,12 @@ type DiskStatus struct {
 	Partitions []Partition               `json:"partitions"`
 }
 
+/*
+FetchPartitions – groups all mounted partitions by their mount point prefix.
+
+Deprecated: fstmon drops pseudo filesystems, container and /run
+mounts with the filters of its partitions collector.
+*/
 func FetchPartitions() (Partitions, error) {
 
 	psList, err := psdisk.Partitions(true)
@@ -8,16 +8,22 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"strings"
 )
 
 func NetCounters() (NetCounter, error) {
+	return NetCountersAt(DefaultProcRoot)
+}
+
+// NetCountersAt – reads the interface and protocol counters of the proc filesystem mounted at root.
+func NetCountersAt(root string) (NetCounter, error) {
 
-	iCounters, err := procInterfaceCounters()
+	iCounters, err := procInterfaceCounters(root)
 	if err != nil {
 		return NetCounter{}, err
 	}
 
-	sCounters, err := procSnmpCounters()
+	sCounters, err := procSnmpCounters(root)
 	if err != nil {
 		return NetCounter{}, err
 	}
@@ -60,9 +66,9 @@ func parseInterfaceCounters(data []byte) (InterfaceCounters, error) {
 	return ifaces, nil
 }
 
-func procInterfaceCounters() (InterfaceCounters, error) {
+func procInterfaceCounters(root string) (InterfaceCounters, error) {
 
-	data, err := procNetDev.Data()
+	data, err := procNetDev.at(root).Data()
 	if err != nil {
 		return nil, fmt.Errorf("net counter fetch error: %v", err)
 	}
@@ -106,36 +112,114 @@ func parseNetTx(data [][]byte) NetTX {
 	}
 }
 
-func procSnmpCounters() (SnmpCounter, error) {
-	data, err := procNetSnmp.Data()
+/*
+netTable – header and value fields of a /proc/net/snmp or netstat protocol.
+
+	Both fields slices keep the "Tcp:" prefix as their first element.
+*/
+type netTable struct {
+	names  [][]byte
+	values [][]byte
+}
+
+/*
+parseNetTables – splits /proc/net/snmp or netstat data into protocol tables.
+
+	Every protocol is a pair of lines, the first with counter names
+	and the second with their values:
+
+		Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens ...
+		Tcp: 1 200 120000 -1 24 ...
+
+	Tables are keyed by the protocol name without the colon. The set
+	of protocols depends on the kernel, e.g. IcmpMsg appears only
+	after the first ICMP message.
+*/
+func parseNetTables(data []byte) map[string]netTable {
+	fileLines := bytes.Split(data, []byte("\n"))
+	tables := make(map[string]netTable)
+
+	for i := 0; i+1 < len(fileLines); i += 2 {
+		names := bytes.Fields(fileLines[i])
+		values := bytes.Fields(fileLines[i+1])
+		if len(names) == 0 || len(values) == 0 || !bytes.Equal(names[0], values[0]) {
+			continue
+		}
+
+		proto := strings.TrimSuffix(string(names[0]), ":")
+		tables[proto] = netTable{names: names, values: values}
+	}
+
+	return tables
+}
+
+func procSnmpCounters(root string) (SnmpCounter, error) {
+	data, err := procNetSnmp.at(root).Data()
 	if err != nil {
 		return SnmpCounter{}, err
 	}
 
-	fileLines := bytes.Split(data, []byte("\n"))
+	return parseSnmpCounters(data)
+}
+
+func parseSnmpCounters(data []byte) (SnmpCounter, error) {
+	tables := parseNetTables(data)
 
-	if len(fileLines) < 10 {
+	if _, ok := tables["Tcp"]; !ok {
 		return SnmpCounter{}, fmt.Errorf("net counter fetch error: invalid file")
 	}
 
 	cntr := SnmpCounter{
-		Ip:      parseIpSnmp(bytes.Fields(fileLines[1])),
-		Icmp:    parseIcmpSnmp(bytes.Fields(fileLines[3])),
-		Tcp:     parseTcpSnmp(bytes.Fields(fileLines[5])),
-		Udp:     parseUdpSnmp(bytes.Fields(fileLines[7])),
-		UdpLite: parseUdpLiteSnmp(bytes.Fields(fileLines[9])),
+		Ip:      parseIpSnmp(tables["Ip"].values),
+		Icmp:    parseIcmpSnmp(tables["Icmp"].values),
+		Tcp:     parseTcpSnmp(tables["Tcp"].values),
+		Udp:     parseUdpSnmp(tables["Udp"].values),
+		UdpLite: parseUdpLiteSnmp(tables["UdpLite"].values),
 	}
 
 	return cntr, nil
 }
 
+// ProcNetstat – extended protocol counters of /proc/net/netstat by protocol and name.
+type ProcNetstat map[string]map[string]uint64
+
+/*
+ReadProcNetstat – reads net/netstat of the proc filesystem mounted at root.
+
+	Counters are keyed as in the file, e.g. netstat["TcpExt"]["ListenDrops"].
+*/
+func ReadProcNetstat(root string) (ProcNetstat, error) {
+	data, err := procNetNetstat.at(root).Data()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseProcNetstat(data), nil
+}
+
+func parseProcNetstat(data []byte) ProcNetstat {
+	tables := parseNetTables(data)
+	res := make(ProcNetstat, len(tables))
+
+	for proto, table := range tables {
+		counters := make(map[string]uint64, len(table.names)-1)
+		for i := 1; i < len(table.names) && i < len(table.values); i++ {
+			counters[string(table.names[i])] = bytesToUint64(table.values[i])
+		}
+		res[proto] = counters
+	}
+
+	return res
+}
+
 func parseIpSnmp(data [][]byte) IpSnmp {
 
-	if len(data) < 21 {
+	// OutTransmits exists since Linux 6.3
+	if len(data) < 20 {
 		return IpSnmp{}
 	}
 
-	return IpSnmp{
+	ip := IpSnmp{
 		Forwarding:      bytesToInt64(data[1]),
 		DefaultTTL:      bytesToInt64(data[2]),
 		InReceives:      bytesToInt64(data[3]),
@@ -155,8 +239,12 @@ func parseIpSnmp(data [][]byte) IpSnmp {
 		FragOKs:         bytesToInt64(data[17]),
 		FragFails:       bytesToInt64(data[18]),
 		FragCreates:     bytesToInt64(data[19]),
-		OutTransmits:    bytesToInt64(data[20]),
 	}
+	if len(data) > 20 {
+		ip.OutTransmits = bytesToInt64(data[20])
+	}
+
+	return ip
 }
 
 func parseIcmpSnmp(data [][]byte) IcmpSnmp {
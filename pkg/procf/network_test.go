@@ -49,3 +49,47 @@ enp5s1: 889  657028    0    0    0     0          0         0 889  189071    0
 		t.Error(r)
 	}
 }
+
+func Test_parseSnmpCounters(t *testing.T) {
+	// Linux 5.15: no OutTransmits, IcmpMsg present after the first ICMP message
+	data := `Ip: Forwarding DefaultTTL InReceives InHdrErrors InAddrErrors ForwDatagrams InUnknownProtos InDiscards InDelivers OutRequests OutDiscards OutNoRoutes ReasmTimeout ReasmReqds ReasmOKs ReasmFails FragOKs FragFails FragCreates
+Ip: 1 64 3090 0 2 0 0 0 3088 2987 0 4 0 0 0 0 0 0 0
+Icmp: InMsgs InErrors InCsumErrors InDestUnreachs InTimeExcds InParmProbs InSrcQuenchs InRedirects InEchos InEchoReps InTimestamps InTimestampReps InAddrMasks InAddrMaskReps OutMsgs OutErrors OutRateLimitGlobal OutRateLimitHost OutDestUnreachs OutTimeExcds OutParmProbs OutSrcQuenchs OutRedirects OutEchos OutEchoReps OutTimestamps OutTimestampReps OutAddrMasks OutAddrMaskReps
+Icmp: 45 1 0 45 0 0 0 0 0 0 0 0 0 0 50 0 0 0 50 0 0 0 0 0 0 0 0 0 0
+IcmpMsg: InType3 OutType3
+IcmpMsg: 45 50
+Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens PassiveOpens AttemptFails EstabResets CurrEstab InSegs OutSegs RetransSegs InErrs OutRsts InCsumErrors
+Tcp: 1 200 120000 -1 92 11 3 6 7 2614 2610 17 0 40 0
+Udp: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti MemErrors
+Udp: 388 50 0 440 0 0 0 12 0
+UdpLite: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti MemErrors
+UdpLite: 0 0 0 0 0 0 0 0 0
+`
+
+	c, err := parseSnmpCounters([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Ip.InReceives != 3090 || c.Ip.OutNoRoutes != 4 || c.Ip.OutTransmits != 0 {
+		t.Errorf("ip = %+v", c.Ip)
+	}
+	if c.Icmp.InErrors != 1 || c.Icmp.OutDestUnreachs != 50 {
+		t.Errorf("icmp = %+v", c.Icmp)
+	}
+	if c.Tcp.MaxConn != -1 || c.Tcp.RetransSegs != 17 || c.Tcp.OutRsts != 40 {
+		t.Errorf("tcp = %+v", c.Tcp)
+	}
+	if c.Udp.NoPorts != 50 || c.Udp.IgnoredMulti != 12 {
+		t.Errorf("udp = %+v", c.Udp)
+	}
+
+	netstat := parseProcNetstat([]byte(`TcpExt: SyncookiesSent ListenOverflows ListenDrops TCPTimeouts
+TcpExt: 0 3 5 120
+IpExt: InNoRoutes InOctets
+IpExt: 0 9182736
+`))
+	if netstat["TcpExt"]["ListenDrops"] != 5 || netstat["TcpExt"]["TCPTimeouts"] != 120 || netstat["IpExt"]["InOctets"] != 9182736 {
+		t.Errorf("netstat = %v", netstat)
+	}
+}
@@ -29,6 +29,10 @@ Process holds detailed information about proc
 	│ State       │ Indicating process state                               │
 	│ FullCommand │ Full exec command                                      │
 	└─────────────┴────────────────────────────────────────────────────────┘
+
+Deprecated: fstmon reads processes from /proc with the processes
+collector of internal/infra/metrics/system, Process is kept for
+existing importers.
 */
 type Process struct {
 	PID         int32   `json:"pid"`
@@ -42,6 +46,12 @@ type Process struct {
 	FullCommand string  `json:"full_command"`
 }
 
+/*
+SysProcessList – returns all processes parsed from the output of ps.
+
+Deprecated: fstmon serves the top processes from the processes
+collector, which reads /proc without running ps.
+*/
 func SysProcessList() ([]Process, error) {
 
 	output, err := exec.Command("ps", "-eo", psCmdFieldsArg).Output()
@@ -212,7 +222,12 @@ type SelfStatus struct {
 }
 
 func FetchSelfStatus() (SelfStatus, error) {
-	data, err := procSelfStatus.Data()
+	return FetchSelfStatusAt(DefaultProcRoot)
+}
+
+// FetchSelfStatusAt – parses self/status of the proc filesystem mounted at root.
+func FetchSelfStatusAt(root string) (SelfStatus, error) {
+	data, err := procSelfStatus.at(root).Data()
 	if err != nil {
 		return SelfStatus{}, err
 	}
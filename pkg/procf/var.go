@@ -7,21 +7,32 @@ package procf
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
+// DefaultProcRoot – mount point of the proc filesystem of the host.
+const DefaultProcRoot = "/proc"
+
 type ProcFile string
 
+// at – returns the file under the proc filesystem mounted at root, e.g. of a container host.
+func (pf ProcFile) at(root string) ProcFile {
+	return ProcFile(filepath.Join(root, strings.TrimPrefix(string(pf), DefaultProcRoot)))
+}
+
 func (pf ProcFile) Data() (data []byte, err error) {
 	data, err = os.ReadFile(string(pf))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read proc file '%s': %v", pf, err)
+		return nil, fmt.Errorf("failed to read proc file '%s': %w", pf, err)
 	}
 	return data, nil
 }
 
 const (
-	procNetDev  ProcFile = "/proc/net/dev"  // network devices
-	procNetSnmp ProcFile = "/proc/net/snmp" // network snmp counters
+	procNetDev     ProcFile = "/proc/net/dev"     // network devices
+	procNetSnmp    ProcFile = "/proc/net/snmp"    // network snmp counters
+	procNetNetstat ProcFile = "/proc/net/netstat" // extended network counters
 
 	procSelfStatus ProcFile = "/proc/self/status" // information of current go program
 